@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -19,6 +20,7 @@ import (
 	"github.com/vicmanager/esteemed/backend/internal/adapters/secondary/pubsub"
 	"github.com/vicmanager/esteemed/backend/internal/adapters/secondary/sqlite"
 	"github.com/vicmanager/esteemed/backend/internal/app"
+	"github.com/vicmanager/esteemed/backend/internal/ports/secondary"
 )
 
 func main() {
@@ -40,8 +42,87 @@ func main() {
 		}
 	}
 
+	// Archive lives next to the analytics database unless configured
+	archiveDBPath := os.Getenv("ARCHIVE_PATH")
+	if archiveDBPath == "" {
+		archiveDBPath = filepath.Join(filepath.Dir(sqlitePath), "archive.db")
+	}
+
+	archiveRetention := app.DefaultArchiveRetention
+	if v := os.Getenv("ARCHIVE_RETENTION"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			archiveRetention = d
+		} else {
+			log.Printf("Warning: Invalid ARCHIVE_RETENTION %q, using %s", v, archiveRetention)
+		}
+	}
+
+	// Get room timeouts from environment or use defaults
+	roomTimeouts := app.DefaultRoomTimeouts()
+	if v := os.Getenv("ROOM_INACTIVITY_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			roomTimeouts.Inactivity = d
+		} else {
+			log.Printf("Warning: Invalid ROOM_INACTIVITY_TIMEOUT %q, using %s", v, roomTimeouts.Inactivity)
+		}
+	}
+	if v := os.Getenv("ROOM_EXPIRY_WARNING"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			roomTimeouts.Warning = d
+		} else {
+			log.Printf("Warning: Invalid ROOM_EXPIRY_WARNING %q, using %s", v, roomTimeouts.Warning)
+		}
+	}
+	if v := os.Getenv("DISCONNECT_GRACE_PERIOD"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			roomTimeouts.Disconnect = d
+		} else {
+			log.Printf("Warning: Invalid DISCONNECT_GRACE_PERIOD %q, using %s", v, roomTimeouts.Disconnect)
+		}
+	}
+	if v := os.Getenv("PRESENCE_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			roomTimeouts.Presence = d
+		} else {
+			log.Printf("Warning: Invalid PRESENCE_TIMEOUT %q, using %s", v, roomTimeouts.Presence)
+		}
+	}
+
+	// Get per-room participant ceilings from environment or use defaults
+	roomLimits := app.DefaultRoomLimits()
+	if v := os.Getenv("MAX_VOTERS_PER_ROOM"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			roomLimits.MaxVoters = n
+		} else {
+			log.Printf("Warning: Invalid MAX_VOTERS_PER_ROOM %q, using %d", v, roomLimits.MaxVoters)
+		}
+	}
+	if v := os.Getenv("MAX_SPECTATORS_PER_ROOM"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			roomLimits.MaxSpectators = n
+		} else {
+			log.Printf("Warning: Invalid MAX_SPECTATORS_PER_ROOM %q, using %d", v, roomLimits.MaxSpectators)
+		}
+	}
+
+	// Persistent rooms are stored next to the analytics database unless configured
+	roomsDBPath := os.Getenv("ROOMS_PATH")
+	if roomsDBPath == "" {
+		roomsDBPath = filepath.Join(filepath.Dir(sqlitePath), "rooms.db")
+	}
+
 	// Initialize secondary adapters (driven)
-	roomRepo := memory.NewRoomRepository()
+	// Live rooms are kept in memory; persistent rooms are also stored in SQLite,
+	// encrypted with ROOMS_SECRET, so they survive restarts (they stay in memory
+	// only if no secret is set or the store fails to open)
+	var roomRepo secondary.RoomRepository
+	persistentRoomRepo, err := sqlite.NewRoomRepository(context.Background(), roomsDBPath, os.Getenv("ROOMS_SECRET"), memory.NewRoomRepository())
+	if err != nil {
+		log.Printf("Warning: Failed to open room store: %v (persistent rooms will not survive restarts)", err)
+		roomRepo = memory.NewRoomRepository()
+	} else {
+		roomRepo = persistentRoomRepo
+	}
 	eventBroker := pubsub.NewBroker()
 	appEventBroker := pubsub.NewAppEventBroker()
 
@@ -51,15 +132,59 @@ func main() {
 		log.Printf("Warning: Failed to initialize analytics: %v (analytics will be disabled)", err)
 	}
 
+	// Initialize archive repository (optional - closed rooms are not archived if it fails)
+	var archiveService *app.ArchiveService
+	archiveRepo, err := sqlite.NewArchiveRepository(archiveDBPath)
+	if err != nil {
+		log.Printf("Warning: Failed to initialize archive: %v (archiving will be disabled)", err)
+	} else {
+		archiveService = app.NewArchiveService(archiveRepo, archiveRetention, os.Getenv("ARCHIVE_ADMIN_TOKEN"))
+	}
+
+	// Every room close goes through the lifecycle
+	roomLifecycle := app.NewRoomLifecycle(roomRepo, eventBroker, analyticsRepo, appEventBroker)
+	if archiveService != nil {
+		roomLifecycle.OnClose(app.CloseStageArchive, archiveService.Archive)
+	}
+
+	// Invite links to private rooms are signed with INVITE_SECRET
+	inviteSecret := os.Getenv("INVITE_SECRET")
+	if inviteSecret == "" {
+		log.Printf("Warning: INVITE_SECRET not set, invite links will stop working on restart")
+	}
+	inviteSigner := app.NewInviteSigner(inviteSecret)
+
+	// Participants are connected while they have an event stream open
+	presence := app.NewPresence(roomRepo, eventBroker, roomLifecycle, roomTimeouts.Presence)
+
 	// Initialize application services
-	roomService := app.NewRoomService(roomRepo, eventBroker, analyticsRepo, appEventBroker)
-	estimationService := app.NewEstimationService(roomRepo, eventBroker, analyticsRepo, appEventBroker)
+	estimationService := app.NewEstimationService(roomRepo, eventBroker, analyticsRepo, appEventBroker, presence)
 	analyticsService := app.NewAnalyticsService(analyticsRepo)
 
-	// Initialize and start room cleaner
-	roomCleaner := app.NewRoomCleaner(roomRepo, eventBroker)
+	// Room cleaner and deadline revealer are created first so new rooms can
+	// schedule their expiry and deadline reveal with them
+	roomCleaner := app.NewRoomCleaner(roomRepo, eventBroker, roomTimeouts, roomLifecycle)
+	deadlineRevealer := app.NewDeadlineRevealer(roomRepo, estimationService)
+	roomActivity := app.ActivityObservers{roomCleaner, deadlineRevealer}
+	roomService := app.NewRoomService(roomRepo, eventBroker, analyticsRepo, appEventBroker, roomTimeouts, roomLimits, roomActivity, roomLifecycle, presence, inviteSigner)
+
+	// Rooms restored from the room store are scheduled like new ones
+	if restored, err := roomRepo.ListAll(context.Background()); err == nil {
+		for _, room := range restored {
+			room.SetActivityObserver(roomActivity)
+		}
+		log.Printf("Restored %d persistent rooms", len(restored))
+	}
+
+	// Start room cleaner and deadline revealer
 	cleanupCtx, cleanupCancel := context.WithCancel(context.Background())
 	roomCleaner.Start(cleanupCtx)
+	deadlineRevealer.Start(cleanupCtx)
+
+	// Prune archived sessions past their retention
+	if archiveService != nil {
+		archiveService.Start(cleanupCtx)
+	}
 
 	// Initialize primary adapters (driving)
 	roomHandler := connectrpc.NewRoomHandler(roomService)
@@ -83,6 +208,11 @@ func main() {
 	eventPath, eventSvc := eventHandler.Handler()
 	mux.Handle(eventPath, eventSvc)
 
+	if archiveService != nil {
+		archivePath, archiveSvc := connectrpc.NewArchiveHandler(archiveService).Handler()
+		mux.Handle(archivePath, archiveSvc)
+	}
+
 	// Health check endpoint
 	mux.HandleFunc("/health", func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -113,7 +243,7 @@ func main() {
 
 		log.Println("Shutting down server...")
 
-		// Stop room cleaner
+		// Stop room cleaner, deadline revealer and archive pruning
 		cleanupCancel()
 
 		// Close analytics database
@@ -123,6 +253,20 @@ func main() {
 			}
 		}
 
+		// Close room store
+		if persistentRoomRepo != nil {
+			if err := persistentRoomRepo.Close(); err != nil {
+				log.Printf("Error closing room store: %v", err)
+			}
+		}
+
+		// Close archive database
+		if archiveService != nil {
+			if err := archiveRepo.Close(); err != nil {
+				log.Printf("Error closing archive database: %v", err)
+			}
+		}
+
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
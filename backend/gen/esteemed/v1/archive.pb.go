@@ -0,0 +1,925 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.2
+// 	protoc        (unknown)
+// source: esteemed/v1/archive.proto
+
+package esteemedv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ListArchivedSessionsRequest filters the archive
+type ListArchivedSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                                      // Matches part of the room name (case-insensitive)
+	ClosedAfter   int64                  `protobuf:"varint,2,opt,name=closed_after,json=closedAfter,proto3" json:"closed_after,omitempty"`      // Unix timestamp (0 for no bound)
+	ClosedBefore  int64                  `protobuf:"varint,3,opt,name=closed_before,json=closedBefore,proto3" json:"closed_before,omitempty"`   // Unix timestamp (0 for no bound)
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                     // Defaults to 50, at most 200
+	SessionTokens []string               `protobuf:"bytes,5,rep,name=session_tokens,json=sessionTokens,proto3" json:"session_tokens,omitempty"` // Session tokens the caller held; lists their sessions, leaving out private rooms (at most 100)
+	AdminToken    string                 `protobuf:"bytes,6,opt,name=admin_token,json=adminToken,proto3" json:"admin_token,omitempty"`          // The deployment's archive admin token; lists every session instead
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArchivedSessionsRequest) Reset() {
+	*x = ListArchivedSessionsRequest{}
+	mi := &file_esteemed_v1_archive_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArchivedSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArchivedSessionsRequest) ProtoMessage() {}
+
+func (x *ListArchivedSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_archive_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArchivedSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListArchivedSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_archive_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListArchivedSessionsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListArchivedSessionsRequest) GetClosedAfter() int64 {
+	if x != nil {
+		return x.ClosedAfter
+	}
+	return 0
+}
+
+func (x *ListArchivedSessionsRequest) GetClosedBefore() int64 {
+	if x != nil {
+		return x.ClosedBefore
+	}
+	return 0
+}
+
+func (x *ListArchivedSessionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListArchivedSessionsRequest) GetSessionTokens() []string {
+	if x != nil {
+		return x.SessionTokens
+	}
+	return nil
+}
+
+func (x *ListArchivedSessionsRequest) GetAdminToken() string {
+	if x != nil {
+		return x.AdminToken
+	}
+	return ""
+}
+
+type ListArchivedSessionsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Sessions      []*ArchivedSessionSummary `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArchivedSessionsResponse) Reset() {
+	*x = ListArchivedSessionsResponse{}
+	mi := &file_esteemed_v1_archive_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArchivedSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArchivedSessionsResponse) ProtoMessage() {}
+
+func (x *ListArchivedSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_archive_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArchivedSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListArchivedSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_archive_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListArchivedSessionsResponse) GetSessions() []*ArchivedSessionSummary {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// ArchivedSessionSummary describes a closed session in listings
+type ArchivedSessionSummary struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RoomName         string                 `protobuf:"bytes,2,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	DotVoting        bool                   `protobuf:"varint,3,opt,name=dot_voting,json=dotVoting,proto3" json:"dot_voting,omitempty"`
+	CreatedAt        int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ClosedAt         int64                  `protobuf:"varint,5,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	CloseReason      string                 `protobuf:"bytes,6,opt,name=close_reason,json=closeReason,proto3" json:"close_reason,omitempty"`
+	ParticipantCount int32                  `protobuf:"varint,7,opt,name=participant_count,json=participantCount,proto3" json:"participant_count,omitempty"`
+	RoundCount       int32                  `protobuf:"varint,8,opt,name=round_count,json=roundCount,proto3" json:"round_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ArchivedSessionSummary) Reset() {
+	*x = ArchivedSessionSummary{}
+	mi := &file_esteemed_v1_archive_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivedSessionSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivedSessionSummary) ProtoMessage() {}
+
+func (x *ArchivedSessionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_archive_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivedSessionSummary.ProtoReflect.Descriptor instead.
+func (*ArchivedSessionSummary) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_archive_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ArchivedSessionSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ArchivedSessionSummary) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+func (x *ArchivedSessionSummary) GetDotVoting() bool {
+	if x != nil {
+		return x.DotVoting
+	}
+	return false
+}
+
+func (x *ArchivedSessionSummary) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ArchivedSessionSummary) GetClosedAt() int64 {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return 0
+}
+
+func (x *ArchivedSessionSummary) GetCloseReason() string {
+	if x != nil {
+		return x.CloseReason
+	}
+	return ""
+}
+
+func (x *ArchivedSessionSummary) GetParticipantCount() int32 {
+	if x != nil {
+		return x.ParticipantCount
+	}
+	return 0
+}
+
+func (x *ArchivedSessionSummary) GetRoundCount() int32 {
+	if x != nil {
+		return x.RoundCount
+	}
+	return 0
+}
+
+// GetArchivedSessionRequest fetches a closed session
+type GetArchivedSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SessionToken  string                 `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"` // A session token of one of the session's participants
+	AdminToken    string                 `protobuf:"bytes,3,opt,name=admin_token,json=adminToken,proto3" json:"admin_token,omitempty"`       // Or the deployment's archive admin token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArchivedSessionRequest) Reset() {
+	*x = GetArchivedSessionRequest{}
+	mi := &file_esteemed_v1_archive_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArchivedSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchivedSessionRequest) ProtoMessage() {}
+
+func (x *GetArchivedSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_archive_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchivedSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetArchivedSessionRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_archive_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetArchivedSessionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetArchivedSessionRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *GetArchivedSessionRequest) GetAdminToken() string {
+	if x != nil {
+		return x.AdminToken
+	}
+	return ""
+}
+
+type GetArchivedSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *ArchivedSession       `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArchivedSessionResponse) Reset() {
+	*x = GetArchivedSessionResponse{}
+	mi := &file_esteemed_v1_archive_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArchivedSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchivedSessionResponse) ProtoMessage() {}
+
+func (x *GetArchivedSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_archive_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchivedSessionResponse.ProtoReflect.Descriptor instead.
+func (*GetArchivedSessionResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_archive_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetArchivedSessionResponse) GetSession() *ArchivedSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+// ArchivedSession is a closed room with everything needed to export it
+type ArchivedSession struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Summary       *ArchivedSessionSummary `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	ParentRoomId  string                  `protobuf:"bytes,2,opt,name=parent_room_id,json=parentRoomId,proto3" json:"parent_room_id,omitempty"` // Set when the room was a breakout
+	Deck          []string                `protobuf:"bytes,3,rep,name=deck,proto3" json:"deck,omitempty"`                                       // Card values, or options in dot-voting rooms
+	Participants  []*ArchivedParticipant  `protobuf:"bytes,4,rep,name=participants,proto3" json:"participants,omitempty"`
+	Rounds        []*ArchivedRound        `protobuf:"bytes,5,rep,name=rounds,proto3" json:"rounds,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivedSession) Reset() {
+	*x = ArchivedSession{}
+	mi := &file_esteemed_v1_archive_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivedSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivedSession) ProtoMessage() {}
+
+func (x *ArchivedSession) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_archive_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivedSession.ProtoReflect.Descriptor instead.
+func (*ArchivedSession) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_archive_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ArchivedSession) GetSummary() *ArchivedSessionSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *ArchivedSession) GetParentRoomId() string {
+	if x != nil {
+		return x.ParentRoomId
+	}
+	return ""
+}
+
+func (x *ArchivedSession) GetDeck() []string {
+	if x != nil {
+		return x.Deck
+	}
+	return nil
+}
+
+func (x *ArchivedSession) GetParticipants() []*ArchivedParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *ArchivedSession) GetRounds() []*ArchivedRound {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+type ArchivedParticipant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	WasHost       bool                   `protobuf:"varint,2,opt,name=was_host,json=wasHost,proto3" json:"was_host,omitempty"`
+	IsSpectator   bool                   `protobuf:"varint,3,opt,name=is_spectator,json=isSpectator,proto3" json:"is_spectator,omitempty"`
+	IsPlaceholder bool                   `protobuf:"varint,4,opt,name=is_placeholder,json=isPlaceholder,proto3" json:"is_placeholder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivedParticipant) Reset() {
+	*x = ArchivedParticipant{}
+	mi := &file_esteemed_v1_archive_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivedParticipant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivedParticipant) ProtoMessage() {}
+
+func (x *ArchivedParticipant) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_archive_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivedParticipant.ProtoReflect.Descriptor instead.
+func (*ArchivedParticipant) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_archive_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ArchivedParticipant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ArchivedParticipant) GetWasHost() bool {
+	if x != nil {
+		return x.WasHost
+	}
+	return false
+}
+
+func (x *ArchivedParticipant) GetIsSpectator() bool {
+	if x != nil {
+		return x.IsSpectator
+	}
+	return false
+}
+
+func (x *ArchivedParticipant) GetIsPlaceholder() bool {
+	if x != nil {
+		return x.IsPlaceholder
+	}
+	return false
+}
+
+// ArchivedRound is the outcome of a revealed round
+type ArchivedRound struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RevealedAt     int64                  `protobuf:"varint,1,opt,name=revealed_at,json=revealedAt,proto3" json:"revealed_at,omitempty"`
+	SourceRoomName string                 `protobuf:"bytes,2,opt,name=source_room_name,json=sourceRoomName,proto3" json:"source_room_name,omitempty"` // Set for rounds merged from a breakout
+	Average        string                 `protobuf:"bytes,3,opt,name=average,proto3" json:"average,omitempty"`
+	Mode           string                 `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"` // Most common vote
+	HasConsensus   bool                   `protobuf:"varint,5,opt,name=has_consensus,json=hasConsensus,proto3" json:"has_consensus,omitempty"`
+	NumericAverage float64                `protobuf:"fixed64,6,opt,name=numeric_average,json=numericAverage,proto3" json:"numeric_average,omitempty"`
+	Votes          []*ArchivedVote        `protobuf:"bytes,7,rep,name=votes,proto3" json:"votes,omitempty"`
+	Ranking        []*ArchivedRanking     `protobuf:"bytes,8,rep,name=ranking,proto3" json:"ranking,omitempty"` // Dot-voting rooms only
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ArchivedRound) Reset() {
+	*x = ArchivedRound{}
+	mi := &file_esteemed_v1_archive_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivedRound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivedRound) ProtoMessage() {}
+
+func (x *ArchivedRound) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_archive_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivedRound.ProtoReflect.Descriptor instead.
+func (*ArchivedRound) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_archive_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ArchivedRound) GetRevealedAt() int64 {
+	if x != nil {
+		return x.RevealedAt
+	}
+	return 0
+}
+
+func (x *ArchivedRound) GetSourceRoomName() string {
+	if x != nil {
+		return x.SourceRoomName
+	}
+	return ""
+}
+
+func (x *ArchivedRound) GetAverage() string {
+	if x != nil {
+		return x.Average
+	}
+	return ""
+}
+
+func (x *ArchivedRound) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ArchivedRound) GetHasConsensus() bool {
+	if x != nil {
+		return x.HasConsensus
+	}
+	return false
+}
+
+func (x *ArchivedRound) GetNumericAverage() float64 {
+	if x != nil {
+		return x.NumericAverage
+	}
+	return 0
+}
+
+func (x *ArchivedRound) GetVotes() []*ArchivedVote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+func (x *ArchivedRound) GetRanking() []*ArchivedRanking {
+	if x != nil {
+		return x.Ranking
+	}
+	return nil
+}
+
+type ArchivedVote struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantName string                 `protobuf:"bytes,1,opt,name=participant_name,json=participantName,proto3" json:"participant_name,omitempty"`
+	Value           string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	IsProxy         bool                   `protobuf:"varint,3,opt,name=is_proxy,json=isProxy,proto3" json:"is_proxy,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ArchivedVote) Reset() {
+	*x = ArchivedVote{}
+	mi := &file_esteemed_v1_archive_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivedVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivedVote) ProtoMessage() {}
+
+func (x *ArchivedVote) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_archive_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivedVote.ProtoReflect.Descriptor instead.
+func (*ArchivedVote) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_archive_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ArchivedVote) GetParticipantName() string {
+	if x != nil {
+		return x.ParticipantName
+	}
+	return ""
+}
+
+func (x *ArchivedVote) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ArchivedVote) GetIsProxy() bool {
+	if x != nil {
+		return x.IsProxy
+	}
+	return false
+}
+
+type ArchivedRanking struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Option        string                 `protobuf:"bytes,1,opt,name=option,proto3" json:"option,omitempty"`
+	Dots          int32                  `protobuf:"varint,2,opt,name=dots,proto3" json:"dots,omitempty"`
+	Rank          int32                  `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivedRanking) Reset() {
+	*x = ArchivedRanking{}
+	mi := &file_esteemed_v1_archive_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivedRanking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivedRanking) ProtoMessage() {}
+
+func (x *ArchivedRanking) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_archive_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivedRanking.ProtoReflect.Descriptor instead.
+func (*ArchivedRanking) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_archive_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ArchivedRanking) GetOption() string {
+	if x != nil {
+		return x.Option
+	}
+	return ""
+}
+
+func (x *ArchivedRanking) GetDots() int32 {
+	if x != nil {
+		return x.Dots
+	}
+	return 0
+}
+
+func (x *ArchivedRanking) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+var File_esteemed_v1_archive_proto protoreflect.FileDescriptor
+
+var file_esteemed_v1_archive_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x65, 0x73, 0x74,
+	0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x22, 0xd9, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5f, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x08, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x91, 0x02, 0x0a, 0x16, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x64, 0x6f, 0x74, 0x5f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x64, 0x6f, 0x74, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x71, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x54, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x73,
+	0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x84, 0x02, 0x0a, 0x0f, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d,
+	0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x65, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x63, 0x6b, 0x12,
+	0x44, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x13, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x61, 0x73, 0x5f, 0x68, 0x6f, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x61, 0x73, 0x48, 0x6f, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x68,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x22, 0xbf, 0x02, 0x0a, 0x0d, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x28, 0x0a,
+	0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x68, 0x61,
+	0x73, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x75,
+	0x6d, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x41, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x6a, 0x0a, 0x0c,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x22, 0x51, 0x0a, 0x0f, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x64, 0x6f, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x32, 0xe4, 0x01, 0x0a, 0x0e,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6b,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x26, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x65, 0x73, 0x74, 0x65,
+	0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x76, 0x69, 0x63, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x65, 0x73, 0x74, 0x65,
+	0x65, 0x6d, 0x65, 0x64, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x67, 0x65, 0x6e,
+	0x2f, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x73, 0x74,
+	0x65, 0x65, 0x6d, 0x65, 0x64, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_esteemed_v1_archive_proto_rawDescOnce sync.Once
+	file_esteemed_v1_archive_proto_rawDescData = file_esteemed_v1_archive_proto_rawDesc
+)
+
+func file_esteemed_v1_archive_proto_rawDescGZIP() []byte {
+	file_esteemed_v1_archive_proto_rawDescOnce.Do(func() {
+		file_esteemed_v1_archive_proto_rawDescData = protoimpl.X.CompressGZIP(file_esteemed_v1_archive_proto_rawDescData)
+	})
+	return file_esteemed_v1_archive_proto_rawDescData
+}
+
+var file_esteemed_v1_archive_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_esteemed_v1_archive_proto_goTypes = []any{
+	(*ListArchivedSessionsRequest)(nil),  // 0: esteemed.v1.ListArchivedSessionsRequest
+	(*ListArchivedSessionsResponse)(nil), // 1: esteemed.v1.ListArchivedSessionsResponse
+	(*ArchivedSessionSummary)(nil),       // 2: esteemed.v1.ArchivedSessionSummary
+	(*GetArchivedSessionRequest)(nil),    // 3: esteemed.v1.GetArchivedSessionRequest
+	(*GetArchivedSessionResponse)(nil),   // 4: esteemed.v1.GetArchivedSessionResponse
+	(*ArchivedSession)(nil),              // 5: esteemed.v1.ArchivedSession
+	(*ArchivedParticipant)(nil),          // 6: esteemed.v1.ArchivedParticipant
+	(*ArchivedRound)(nil),                // 7: esteemed.v1.ArchivedRound
+	(*ArchivedVote)(nil),                 // 8: esteemed.v1.ArchivedVote
+	(*ArchivedRanking)(nil),              // 9: esteemed.v1.ArchivedRanking
+}
+var file_esteemed_v1_archive_proto_depIdxs = []int32{
+	2, // 0: esteemed.v1.ListArchivedSessionsResponse.sessions:type_name -> esteemed.v1.ArchivedSessionSummary
+	5, // 1: esteemed.v1.GetArchivedSessionResponse.session:type_name -> esteemed.v1.ArchivedSession
+	2, // 2: esteemed.v1.ArchivedSession.summary:type_name -> esteemed.v1.ArchivedSessionSummary
+	6, // 3: esteemed.v1.ArchivedSession.participants:type_name -> esteemed.v1.ArchivedParticipant
+	7, // 4: esteemed.v1.ArchivedSession.rounds:type_name -> esteemed.v1.ArchivedRound
+	8, // 5: esteemed.v1.ArchivedRound.votes:type_name -> esteemed.v1.ArchivedVote
+	9, // 6: esteemed.v1.ArchivedRound.ranking:type_name -> esteemed.v1.ArchivedRanking
+	0, // 7: esteemed.v1.ArchiveService.ListArchivedSessions:input_type -> esteemed.v1.ListArchivedSessionsRequest
+	3, // 8: esteemed.v1.ArchiveService.GetArchivedSession:input_type -> esteemed.v1.GetArchivedSessionRequest
+	1, // 9: esteemed.v1.ArchiveService.ListArchivedSessions:output_type -> esteemed.v1.ListArchivedSessionsResponse
+	4, // 10: esteemed.v1.ArchiveService.GetArchivedSession:output_type -> esteemed.v1.GetArchivedSessionResponse
+	9, // [9:11] is the sub-list for method output_type
+	7, // [7:9] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_esteemed_v1_archive_proto_init() }
+func file_esteemed_v1_archive_proto_init() {
+	if File_esteemed_v1_archive_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_esteemed_v1_archive_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_esteemed_v1_archive_proto_goTypes,
+		DependencyIndexes: file_esteemed_v1_archive_proto_depIdxs,
+		MessageInfos:      file_esteemed_v1_archive_proto_msgTypes,
+	}.Build()
+	File_esteemed_v1_archive_proto = out.File
+	file_esteemed_v1_archive_proto_rawDesc = nil
+	file_esteemed_v1_archive_proto_goTypes = nil
+	file_esteemed_v1_archive_proto_depIdxs = nil
+}
@@ -0,0 +1,142 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: esteemed/v1/archive.proto
+
+package esteemedv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/vicmanager/esteemed/backend/gen/esteemed/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ArchiveServiceName is the fully-qualified name of the ArchiveService service.
+	ArchiveServiceName = "esteemed.v1.ArchiveService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ArchiveServiceListArchivedSessionsProcedure is the fully-qualified name of the ArchiveService's
+	// ListArchivedSessions RPC.
+	ArchiveServiceListArchivedSessionsProcedure = "/esteemed.v1.ArchiveService/ListArchivedSessions"
+	// ArchiveServiceGetArchivedSessionProcedure is the fully-qualified name of the ArchiveService's
+	// GetArchivedSession RPC.
+	ArchiveServiceGetArchivedSessionProcedure = "/esteemed.v1.ArchiveService/GetArchivedSession"
+)
+
+// ArchiveServiceClient is a client for the esteemed.v1.ArchiveService service.
+type ArchiveServiceClient interface {
+	// ListArchivedSessions returns the caller's closed sessions, most recently closed first
+	ListArchivedSessions(context.Context, *connect.Request[v1.ListArchivedSessionsRequest]) (*connect.Response[v1.ListArchivedSessionsResponse], error)
+	// GetArchivedSession returns a closed session the caller took part in, with its participants, deck and rounds
+	GetArchivedSession(context.Context, *connect.Request[v1.GetArchivedSessionRequest]) (*connect.Response[v1.GetArchivedSessionResponse], error)
+}
+
+// NewArchiveServiceClient constructs a client for the esteemed.v1.ArchiveService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewArchiveServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ArchiveServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	archiveServiceMethods := v1.File_esteemed_v1_archive_proto.Services().ByName("ArchiveService").Methods()
+	return &archiveServiceClient{
+		listArchivedSessions: connect.NewClient[v1.ListArchivedSessionsRequest, v1.ListArchivedSessionsResponse](
+			httpClient,
+			baseURL+ArchiveServiceListArchivedSessionsProcedure,
+			connect.WithSchema(archiveServiceMethods.ByName("ListArchivedSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		getArchivedSession: connect.NewClient[v1.GetArchivedSessionRequest, v1.GetArchivedSessionResponse](
+			httpClient,
+			baseURL+ArchiveServiceGetArchivedSessionProcedure,
+			connect.WithSchema(archiveServiceMethods.ByName("GetArchivedSession")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// archiveServiceClient implements ArchiveServiceClient.
+type archiveServiceClient struct {
+	listArchivedSessions *connect.Client[v1.ListArchivedSessionsRequest, v1.ListArchivedSessionsResponse]
+	getArchivedSession   *connect.Client[v1.GetArchivedSessionRequest, v1.GetArchivedSessionResponse]
+}
+
+// ListArchivedSessions calls esteemed.v1.ArchiveService.ListArchivedSessions.
+func (c *archiveServiceClient) ListArchivedSessions(ctx context.Context, req *connect.Request[v1.ListArchivedSessionsRequest]) (*connect.Response[v1.ListArchivedSessionsResponse], error) {
+	return c.listArchivedSessions.CallUnary(ctx, req)
+}
+
+// GetArchivedSession calls esteemed.v1.ArchiveService.GetArchivedSession.
+func (c *archiveServiceClient) GetArchivedSession(ctx context.Context, req *connect.Request[v1.GetArchivedSessionRequest]) (*connect.Response[v1.GetArchivedSessionResponse], error) {
+	return c.getArchivedSession.CallUnary(ctx, req)
+}
+
+// ArchiveServiceHandler is an implementation of the esteemed.v1.ArchiveService service.
+type ArchiveServiceHandler interface {
+	// ListArchivedSessions returns the caller's closed sessions, most recently closed first
+	ListArchivedSessions(context.Context, *connect.Request[v1.ListArchivedSessionsRequest]) (*connect.Response[v1.ListArchivedSessionsResponse], error)
+	// GetArchivedSession returns a closed session the caller took part in, with its participants, deck and rounds
+	GetArchivedSession(context.Context, *connect.Request[v1.GetArchivedSessionRequest]) (*connect.Response[v1.GetArchivedSessionResponse], error)
+}
+
+// NewArchiveServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewArchiveServiceHandler(svc ArchiveServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	archiveServiceMethods := v1.File_esteemed_v1_archive_proto.Services().ByName("ArchiveService").Methods()
+	archiveServiceListArchivedSessionsHandler := connect.NewUnaryHandler(
+		ArchiveServiceListArchivedSessionsProcedure,
+		svc.ListArchivedSessions,
+		connect.WithSchema(archiveServiceMethods.ByName("ListArchivedSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceGetArchivedSessionHandler := connect.NewUnaryHandler(
+		ArchiveServiceGetArchivedSessionProcedure,
+		svc.GetArchivedSession,
+		connect.WithSchema(archiveServiceMethods.ByName("GetArchivedSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/esteemed.v1.ArchiveService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ArchiveServiceListArchivedSessionsProcedure:
+			archiveServiceListArchivedSessionsHandler.ServeHTTP(w, r)
+		case ArchiveServiceGetArchivedSessionProcedure:
+			archiveServiceGetArchivedSessionHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedArchiveServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedArchiveServiceHandler struct{}
+
+func (UnimplementedArchiveServiceHandler) ListArchivedSessions(context.Context, *connect.Request[v1.ListArchivedSessionsRequest]) (*connect.Response[v1.ListArchivedSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.ArchiveService.ListArchivedSessions is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) GetArchivedSession(context.Context, *connect.Request[v1.GetArchivedSessionRequest]) (*connect.Response[v1.GetArchivedSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.ArchiveService.GetArchivedSession is not implemented"))
+}
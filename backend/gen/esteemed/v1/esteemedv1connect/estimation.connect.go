@@ -36,6 +36,12 @@ const (
 	// EstimationServiceCastVoteProcedure is the fully-qualified name of the EstimationService's
 	// CastVote RPC.
 	EstimationServiceCastVoteProcedure = "/esteemed.v1.EstimationService/CastVote"
+	// EstimationServiceCastProxyVoteProcedure is the fully-qualified name of the EstimationService's
+	// CastProxyVote RPC.
+	EstimationServiceCastProxyVoteProcedure = "/esteemed.v1.EstimationService/CastProxyVote"
+	// EstimationServiceCastDotsProcedure is the fully-qualified name of the EstimationService's
+	// CastDots RPC.
+	EstimationServiceCastDotsProcedure = "/esteemed.v1.EstimationService/CastDots"
 	// EstimationServiceRevealVotesProcedure is the fully-qualified name of the EstimationService's
 	// RevealVotes RPC.
 	EstimationServiceRevealVotesProcedure = "/esteemed.v1.EstimationService/RevealVotes"
@@ -45,6 +51,15 @@ const (
 	// EstimationServiceStartRoundProcedure is the fully-qualified name of the EstimationService's
 	// StartRound RPC.
 	EstimationServiceStartRoundProcedure = "/esteemed.v1.EstimationService/StartRound"
+	// EstimationServiceUndoLastTransitionProcedure is the fully-qualified name of the
+	// EstimationService's UndoLastTransition RPC.
+	EstimationServiceUndoLastTransitionProcedure = "/esteemed.v1.EstimationService/UndoLastTransition"
+	// EstimationServiceSetVotingDeadlineProcedure is the fully-qualified name of the
+	// EstimationService's SetVotingDeadline RPC.
+	EstimationServiceSetVotingDeadlineProcedure = "/esteemed.v1.EstimationService/SetVotingDeadline"
+	// EstimationServiceGetRoundHistoryProcedure is the fully-qualified name of the EstimationService's
+	// GetRoundHistory RPC.
+	EstimationServiceGetRoundHistoryProcedure = "/esteemed.v1.EstimationService/GetRoundHistory"
 	// EstimationServiceWatchVotesProcedure is the fully-qualified name of the EstimationService's
 	// WatchVotes RPC.
 	EstimationServiceWatchVotesProcedure = "/esteemed.v1.EstimationService/WatchVotes"
@@ -54,12 +69,22 @@ const (
 type EstimationServiceClient interface {
 	// CastVote submits a vote (hidden until reveal)
 	CastVote(context.Context, *connect.Request[v1.CastVoteRequest]) (*connect.Response[v1.CastVoteResponse], error)
-	// RevealVotes makes all votes visible (host only)
+	// CastProxyVote records a vote on behalf of an absent participant (host or facilitator)
+	CastProxyVote(context.Context, *connect.Request[v1.CastProxyVoteRequest]) (*connect.Response[v1.CastProxyVoteResponse], error)
+	// CastDots places a participant's dots in a dot-voting room (hidden until reveal)
+	CastDots(context.Context, *connect.Request[v1.CastDotsRequest]) (*connect.Response[v1.CastDotsResponse], error)
+	// RevealVotes makes all votes visible (host or facilitator)
 	RevealVotes(context.Context, *connect.Request[v1.RevealVotesRequest]) (*connect.Response[v1.RevealVotesResponse], error)
 	// ResetRound clears all votes and starts a new round
 	ResetRound(context.Context, *connect.Request[v1.ResetRoundRequest]) (*connect.Response[v1.ResetRoundResponse], error)
-	// StartRound begins a new voting round (host only)
+	// StartRound begins a new voting round (host or facilitator)
 	StartRound(context.Context, *connect.Request[v1.StartRoundRequest]) (*connect.Response[v1.StartRoundResponse], error)
+	// UndoLastTransition restores the votes and state from before the last start, reveal or reset (host or facilitator)
+	UndoLastTransition(context.Context, *connect.Request[v1.UndoLastTransitionRequest]) (*connect.Response[v1.UndoLastTransitionResponse], error)
+	// SetVotingDeadline sets when the current async round is revealed automatically (host or facilitator)
+	SetVotingDeadline(context.Context, *connect.Request[v1.SetVotingDeadlineRequest]) (*connect.Response[v1.SetVotingDeadlineResponse], error)
+	// GetRoundHistory returns the revealed rounds of a room, including merged breakout rounds
+	GetRoundHistory(context.Context, *connect.Request[v1.GetRoundHistoryRequest]) (*connect.Response[v1.GetRoundHistoryResponse], error)
 	// WatchVotes streams real-time vote status and results
 	WatchVotes(context.Context, *connect.Request[v1.WatchVotesRequest]) (*connect.ServerStreamForClient[v1.VoteEvent], error)
 }
@@ -81,6 +106,18 @@ func NewEstimationServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(estimationServiceMethods.ByName("CastVote")),
 			connect.WithClientOptions(opts...),
 		),
+		castProxyVote: connect.NewClient[v1.CastProxyVoteRequest, v1.CastProxyVoteResponse](
+			httpClient,
+			baseURL+EstimationServiceCastProxyVoteProcedure,
+			connect.WithSchema(estimationServiceMethods.ByName("CastProxyVote")),
+			connect.WithClientOptions(opts...),
+		),
+		castDots: connect.NewClient[v1.CastDotsRequest, v1.CastDotsResponse](
+			httpClient,
+			baseURL+EstimationServiceCastDotsProcedure,
+			connect.WithSchema(estimationServiceMethods.ByName("CastDots")),
+			connect.WithClientOptions(opts...),
+		),
 		revealVotes: connect.NewClient[v1.RevealVotesRequest, v1.RevealVotesResponse](
 			httpClient,
 			baseURL+EstimationServiceRevealVotesProcedure,
@@ -99,6 +136,24 @@ func NewEstimationServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(estimationServiceMethods.ByName("StartRound")),
 			connect.WithClientOptions(opts...),
 		),
+		undoLastTransition: connect.NewClient[v1.UndoLastTransitionRequest, v1.UndoLastTransitionResponse](
+			httpClient,
+			baseURL+EstimationServiceUndoLastTransitionProcedure,
+			connect.WithSchema(estimationServiceMethods.ByName("UndoLastTransition")),
+			connect.WithClientOptions(opts...),
+		),
+		setVotingDeadline: connect.NewClient[v1.SetVotingDeadlineRequest, v1.SetVotingDeadlineResponse](
+			httpClient,
+			baseURL+EstimationServiceSetVotingDeadlineProcedure,
+			connect.WithSchema(estimationServiceMethods.ByName("SetVotingDeadline")),
+			connect.WithClientOptions(opts...),
+		),
+		getRoundHistory: connect.NewClient[v1.GetRoundHistoryRequest, v1.GetRoundHistoryResponse](
+			httpClient,
+			baseURL+EstimationServiceGetRoundHistoryProcedure,
+			connect.WithSchema(estimationServiceMethods.ByName("GetRoundHistory")),
+			connect.WithClientOptions(opts...),
+		),
 		watchVotes: connect.NewClient[v1.WatchVotesRequest, v1.VoteEvent](
 			httpClient,
 			baseURL+EstimationServiceWatchVotesProcedure,
@@ -110,11 +165,16 @@ func NewEstimationServiceClient(httpClient connect.HTTPClient, baseURL string, o
 
 // estimationServiceClient implements EstimationServiceClient.
 type estimationServiceClient struct {
-	castVote    *connect.Client[v1.CastVoteRequest, v1.CastVoteResponse]
-	revealVotes *connect.Client[v1.RevealVotesRequest, v1.RevealVotesResponse]
-	resetRound  *connect.Client[v1.ResetRoundRequest, v1.ResetRoundResponse]
-	startRound  *connect.Client[v1.StartRoundRequest, v1.StartRoundResponse]
-	watchVotes  *connect.Client[v1.WatchVotesRequest, v1.VoteEvent]
+	castVote           *connect.Client[v1.CastVoteRequest, v1.CastVoteResponse]
+	castProxyVote      *connect.Client[v1.CastProxyVoteRequest, v1.CastProxyVoteResponse]
+	castDots           *connect.Client[v1.CastDotsRequest, v1.CastDotsResponse]
+	revealVotes        *connect.Client[v1.RevealVotesRequest, v1.RevealVotesResponse]
+	resetRound         *connect.Client[v1.ResetRoundRequest, v1.ResetRoundResponse]
+	startRound         *connect.Client[v1.StartRoundRequest, v1.StartRoundResponse]
+	undoLastTransition *connect.Client[v1.UndoLastTransitionRequest, v1.UndoLastTransitionResponse]
+	setVotingDeadline  *connect.Client[v1.SetVotingDeadlineRequest, v1.SetVotingDeadlineResponse]
+	getRoundHistory    *connect.Client[v1.GetRoundHistoryRequest, v1.GetRoundHistoryResponse]
+	watchVotes         *connect.Client[v1.WatchVotesRequest, v1.VoteEvent]
 }
 
 // CastVote calls esteemed.v1.EstimationService.CastVote.
@@ -122,6 +182,16 @@ func (c *estimationServiceClient) CastVote(ctx context.Context, req *connect.Req
 	return c.castVote.CallUnary(ctx, req)
 }
 
+// CastProxyVote calls esteemed.v1.EstimationService.CastProxyVote.
+func (c *estimationServiceClient) CastProxyVote(ctx context.Context, req *connect.Request[v1.CastProxyVoteRequest]) (*connect.Response[v1.CastProxyVoteResponse], error) {
+	return c.castProxyVote.CallUnary(ctx, req)
+}
+
+// CastDots calls esteemed.v1.EstimationService.CastDots.
+func (c *estimationServiceClient) CastDots(ctx context.Context, req *connect.Request[v1.CastDotsRequest]) (*connect.Response[v1.CastDotsResponse], error) {
+	return c.castDots.CallUnary(ctx, req)
+}
+
 // RevealVotes calls esteemed.v1.EstimationService.RevealVotes.
 func (c *estimationServiceClient) RevealVotes(ctx context.Context, req *connect.Request[v1.RevealVotesRequest]) (*connect.Response[v1.RevealVotesResponse], error) {
 	return c.revealVotes.CallUnary(ctx, req)
@@ -137,6 +207,21 @@ func (c *estimationServiceClient) StartRound(ctx context.Context, req *connect.R
 	return c.startRound.CallUnary(ctx, req)
 }
 
+// UndoLastTransition calls esteemed.v1.EstimationService.UndoLastTransition.
+func (c *estimationServiceClient) UndoLastTransition(ctx context.Context, req *connect.Request[v1.UndoLastTransitionRequest]) (*connect.Response[v1.UndoLastTransitionResponse], error) {
+	return c.undoLastTransition.CallUnary(ctx, req)
+}
+
+// SetVotingDeadline calls esteemed.v1.EstimationService.SetVotingDeadline.
+func (c *estimationServiceClient) SetVotingDeadline(ctx context.Context, req *connect.Request[v1.SetVotingDeadlineRequest]) (*connect.Response[v1.SetVotingDeadlineResponse], error) {
+	return c.setVotingDeadline.CallUnary(ctx, req)
+}
+
+// GetRoundHistory calls esteemed.v1.EstimationService.GetRoundHistory.
+func (c *estimationServiceClient) GetRoundHistory(ctx context.Context, req *connect.Request[v1.GetRoundHistoryRequest]) (*connect.Response[v1.GetRoundHistoryResponse], error) {
+	return c.getRoundHistory.CallUnary(ctx, req)
+}
+
 // WatchVotes calls esteemed.v1.EstimationService.WatchVotes.
 func (c *estimationServiceClient) WatchVotes(ctx context.Context, req *connect.Request[v1.WatchVotesRequest]) (*connect.ServerStreamForClient[v1.VoteEvent], error) {
 	return c.watchVotes.CallServerStream(ctx, req)
@@ -146,12 +231,22 @@ func (c *estimationServiceClient) WatchVotes(ctx context.Context, req *connect.R
 type EstimationServiceHandler interface {
 	// CastVote submits a vote (hidden until reveal)
 	CastVote(context.Context, *connect.Request[v1.CastVoteRequest]) (*connect.Response[v1.CastVoteResponse], error)
-	// RevealVotes makes all votes visible (host only)
+	// CastProxyVote records a vote on behalf of an absent participant (host or facilitator)
+	CastProxyVote(context.Context, *connect.Request[v1.CastProxyVoteRequest]) (*connect.Response[v1.CastProxyVoteResponse], error)
+	// CastDots places a participant's dots in a dot-voting room (hidden until reveal)
+	CastDots(context.Context, *connect.Request[v1.CastDotsRequest]) (*connect.Response[v1.CastDotsResponse], error)
+	// RevealVotes makes all votes visible (host or facilitator)
 	RevealVotes(context.Context, *connect.Request[v1.RevealVotesRequest]) (*connect.Response[v1.RevealVotesResponse], error)
 	// ResetRound clears all votes and starts a new round
 	ResetRound(context.Context, *connect.Request[v1.ResetRoundRequest]) (*connect.Response[v1.ResetRoundResponse], error)
-	// StartRound begins a new voting round (host only)
+	// StartRound begins a new voting round (host or facilitator)
 	StartRound(context.Context, *connect.Request[v1.StartRoundRequest]) (*connect.Response[v1.StartRoundResponse], error)
+	// UndoLastTransition restores the votes and state from before the last start, reveal or reset (host or facilitator)
+	UndoLastTransition(context.Context, *connect.Request[v1.UndoLastTransitionRequest]) (*connect.Response[v1.UndoLastTransitionResponse], error)
+	// SetVotingDeadline sets when the current async round is revealed automatically (host or facilitator)
+	SetVotingDeadline(context.Context, *connect.Request[v1.SetVotingDeadlineRequest]) (*connect.Response[v1.SetVotingDeadlineResponse], error)
+	// GetRoundHistory returns the revealed rounds of a room, including merged breakout rounds
+	GetRoundHistory(context.Context, *connect.Request[v1.GetRoundHistoryRequest]) (*connect.Response[v1.GetRoundHistoryResponse], error)
 	// WatchVotes streams real-time vote status and results
 	WatchVotes(context.Context, *connect.Request[v1.WatchVotesRequest], *connect.ServerStream[v1.VoteEvent]) error
 }
@@ -169,6 +264,18 @@ func NewEstimationServiceHandler(svc EstimationServiceHandler, opts ...connect.H
 		connect.WithSchema(estimationServiceMethods.ByName("CastVote")),
 		connect.WithHandlerOptions(opts...),
 	)
+	estimationServiceCastProxyVoteHandler := connect.NewUnaryHandler(
+		EstimationServiceCastProxyVoteProcedure,
+		svc.CastProxyVote,
+		connect.WithSchema(estimationServiceMethods.ByName("CastProxyVote")),
+		connect.WithHandlerOptions(opts...),
+	)
+	estimationServiceCastDotsHandler := connect.NewUnaryHandler(
+		EstimationServiceCastDotsProcedure,
+		svc.CastDots,
+		connect.WithSchema(estimationServiceMethods.ByName("CastDots")),
+		connect.WithHandlerOptions(opts...),
+	)
 	estimationServiceRevealVotesHandler := connect.NewUnaryHandler(
 		EstimationServiceRevealVotesProcedure,
 		svc.RevealVotes,
@@ -187,6 +294,24 @@ func NewEstimationServiceHandler(svc EstimationServiceHandler, opts ...connect.H
 		connect.WithSchema(estimationServiceMethods.ByName("StartRound")),
 		connect.WithHandlerOptions(opts...),
 	)
+	estimationServiceUndoLastTransitionHandler := connect.NewUnaryHandler(
+		EstimationServiceUndoLastTransitionProcedure,
+		svc.UndoLastTransition,
+		connect.WithSchema(estimationServiceMethods.ByName("UndoLastTransition")),
+		connect.WithHandlerOptions(opts...),
+	)
+	estimationServiceSetVotingDeadlineHandler := connect.NewUnaryHandler(
+		EstimationServiceSetVotingDeadlineProcedure,
+		svc.SetVotingDeadline,
+		connect.WithSchema(estimationServiceMethods.ByName("SetVotingDeadline")),
+		connect.WithHandlerOptions(opts...),
+	)
+	estimationServiceGetRoundHistoryHandler := connect.NewUnaryHandler(
+		EstimationServiceGetRoundHistoryProcedure,
+		svc.GetRoundHistory,
+		connect.WithSchema(estimationServiceMethods.ByName("GetRoundHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
 	estimationServiceWatchVotesHandler := connect.NewServerStreamHandler(
 		EstimationServiceWatchVotesProcedure,
 		svc.WatchVotes,
@@ -197,12 +322,22 @@ func NewEstimationServiceHandler(svc EstimationServiceHandler, opts ...connect.H
 		switch r.URL.Path {
 		case EstimationServiceCastVoteProcedure:
 			estimationServiceCastVoteHandler.ServeHTTP(w, r)
+		case EstimationServiceCastProxyVoteProcedure:
+			estimationServiceCastProxyVoteHandler.ServeHTTP(w, r)
+		case EstimationServiceCastDotsProcedure:
+			estimationServiceCastDotsHandler.ServeHTTP(w, r)
 		case EstimationServiceRevealVotesProcedure:
 			estimationServiceRevealVotesHandler.ServeHTTP(w, r)
 		case EstimationServiceResetRoundProcedure:
 			estimationServiceResetRoundHandler.ServeHTTP(w, r)
 		case EstimationServiceStartRoundProcedure:
 			estimationServiceStartRoundHandler.ServeHTTP(w, r)
+		case EstimationServiceUndoLastTransitionProcedure:
+			estimationServiceUndoLastTransitionHandler.ServeHTTP(w, r)
+		case EstimationServiceSetVotingDeadlineProcedure:
+			estimationServiceSetVotingDeadlineHandler.ServeHTTP(w, r)
+		case EstimationServiceGetRoundHistoryProcedure:
+			estimationServiceGetRoundHistoryHandler.ServeHTTP(w, r)
 		case EstimationServiceWatchVotesProcedure:
 			estimationServiceWatchVotesHandler.ServeHTTP(w, r)
 		default:
@@ -218,6 +353,14 @@ func (UnimplementedEstimationServiceHandler) CastVote(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.EstimationService.CastVote is not implemented"))
 }
 
+func (UnimplementedEstimationServiceHandler) CastProxyVote(context.Context, *connect.Request[v1.CastProxyVoteRequest]) (*connect.Response[v1.CastProxyVoteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.EstimationService.CastProxyVote is not implemented"))
+}
+
+func (UnimplementedEstimationServiceHandler) CastDots(context.Context, *connect.Request[v1.CastDotsRequest]) (*connect.Response[v1.CastDotsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.EstimationService.CastDots is not implemented"))
+}
+
 func (UnimplementedEstimationServiceHandler) RevealVotes(context.Context, *connect.Request[v1.RevealVotesRequest]) (*connect.Response[v1.RevealVotesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.EstimationService.RevealVotes is not implemented"))
 }
@@ -230,6 +373,18 @@ func (UnimplementedEstimationServiceHandler) StartRound(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.EstimationService.StartRound is not implemented"))
 }
 
+func (UnimplementedEstimationServiceHandler) UndoLastTransition(context.Context, *connect.Request[v1.UndoLastTransitionRequest]) (*connect.Response[v1.UndoLastTransitionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.EstimationService.UndoLastTransition is not implemented"))
+}
+
+func (UnimplementedEstimationServiceHandler) SetVotingDeadline(context.Context, *connect.Request[v1.SetVotingDeadlineRequest]) (*connect.Response[v1.SetVotingDeadlineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.EstimationService.SetVotingDeadline is not implemented"))
+}
+
+func (UnimplementedEstimationServiceHandler) GetRoundHistory(context.Context, *connect.Request[v1.GetRoundHistoryRequest]) (*connect.Response[v1.GetRoundHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.EstimationService.GetRoundHistory is not implemented"))
+}
+
 func (UnimplementedEstimationServiceHandler) WatchVotes(context.Context, *connect.Request[v1.WatchVotesRequest], *connect.ServerStream[v1.VoteEvent]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.EstimationService.WatchVotes is not implemented"))
 }
@@ -43,17 +43,87 @@ const (
 	RoomServiceLeaveRoomProcedure = "/esteemed.v1.RoomService/LeaveRoom"
 	// RoomServiceWatchRoomProcedure is the fully-qualified name of the RoomService's WatchRoom RPC.
 	RoomServiceWatchRoomProcedure = "/esteemed.v1.RoomService/WatchRoom"
+	// RoomServiceWatchRoomStateProcedure is the fully-qualified name of the RoomService's
+	// WatchRoomState RPC.
+	RoomServiceWatchRoomStateProcedure = "/esteemed.v1.RoomService/WatchRoomState"
+	// RoomServiceGetRoomProcedure is the fully-qualified name of the RoomService's GetRoom RPC.
+	RoomServiceGetRoomProcedure = "/esteemed.v1.RoomService/GetRoom"
+	// RoomServiceCreateViewTokenProcedure is the fully-qualified name of the RoomService's
+	// CreateViewToken RPC.
+	RoomServiceCreateViewTokenProcedure = "/esteemed.v1.RoomService/CreateViewToken"
+	// RoomServiceRevokeViewTokenProcedure is the fully-qualified name of the RoomService's
+	// RevokeViewToken RPC.
+	RoomServiceRevokeViewTokenProcedure = "/esteemed.v1.RoomService/RevokeViewToken"
 	// RoomServiceKickParticipantProcedure is the fully-qualified name of the RoomService's
 	// KickParticipant RPC.
 	RoomServiceKickParticipantProcedure = "/esteemed.v1.RoomService/KickParticipant"
+	// RoomServiceListBansProcedure is the fully-qualified name of the RoomService's ListBans RPC.
+	RoomServiceListBansProcedure = "/esteemed.v1.RoomService/ListBans"
+	// RoomServiceLiftBanProcedure is the fully-qualified name of the RoomService's LiftBan RPC.
+	RoomServiceLiftBanProcedure = "/esteemed.v1.RoomService/LiftBan"
 	// RoomServiceTransferOwnershipProcedure is the fully-qualified name of the RoomService's
 	// TransferOwnership RPC.
 	RoomServiceTransferOwnershipProcedure = "/esteemed.v1.RoomService/TransferOwnership"
+	// RoomServiceGrantRoleProcedure is the fully-qualified name of the RoomService's GrantRole RPC.
+	RoomServiceGrantRoleProcedure = "/esteemed.v1.RoomService/GrantRole"
+	// RoomServiceRevokeRoleProcedure is the fully-qualified name of the RoomService's RevokeRole RPC.
+	RoomServiceRevokeRoleProcedure = "/esteemed.v1.RoomService/RevokeRole"
+	// RoomServiceUpdateParticipantProcedure is the fully-qualified name of the RoomService's
+	// UpdateParticipant RPC.
+	RoomServiceUpdateParticipantProcedure = "/esteemed.v1.RoomService/UpdateParticipant"
+	// RoomServiceSetPasscodeProcedure is the fully-qualified name of the RoomService's SetPasscode RPC.
+	RoomServiceSetPasscodeProcedure = "/esteemed.v1.RoomService/SetPasscode"
+	// RoomServiceSetVisibilityProcedure is the fully-qualified name of the RoomService's SetVisibility
+	// RPC.
+	RoomServiceSetVisibilityProcedure = "/esteemed.v1.RoomService/SetVisibility"
+	// RoomServiceCreateInviteProcedure is the fully-qualified name of the RoomService's CreateInvite
+	// RPC.
+	RoomServiceCreateInviteProcedure = "/esteemed.v1.RoomService/CreateInvite"
+	// RoomServiceRevokeInviteProcedure is the fully-qualified name of the RoomService's RevokeInvite
+	// RPC.
+	RoomServiceRevokeInviteProcedure = "/esteemed.v1.RoomService/RevokeInvite"
+	// RoomServiceSetRoomLockedProcedure is the fully-qualified name of the RoomService's SetRoomLocked
+	// RPC.
+	RoomServiceSetRoomLockedProcedure = "/esteemed.v1.RoomService/SetRoomLocked"
+	// RoomServiceSetWaitingRoomProcedure is the fully-qualified name of the RoomService's
+	// SetWaitingRoom RPC.
+	RoomServiceSetWaitingRoomProcedure = "/esteemed.v1.RoomService/SetWaitingRoom"
+	// RoomServiceAdmitParticipantProcedure is the fully-qualified name of the RoomService's
+	// AdmitParticipant RPC.
+	RoomServiceAdmitParticipantProcedure = "/esteemed.v1.RoomService/AdmitParticipant"
+	// RoomServiceRejectParticipantProcedure is the fully-qualified name of the RoomService's
+	// RejectParticipant RPC.
+	RoomServiceRejectParticipantProcedure = "/esteemed.v1.RoomService/RejectParticipant"
+	// RoomServiceSetParticipantLimitsProcedure is the fully-qualified name of the RoomService's
+	// SetParticipantLimits RPC.
+	RoomServiceSetParticipantLimitsProcedure = "/esteemed.v1.RoomService/SetParticipantLimits"
+	// RoomServiceSetSpectatorProcedure is the fully-qualified name of the RoomService's SetSpectator
+	// RPC.
+	RoomServiceSetSpectatorProcedure = "/esteemed.v1.RoomService/SetSpectator"
+	// RoomServiceSetFacilitatorRotationProcedure is the fully-qualified name of the RoomService's
+	// SetFacilitatorRotation RPC.
+	RoomServiceSetFacilitatorRotationProcedure = "/esteemed.v1.RoomService/SetFacilitatorRotation"
+	// RoomServiceSetHostSuccessionProcedure is the fully-qualified name of the RoomService's
+	// SetHostSuccession RPC.
+	RoomServiceSetHostSuccessionProcedure = "/esteemed.v1.RoomService/SetHostSuccession"
+	// RoomServiceClaimHostProcedure is the fully-qualified name of the RoomService's ClaimHost RPC.
+	RoomServiceClaimHostProcedure = "/esteemed.v1.RoomService/ClaimHost"
+	// RoomServiceKeepAliveProcedure is the fully-qualified name of the RoomService's KeepAlive RPC.
+	RoomServiceKeepAliveProcedure = "/esteemed.v1.RoomService/KeepAlive"
+	// RoomServiceCreateBreakoutProcedure is the fully-qualified name of the RoomService's
+	// CreateBreakout RPC.
+	RoomServiceCreateBreakoutProcedure = "/esteemed.v1.RoomService/CreateBreakout"
+	// RoomServiceMoveToBreakoutProcedure is the fully-qualified name of the RoomService's
+	// MoveToBreakout RPC.
+	RoomServiceMoveToBreakoutProcedure = "/esteemed.v1.RoomService/MoveToBreakout"
+	// RoomServiceCloseBreakoutProcedure is the fully-qualified name of the RoomService's CloseBreakout
+	// RPC.
+	RoomServiceCloseBreakoutProcedure = "/esteemed.v1.RoomService/CloseBreakout"
 )
 
 // RoomServiceClient is a client for the esteemed.v1.RoomService service.
 type RoomServiceClient interface {
-	// ListRooms returns all active rooms
+	// ListRooms returns all active public rooms
 	ListRooms(context.Context, *connect.Request[v1.ListRoomsRequest]) (*connect.Response[v1.ListRoomsResponse], error)
 	// CreateRoom generates a new room with a fun name (e.g., brave-falcon-42)
 	CreateRoom(context.Context, *connect.Request[v1.CreateRoomRequest]) (*connect.Response[v1.CreateRoomResponse], error)
@@ -63,10 +133,64 @@ type RoomServiceClient interface {
 	LeaveRoom(context.Context, *connect.Request[v1.LeaveRoomRequest]) (*connect.Response[v1.LeaveRoomResponse], error)
 	// WatchRoom streams real-time participant and room state updates
 	WatchRoom(context.Context, *connect.Request[v1.WatchRoomRequest]) (*connect.ServerStreamForClient[v1.RoomEvent], error)
-	// KickParticipant removes a participant from the room (host only)
+	// WatchRoomState streams the room's room and vote events in order, starting
+	// with a snapshot. Replaces watching WatchRoom and WatchVotes side by side.
+	WatchRoomState(context.Context, *connect.Request[v1.WatchRoomStateRequest]) (*connect.ServerStreamForClient[v1.RoomStateEvent], error)
+	// GetRoom returns a snapshot of the room, its round and, once revealed, the
+	// results to anyone allowed to watch it. Unlike JoinRoom it changes nothing.
+	GetRoom(context.Context, *connect.Request[v1.GetRoomRequest]) (*connect.Response[v1.GetRoomResponse], error)
+	// CreateViewToken issues a read-only token for shared screens, replacing any earlier one (host or facilitator)
+	CreateViewToken(context.Context, *connect.Request[v1.CreateViewTokenRequest]) (*connect.Response[v1.CreateViewTokenResponse], error)
+	// RevokeViewToken stops the room's view token from working (host or facilitator)
+	RevokeViewToken(context.Context, *connect.Request[v1.RevokeViewTokenRequest]) (*connect.Response[v1.RevokeViewTokenResponse], error)
+	// KickParticipant removes a participant from the room (host or facilitator)
 	KickParticipant(context.Context, *connect.Request[v1.KickParticipantRequest]) (*connect.Response[v1.KickParticipantResponse], error)
+	// ListBans returns who has been banned from the room (host or facilitator)
+	ListBans(context.Context, *connect.Request[v1.ListBansRequest]) (*connect.Response[v1.ListBansResponse], error)
+	// LiftBan lets a banned participant join the room again (host or facilitator)
+	LiftBan(context.Context, *connect.Request[v1.LiftBanRequest]) (*connect.Response[v1.LiftBanResponse], error)
 	// TransferOwnership transfers host privileges to another participant
 	TransferOwnership(context.Context, *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.TransferOwnershipResponse], error)
+	// GrantRole makes a participant a facilitator, voter or spectator (owner only)
+	GrantRole(context.Context, *connect.Request[v1.GrantRoleRequest]) (*connect.Response[v1.GrantRoleResponse], error)
+	// RevokeRole returns a participant to the voter role (owner only)
+	RevokeRole(context.Context, *connect.Request[v1.RevokeRoleRequest]) (*connect.Response[v1.RevokeRoleResponse], error)
+	// UpdateParticipant changes the caller's name, avatar seed or color
+	UpdateParticipant(context.Context, *connect.Request[v1.UpdateParticipantRequest]) (*connect.Response[v1.UpdateParticipantResponse], error)
+	// SetPasscode sets or, when empty, removes the room's passcode (owner only)
+	SetPasscode(context.Context, *connect.Request[v1.SetPasscodeRequest]) (*connect.Response[v1.SetPasscodeResponse], error)
+	// SetVisibility changes who can find and join the room (owner only)
+	SetVisibility(context.Context, *connect.Request[v1.SetVisibilityRequest]) (*connect.Response[v1.SetVisibilityResponse], error)
+	// CreateInvite creates a signed invite link that joins the room as a voter or spectator (host or facilitator)
+	CreateInvite(context.Context, *connect.Request[v1.CreateInviteRequest]) (*connect.Response[v1.CreateInviteResponse], error)
+	// RevokeInvite stops an invite link from being used (host or facilitator)
+	RevokeInvite(context.Context, *connect.Request[v1.RevokeInviteRequest]) (*connect.Response[v1.RevokeInviteResponse], error)
+	// SetRoomLocked locks or unlocks the room to new participants; reconnects still work (host or facilitator)
+	SetRoomLocked(context.Context, *connect.Request[v1.SetRoomLockedRequest]) (*connect.Response[v1.SetRoomLockedResponse], error)
+	// SetWaitingRoom makes new participants wait until they are admitted (owner only)
+	SetWaitingRoom(context.Context, *connect.Request[v1.SetWaitingRoomRequest]) (*connect.Response[v1.SetWaitingRoomResponse], error)
+	// AdmitParticipant lets a participant in from the waiting room (host or facilitator)
+	AdmitParticipant(context.Context, *connect.Request[v1.AdmitParticipantRequest]) (*connect.Response[v1.AdmitParticipantResponse], error)
+	// RejectParticipant turns away a participant in the waiting room (host or facilitator)
+	RejectParticipant(context.Context, *connect.Request[v1.RejectParticipantRequest]) (*connect.Response[v1.RejectParticipantResponse], error)
+	// SetParticipantLimits caps how many voters and spectators the room takes (owner only)
+	SetParticipantLimits(context.Context, *connect.Request[v1.SetParticipantLimitsRequest]) (*connect.Response[v1.SetParticipantLimitsResponse], error)
+	// SetSpectator switches a participant between voting and spectating
+	SetSpectator(context.Context, *connect.Request[v1.SetSpectatorRequest]) (*connect.Response[v1.SetSpectatorResponse], error)
+	// SetFacilitatorRotation hands the facilitator role to the next voter for every story (owner only)
+	SetFacilitatorRotation(context.Context, *connect.Request[v1.SetFacilitatorRotationRequest]) (*connect.Response[v1.SetFacilitatorRotationResponse], error)
+	// SetHostSuccession sets who takes over when the host disconnects (owner only)
+	SetHostSuccession(context.Context, *connect.Request[v1.SetHostSuccessionRequest]) (*connect.Response[v1.SetHostSuccessionResponse], error)
+	// ClaimHost makes the caller host of a room that has none
+	ClaimHost(context.Context, *connect.Request[v1.ClaimHostRequest]) (*connect.Response[v1.ClaimHostResponse], error)
+	// KeepAlive resets the room's inactivity timer (any participant)
+	KeepAlive(context.Context, *connect.Request[v1.KeepAliveRequest]) (*connect.Response[v1.KeepAliveResponse], error)
+	// CreateBreakout creates a child room for a sub-team (host or facilitator)
+	CreateBreakout(context.Context, *connect.Request[v1.CreateBreakoutRequest]) (*connect.Response[v1.CreateBreakoutResponse], error)
+	// MoveToBreakout moves a participant into one of the room's breakouts (host or facilitator)
+	MoveToBreakout(context.Context, *connect.Request[v1.MoveToBreakoutRequest]) (*connect.Response[v1.MoveToBreakoutResponse], error)
+	// CloseBreakout closes a breakout and merges its results into the parent (host or facilitator)
+	CloseBreakout(context.Context, *connect.Request[v1.CloseBreakoutRequest]) (*connect.Response[v1.CloseBreakoutResponse], error)
 }
 
 // NewRoomServiceClient constructs a client for the esteemed.v1.RoomService service. By default, it
@@ -110,30 +234,212 @@ func NewRoomServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(roomServiceMethods.ByName("WatchRoom")),
 			connect.WithClientOptions(opts...),
 		),
+		watchRoomState: connect.NewClient[v1.WatchRoomStateRequest, v1.RoomStateEvent](
+			httpClient,
+			baseURL+RoomServiceWatchRoomStateProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("WatchRoomState")),
+			connect.WithClientOptions(opts...),
+		),
+		getRoom: connect.NewClient[v1.GetRoomRequest, v1.GetRoomResponse](
+			httpClient,
+			baseURL+RoomServiceGetRoomProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("GetRoom")),
+			connect.WithClientOptions(opts...),
+		),
+		createViewToken: connect.NewClient[v1.CreateViewTokenRequest, v1.CreateViewTokenResponse](
+			httpClient,
+			baseURL+RoomServiceCreateViewTokenProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("CreateViewToken")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeViewToken: connect.NewClient[v1.RevokeViewTokenRequest, v1.RevokeViewTokenResponse](
+			httpClient,
+			baseURL+RoomServiceRevokeViewTokenProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("RevokeViewToken")),
+			connect.WithClientOptions(opts...),
+		),
 		kickParticipant: connect.NewClient[v1.KickParticipantRequest, v1.KickParticipantResponse](
 			httpClient,
 			baseURL+RoomServiceKickParticipantProcedure,
 			connect.WithSchema(roomServiceMethods.ByName("KickParticipant")),
 			connect.WithClientOptions(opts...),
 		),
+		listBans: connect.NewClient[v1.ListBansRequest, v1.ListBansResponse](
+			httpClient,
+			baseURL+RoomServiceListBansProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("ListBans")),
+			connect.WithClientOptions(opts...),
+		),
+		liftBan: connect.NewClient[v1.LiftBanRequest, v1.LiftBanResponse](
+			httpClient,
+			baseURL+RoomServiceLiftBanProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("LiftBan")),
+			connect.WithClientOptions(opts...),
+		),
 		transferOwnership: connect.NewClient[v1.TransferOwnershipRequest, v1.TransferOwnershipResponse](
 			httpClient,
 			baseURL+RoomServiceTransferOwnershipProcedure,
 			connect.WithSchema(roomServiceMethods.ByName("TransferOwnership")),
 			connect.WithClientOptions(opts...),
 		),
+		grantRole: connect.NewClient[v1.GrantRoleRequest, v1.GrantRoleResponse](
+			httpClient,
+			baseURL+RoomServiceGrantRoleProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("GrantRole")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeRole: connect.NewClient[v1.RevokeRoleRequest, v1.RevokeRoleResponse](
+			httpClient,
+			baseURL+RoomServiceRevokeRoleProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("RevokeRole")),
+			connect.WithClientOptions(opts...),
+		),
+		updateParticipant: connect.NewClient[v1.UpdateParticipantRequest, v1.UpdateParticipantResponse](
+			httpClient,
+			baseURL+RoomServiceUpdateParticipantProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("UpdateParticipant")),
+			connect.WithClientOptions(opts...),
+		),
+		setPasscode: connect.NewClient[v1.SetPasscodeRequest, v1.SetPasscodeResponse](
+			httpClient,
+			baseURL+RoomServiceSetPasscodeProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("SetPasscode")),
+			connect.WithClientOptions(opts...),
+		),
+		setVisibility: connect.NewClient[v1.SetVisibilityRequest, v1.SetVisibilityResponse](
+			httpClient,
+			baseURL+RoomServiceSetVisibilityProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("SetVisibility")),
+			connect.WithClientOptions(opts...),
+		),
+		createInvite: connect.NewClient[v1.CreateInviteRequest, v1.CreateInviteResponse](
+			httpClient,
+			baseURL+RoomServiceCreateInviteProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("CreateInvite")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeInvite: connect.NewClient[v1.RevokeInviteRequest, v1.RevokeInviteResponse](
+			httpClient,
+			baseURL+RoomServiceRevokeInviteProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("RevokeInvite")),
+			connect.WithClientOptions(opts...),
+		),
+		setRoomLocked: connect.NewClient[v1.SetRoomLockedRequest, v1.SetRoomLockedResponse](
+			httpClient,
+			baseURL+RoomServiceSetRoomLockedProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("SetRoomLocked")),
+			connect.WithClientOptions(opts...),
+		),
+		setWaitingRoom: connect.NewClient[v1.SetWaitingRoomRequest, v1.SetWaitingRoomResponse](
+			httpClient,
+			baseURL+RoomServiceSetWaitingRoomProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("SetWaitingRoom")),
+			connect.WithClientOptions(opts...),
+		),
+		admitParticipant: connect.NewClient[v1.AdmitParticipantRequest, v1.AdmitParticipantResponse](
+			httpClient,
+			baseURL+RoomServiceAdmitParticipantProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("AdmitParticipant")),
+			connect.WithClientOptions(opts...),
+		),
+		rejectParticipant: connect.NewClient[v1.RejectParticipantRequest, v1.RejectParticipantResponse](
+			httpClient,
+			baseURL+RoomServiceRejectParticipantProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("RejectParticipant")),
+			connect.WithClientOptions(opts...),
+		),
+		setParticipantLimits: connect.NewClient[v1.SetParticipantLimitsRequest, v1.SetParticipantLimitsResponse](
+			httpClient,
+			baseURL+RoomServiceSetParticipantLimitsProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("SetParticipantLimits")),
+			connect.WithClientOptions(opts...),
+		),
+		setSpectator: connect.NewClient[v1.SetSpectatorRequest, v1.SetSpectatorResponse](
+			httpClient,
+			baseURL+RoomServiceSetSpectatorProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("SetSpectator")),
+			connect.WithClientOptions(opts...),
+		),
+		setFacilitatorRotation: connect.NewClient[v1.SetFacilitatorRotationRequest, v1.SetFacilitatorRotationResponse](
+			httpClient,
+			baseURL+RoomServiceSetFacilitatorRotationProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("SetFacilitatorRotation")),
+			connect.WithClientOptions(opts...),
+		),
+		setHostSuccession: connect.NewClient[v1.SetHostSuccessionRequest, v1.SetHostSuccessionResponse](
+			httpClient,
+			baseURL+RoomServiceSetHostSuccessionProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("SetHostSuccession")),
+			connect.WithClientOptions(opts...),
+		),
+		claimHost: connect.NewClient[v1.ClaimHostRequest, v1.ClaimHostResponse](
+			httpClient,
+			baseURL+RoomServiceClaimHostProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("ClaimHost")),
+			connect.WithClientOptions(opts...),
+		),
+		keepAlive: connect.NewClient[v1.KeepAliveRequest, v1.KeepAliveResponse](
+			httpClient,
+			baseURL+RoomServiceKeepAliveProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("KeepAlive")),
+			connect.WithClientOptions(opts...),
+		),
+		createBreakout: connect.NewClient[v1.CreateBreakoutRequest, v1.CreateBreakoutResponse](
+			httpClient,
+			baseURL+RoomServiceCreateBreakoutProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("CreateBreakout")),
+			connect.WithClientOptions(opts...),
+		),
+		moveToBreakout: connect.NewClient[v1.MoveToBreakoutRequest, v1.MoveToBreakoutResponse](
+			httpClient,
+			baseURL+RoomServiceMoveToBreakoutProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("MoveToBreakout")),
+			connect.WithClientOptions(opts...),
+		),
+		closeBreakout: connect.NewClient[v1.CloseBreakoutRequest, v1.CloseBreakoutResponse](
+			httpClient,
+			baseURL+RoomServiceCloseBreakoutProcedure,
+			connect.WithSchema(roomServiceMethods.ByName("CloseBreakout")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // roomServiceClient implements RoomServiceClient.
 type roomServiceClient struct {
-	listRooms         *connect.Client[v1.ListRoomsRequest, v1.ListRoomsResponse]
-	createRoom        *connect.Client[v1.CreateRoomRequest, v1.CreateRoomResponse]
-	joinRoom          *connect.Client[v1.JoinRoomRequest, v1.JoinRoomResponse]
-	leaveRoom         *connect.Client[v1.LeaveRoomRequest, v1.LeaveRoomResponse]
-	watchRoom         *connect.Client[v1.WatchRoomRequest, v1.RoomEvent]
-	kickParticipant   *connect.Client[v1.KickParticipantRequest, v1.KickParticipantResponse]
-	transferOwnership *connect.Client[v1.TransferOwnershipRequest, v1.TransferOwnershipResponse]
+	listRooms              *connect.Client[v1.ListRoomsRequest, v1.ListRoomsResponse]
+	createRoom             *connect.Client[v1.CreateRoomRequest, v1.CreateRoomResponse]
+	joinRoom               *connect.Client[v1.JoinRoomRequest, v1.JoinRoomResponse]
+	leaveRoom              *connect.Client[v1.LeaveRoomRequest, v1.LeaveRoomResponse]
+	watchRoom              *connect.Client[v1.WatchRoomRequest, v1.RoomEvent]
+	watchRoomState         *connect.Client[v1.WatchRoomStateRequest, v1.RoomStateEvent]
+	getRoom                *connect.Client[v1.GetRoomRequest, v1.GetRoomResponse]
+	createViewToken        *connect.Client[v1.CreateViewTokenRequest, v1.CreateViewTokenResponse]
+	revokeViewToken        *connect.Client[v1.RevokeViewTokenRequest, v1.RevokeViewTokenResponse]
+	kickParticipant        *connect.Client[v1.KickParticipantRequest, v1.KickParticipantResponse]
+	listBans               *connect.Client[v1.ListBansRequest, v1.ListBansResponse]
+	liftBan                *connect.Client[v1.LiftBanRequest, v1.LiftBanResponse]
+	transferOwnership      *connect.Client[v1.TransferOwnershipRequest, v1.TransferOwnershipResponse]
+	grantRole              *connect.Client[v1.GrantRoleRequest, v1.GrantRoleResponse]
+	revokeRole             *connect.Client[v1.RevokeRoleRequest, v1.RevokeRoleResponse]
+	updateParticipant      *connect.Client[v1.UpdateParticipantRequest, v1.UpdateParticipantResponse]
+	setPasscode            *connect.Client[v1.SetPasscodeRequest, v1.SetPasscodeResponse]
+	setVisibility          *connect.Client[v1.SetVisibilityRequest, v1.SetVisibilityResponse]
+	createInvite           *connect.Client[v1.CreateInviteRequest, v1.CreateInviteResponse]
+	revokeInvite           *connect.Client[v1.RevokeInviteRequest, v1.RevokeInviteResponse]
+	setRoomLocked          *connect.Client[v1.SetRoomLockedRequest, v1.SetRoomLockedResponse]
+	setWaitingRoom         *connect.Client[v1.SetWaitingRoomRequest, v1.SetWaitingRoomResponse]
+	admitParticipant       *connect.Client[v1.AdmitParticipantRequest, v1.AdmitParticipantResponse]
+	rejectParticipant      *connect.Client[v1.RejectParticipantRequest, v1.RejectParticipantResponse]
+	setParticipantLimits   *connect.Client[v1.SetParticipantLimitsRequest, v1.SetParticipantLimitsResponse]
+	setSpectator           *connect.Client[v1.SetSpectatorRequest, v1.SetSpectatorResponse]
+	setFacilitatorRotation *connect.Client[v1.SetFacilitatorRotationRequest, v1.SetFacilitatorRotationResponse]
+	setHostSuccession      *connect.Client[v1.SetHostSuccessionRequest, v1.SetHostSuccessionResponse]
+	claimHost              *connect.Client[v1.ClaimHostRequest, v1.ClaimHostResponse]
+	keepAlive              *connect.Client[v1.KeepAliveRequest, v1.KeepAliveResponse]
+	createBreakout         *connect.Client[v1.CreateBreakoutRequest, v1.CreateBreakoutResponse]
+	moveToBreakout         *connect.Client[v1.MoveToBreakoutRequest, v1.MoveToBreakoutResponse]
+	closeBreakout          *connect.Client[v1.CloseBreakoutRequest, v1.CloseBreakoutResponse]
 }
 
 // ListRooms calls esteemed.v1.RoomService.ListRooms.
@@ -161,19 +467,149 @@ func (c *roomServiceClient) WatchRoom(ctx context.Context, req *connect.Request[
 	return c.watchRoom.CallServerStream(ctx, req)
 }
 
+// WatchRoomState calls esteemed.v1.RoomService.WatchRoomState.
+func (c *roomServiceClient) WatchRoomState(ctx context.Context, req *connect.Request[v1.WatchRoomStateRequest]) (*connect.ServerStreamForClient[v1.RoomStateEvent], error) {
+	return c.watchRoomState.CallServerStream(ctx, req)
+}
+
+// GetRoom calls esteemed.v1.RoomService.GetRoom.
+func (c *roomServiceClient) GetRoom(ctx context.Context, req *connect.Request[v1.GetRoomRequest]) (*connect.Response[v1.GetRoomResponse], error) {
+	return c.getRoom.CallUnary(ctx, req)
+}
+
+// CreateViewToken calls esteemed.v1.RoomService.CreateViewToken.
+func (c *roomServiceClient) CreateViewToken(ctx context.Context, req *connect.Request[v1.CreateViewTokenRequest]) (*connect.Response[v1.CreateViewTokenResponse], error) {
+	return c.createViewToken.CallUnary(ctx, req)
+}
+
+// RevokeViewToken calls esteemed.v1.RoomService.RevokeViewToken.
+func (c *roomServiceClient) RevokeViewToken(ctx context.Context, req *connect.Request[v1.RevokeViewTokenRequest]) (*connect.Response[v1.RevokeViewTokenResponse], error) {
+	return c.revokeViewToken.CallUnary(ctx, req)
+}
+
 // KickParticipant calls esteemed.v1.RoomService.KickParticipant.
 func (c *roomServiceClient) KickParticipant(ctx context.Context, req *connect.Request[v1.KickParticipantRequest]) (*connect.Response[v1.KickParticipantResponse], error) {
 	return c.kickParticipant.CallUnary(ctx, req)
 }
 
+// ListBans calls esteemed.v1.RoomService.ListBans.
+func (c *roomServiceClient) ListBans(ctx context.Context, req *connect.Request[v1.ListBansRequest]) (*connect.Response[v1.ListBansResponse], error) {
+	return c.listBans.CallUnary(ctx, req)
+}
+
+// LiftBan calls esteemed.v1.RoomService.LiftBan.
+func (c *roomServiceClient) LiftBan(ctx context.Context, req *connect.Request[v1.LiftBanRequest]) (*connect.Response[v1.LiftBanResponse], error) {
+	return c.liftBan.CallUnary(ctx, req)
+}
+
 // TransferOwnership calls esteemed.v1.RoomService.TransferOwnership.
 func (c *roomServiceClient) TransferOwnership(ctx context.Context, req *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.TransferOwnershipResponse], error) {
 	return c.transferOwnership.CallUnary(ctx, req)
 }
 
+// GrantRole calls esteemed.v1.RoomService.GrantRole.
+func (c *roomServiceClient) GrantRole(ctx context.Context, req *connect.Request[v1.GrantRoleRequest]) (*connect.Response[v1.GrantRoleResponse], error) {
+	return c.grantRole.CallUnary(ctx, req)
+}
+
+// RevokeRole calls esteemed.v1.RoomService.RevokeRole.
+func (c *roomServiceClient) RevokeRole(ctx context.Context, req *connect.Request[v1.RevokeRoleRequest]) (*connect.Response[v1.RevokeRoleResponse], error) {
+	return c.revokeRole.CallUnary(ctx, req)
+}
+
+// UpdateParticipant calls esteemed.v1.RoomService.UpdateParticipant.
+func (c *roomServiceClient) UpdateParticipant(ctx context.Context, req *connect.Request[v1.UpdateParticipantRequest]) (*connect.Response[v1.UpdateParticipantResponse], error) {
+	return c.updateParticipant.CallUnary(ctx, req)
+}
+
+// SetPasscode calls esteemed.v1.RoomService.SetPasscode.
+func (c *roomServiceClient) SetPasscode(ctx context.Context, req *connect.Request[v1.SetPasscodeRequest]) (*connect.Response[v1.SetPasscodeResponse], error) {
+	return c.setPasscode.CallUnary(ctx, req)
+}
+
+// SetVisibility calls esteemed.v1.RoomService.SetVisibility.
+func (c *roomServiceClient) SetVisibility(ctx context.Context, req *connect.Request[v1.SetVisibilityRequest]) (*connect.Response[v1.SetVisibilityResponse], error) {
+	return c.setVisibility.CallUnary(ctx, req)
+}
+
+// CreateInvite calls esteemed.v1.RoomService.CreateInvite.
+func (c *roomServiceClient) CreateInvite(ctx context.Context, req *connect.Request[v1.CreateInviteRequest]) (*connect.Response[v1.CreateInviteResponse], error) {
+	return c.createInvite.CallUnary(ctx, req)
+}
+
+// RevokeInvite calls esteemed.v1.RoomService.RevokeInvite.
+func (c *roomServiceClient) RevokeInvite(ctx context.Context, req *connect.Request[v1.RevokeInviteRequest]) (*connect.Response[v1.RevokeInviteResponse], error) {
+	return c.revokeInvite.CallUnary(ctx, req)
+}
+
+// SetRoomLocked calls esteemed.v1.RoomService.SetRoomLocked.
+func (c *roomServiceClient) SetRoomLocked(ctx context.Context, req *connect.Request[v1.SetRoomLockedRequest]) (*connect.Response[v1.SetRoomLockedResponse], error) {
+	return c.setRoomLocked.CallUnary(ctx, req)
+}
+
+// SetWaitingRoom calls esteemed.v1.RoomService.SetWaitingRoom.
+func (c *roomServiceClient) SetWaitingRoom(ctx context.Context, req *connect.Request[v1.SetWaitingRoomRequest]) (*connect.Response[v1.SetWaitingRoomResponse], error) {
+	return c.setWaitingRoom.CallUnary(ctx, req)
+}
+
+// AdmitParticipant calls esteemed.v1.RoomService.AdmitParticipant.
+func (c *roomServiceClient) AdmitParticipant(ctx context.Context, req *connect.Request[v1.AdmitParticipantRequest]) (*connect.Response[v1.AdmitParticipantResponse], error) {
+	return c.admitParticipant.CallUnary(ctx, req)
+}
+
+// RejectParticipant calls esteemed.v1.RoomService.RejectParticipant.
+func (c *roomServiceClient) RejectParticipant(ctx context.Context, req *connect.Request[v1.RejectParticipantRequest]) (*connect.Response[v1.RejectParticipantResponse], error) {
+	return c.rejectParticipant.CallUnary(ctx, req)
+}
+
+// SetParticipantLimits calls esteemed.v1.RoomService.SetParticipantLimits.
+func (c *roomServiceClient) SetParticipantLimits(ctx context.Context, req *connect.Request[v1.SetParticipantLimitsRequest]) (*connect.Response[v1.SetParticipantLimitsResponse], error) {
+	return c.setParticipantLimits.CallUnary(ctx, req)
+}
+
+// SetSpectator calls esteemed.v1.RoomService.SetSpectator.
+func (c *roomServiceClient) SetSpectator(ctx context.Context, req *connect.Request[v1.SetSpectatorRequest]) (*connect.Response[v1.SetSpectatorResponse], error) {
+	return c.setSpectator.CallUnary(ctx, req)
+}
+
+// SetFacilitatorRotation calls esteemed.v1.RoomService.SetFacilitatorRotation.
+func (c *roomServiceClient) SetFacilitatorRotation(ctx context.Context, req *connect.Request[v1.SetFacilitatorRotationRequest]) (*connect.Response[v1.SetFacilitatorRotationResponse], error) {
+	return c.setFacilitatorRotation.CallUnary(ctx, req)
+}
+
+// SetHostSuccession calls esteemed.v1.RoomService.SetHostSuccession.
+func (c *roomServiceClient) SetHostSuccession(ctx context.Context, req *connect.Request[v1.SetHostSuccessionRequest]) (*connect.Response[v1.SetHostSuccessionResponse], error) {
+	return c.setHostSuccession.CallUnary(ctx, req)
+}
+
+// ClaimHost calls esteemed.v1.RoomService.ClaimHost.
+func (c *roomServiceClient) ClaimHost(ctx context.Context, req *connect.Request[v1.ClaimHostRequest]) (*connect.Response[v1.ClaimHostResponse], error) {
+	return c.claimHost.CallUnary(ctx, req)
+}
+
+// KeepAlive calls esteemed.v1.RoomService.KeepAlive.
+func (c *roomServiceClient) KeepAlive(ctx context.Context, req *connect.Request[v1.KeepAliveRequest]) (*connect.Response[v1.KeepAliveResponse], error) {
+	return c.keepAlive.CallUnary(ctx, req)
+}
+
+// CreateBreakout calls esteemed.v1.RoomService.CreateBreakout.
+func (c *roomServiceClient) CreateBreakout(ctx context.Context, req *connect.Request[v1.CreateBreakoutRequest]) (*connect.Response[v1.CreateBreakoutResponse], error) {
+	return c.createBreakout.CallUnary(ctx, req)
+}
+
+// MoveToBreakout calls esteemed.v1.RoomService.MoveToBreakout.
+func (c *roomServiceClient) MoveToBreakout(ctx context.Context, req *connect.Request[v1.MoveToBreakoutRequest]) (*connect.Response[v1.MoveToBreakoutResponse], error) {
+	return c.moveToBreakout.CallUnary(ctx, req)
+}
+
+// CloseBreakout calls esteemed.v1.RoomService.CloseBreakout.
+func (c *roomServiceClient) CloseBreakout(ctx context.Context, req *connect.Request[v1.CloseBreakoutRequest]) (*connect.Response[v1.CloseBreakoutResponse], error) {
+	return c.closeBreakout.CallUnary(ctx, req)
+}
+
 // RoomServiceHandler is an implementation of the esteemed.v1.RoomService service.
 type RoomServiceHandler interface {
-	// ListRooms returns all active rooms
+	// ListRooms returns all active public rooms
 	ListRooms(context.Context, *connect.Request[v1.ListRoomsRequest]) (*connect.Response[v1.ListRoomsResponse], error)
 	// CreateRoom generates a new room with a fun name (e.g., brave-falcon-42)
 	CreateRoom(context.Context, *connect.Request[v1.CreateRoomRequest]) (*connect.Response[v1.CreateRoomResponse], error)
@@ -183,10 +619,64 @@ type RoomServiceHandler interface {
 	LeaveRoom(context.Context, *connect.Request[v1.LeaveRoomRequest]) (*connect.Response[v1.LeaveRoomResponse], error)
 	// WatchRoom streams real-time participant and room state updates
 	WatchRoom(context.Context, *connect.Request[v1.WatchRoomRequest], *connect.ServerStream[v1.RoomEvent]) error
-	// KickParticipant removes a participant from the room (host only)
+	// WatchRoomState streams the room's room and vote events in order, starting
+	// with a snapshot. Replaces watching WatchRoom and WatchVotes side by side.
+	WatchRoomState(context.Context, *connect.Request[v1.WatchRoomStateRequest], *connect.ServerStream[v1.RoomStateEvent]) error
+	// GetRoom returns a snapshot of the room, its round and, once revealed, the
+	// results to anyone allowed to watch it. Unlike JoinRoom it changes nothing.
+	GetRoom(context.Context, *connect.Request[v1.GetRoomRequest]) (*connect.Response[v1.GetRoomResponse], error)
+	// CreateViewToken issues a read-only token for shared screens, replacing any earlier one (host or facilitator)
+	CreateViewToken(context.Context, *connect.Request[v1.CreateViewTokenRequest]) (*connect.Response[v1.CreateViewTokenResponse], error)
+	// RevokeViewToken stops the room's view token from working (host or facilitator)
+	RevokeViewToken(context.Context, *connect.Request[v1.RevokeViewTokenRequest]) (*connect.Response[v1.RevokeViewTokenResponse], error)
+	// KickParticipant removes a participant from the room (host or facilitator)
 	KickParticipant(context.Context, *connect.Request[v1.KickParticipantRequest]) (*connect.Response[v1.KickParticipantResponse], error)
+	// ListBans returns who has been banned from the room (host or facilitator)
+	ListBans(context.Context, *connect.Request[v1.ListBansRequest]) (*connect.Response[v1.ListBansResponse], error)
+	// LiftBan lets a banned participant join the room again (host or facilitator)
+	LiftBan(context.Context, *connect.Request[v1.LiftBanRequest]) (*connect.Response[v1.LiftBanResponse], error)
 	// TransferOwnership transfers host privileges to another participant
 	TransferOwnership(context.Context, *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.TransferOwnershipResponse], error)
+	// GrantRole makes a participant a facilitator, voter or spectator (owner only)
+	GrantRole(context.Context, *connect.Request[v1.GrantRoleRequest]) (*connect.Response[v1.GrantRoleResponse], error)
+	// RevokeRole returns a participant to the voter role (owner only)
+	RevokeRole(context.Context, *connect.Request[v1.RevokeRoleRequest]) (*connect.Response[v1.RevokeRoleResponse], error)
+	// UpdateParticipant changes the caller's name, avatar seed or color
+	UpdateParticipant(context.Context, *connect.Request[v1.UpdateParticipantRequest]) (*connect.Response[v1.UpdateParticipantResponse], error)
+	// SetPasscode sets or, when empty, removes the room's passcode (owner only)
+	SetPasscode(context.Context, *connect.Request[v1.SetPasscodeRequest]) (*connect.Response[v1.SetPasscodeResponse], error)
+	// SetVisibility changes who can find and join the room (owner only)
+	SetVisibility(context.Context, *connect.Request[v1.SetVisibilityRequest]) (*connect.Response[v1.SetVisibilityResponse], error)
+	// CreateInvite creates a signed invite link that joins the room as a voter or spectator (host or facilitator)
+	CreateInvite(context.Context, *connect.Request[v1.CreateInviteRequest]) (*connect.Response[v1.CreateInviteResponse], error)
+	// RevokeInvite stops an invite link from being used (host or facilitator)
+	RevokeInvite(context.Context, *connect.Request[v1.RevokeInviteRequest]) (*connect.Response[v1.RevokeInviteResponse], error)
+	// SetRoomLocked locks or unlocks the room to new participants; reconnects still work (host or facilitator)
+	SetRoomLocked(context.Context, *connect.Request[v1.SetRoomLockedRequest]) (*connect.Response[v1.SetRoomLockedResponse], error)
+	// SetWaitingRoom makes new participants wait until they are admitted (owner only)
+	SetWaitingRoom(context.Context, *connect.Request[v1.SetWaitingRoomRequest]) (*connect.Response[v1.SetWaitingRoomResponse], error)
+	// AdmitParticipant lets a participant in from the waiting room (host or facilitator)
+	AdmitParticipant(context.Context, *connect.Request[v1.AdmitParticipantRequest]) (*connect.Response[v1.AdmitParticipantResponse], error)
+	// RejectParticipant turns away a participant in the waiting room (host or facilitator)
+	RejectParticipant(context.Context, *connect.Request[v1.RejectParticipantRequest]) (*connect.Response[v1.RejectParticipantResponse], error)
+	// SetParticipantLimits caps how many voters and spectators the room takes (owner only)
+	SetParticipantLimits(context.Context, *connect.Request[v1.SetParticipantLimitsRequest]) (*connect.Response[v1.SetParticipantLimitsResponse], error)
+	// SetSpectator switches a participant between voting and spectating
+	SetSpectator(context.Context, *connect.Request[v1.SetSpectatorRequest]) (*connect.Response[v1.SetSpectatorResponse], error)
+	// SetFacilitatorRotation hands the facilitator role to the next voter for every story (owner only)
+	SetFacilitatorRotation(context.Context, *connect.Request[v1.SetFacilitatorRotationRequest]) (*connect.Response[v1.SetFacilitatorRotationResponse], error)
+	// SetHostSuccession sets who takes over when the host disconnects (owner only)
+	SetHostSuccession(context.Context, *connect.Request[v1.SetHostSuccessionRequest]) (*connect.Response[v1.SetHostSuccessionResponse], error)
+	// ClaimHost makes the caller host of a room that has none
+	ClaimHost(context.Context, *connect.Request[v1.ClaimHostRequest]) (*connect.Response[v1.ClaimHostResponse], error)
+	// KeepAlive resets the room's inactivity timer (any participant)
+	KeepAlive(context.Context, *connect.Request[v1.KeepAliveRequest]) (*connect.Response[v1.KeepAliveResponse], error)
+	// CreateBreakout creates a child room for a sub-team (host or facilitator)
+	CreateBreakout(context.Context, *connect.Request[v1.CreateBreakoutRequest]) (*connect.Response[v1.CreateBreakoutResponse], error)
+	// MoveToBreakout moves a participant into one of the room's breakouts (host or facilitator)
+	MoveToBreakout(context.Context, *connect.Request[v1.MoveToBreakoutRequest]) (*connect.Response[v1.MoveToBreakoutResponse], error)
+	// CloseBreakout closes a breakout and merges its results into the parent (host or facilitator)
+	CloseBreakout(context.Context, *connect.Request[v1.CloseBreakoutRequest]) (*connect.Response[v1.CloseBreakoutResponse], error)
 }
 
 // NewRoomServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -226,18 +716,174 @@ func NewRoomServiceHandler(svc RoomServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(roomServiceMethods.ByName("WatchRoom")),
 		connect.WithHandlerOptions(opts...),
 	)
+	roomServiceWatchRoomStateHandler := connect.NewServerStreamHandler(
+		RoomServiceWatchRoomStateProcedure,
+		svc.WatchRoomState,
+		connect.WithSchema(roomServiceMethods.ByName("WatchRoomState")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceGetRoomHandler := connect.NewUnaryHandler(
+		RoomServiceGetRoomProcedure,
+		svc.GetRoom,
+		connect.WithSchema(roomServiceMethods.ByName("GetRoom")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceCreateViewTokenHandler := connect.NewUnaryHandler(
+		RoomServiceCreateViewTokenProcedure,
+		svc.CreateViewToken,
+		connect.WithSchema(roomServiceMethods.ByName("CreateViewToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceRevokeViewTokenHandler := connect.NewUnaryHandler(
+		RoomServiceRevokeViewTokenProcedure,
+		svc.RevokeViewToken,
+		connect.WithSchema(roomServiceMethods.ByName("RevokeViewToken")),
+		connect.WithHandlerOptions(opts...),
+	)
 	roomServiceKickParticipantHandler := connect.NewUnaryHandler(
 		RoomServiceKickParticipantProcedure,
 		svc.KickParticipant,
 		connect.WithSchema(roomServiceMethods.ByName("KickParticipant")),
 		connect.WithHandlerOptions(opts...),
 	)
+	roomServiceListBansHandler := connect.NewUnaryHandler(
+		RoomServiceListBansProcedure,
+		svc.ListBans,
+		connect.WithSchema(roomServiceMethods.ByName("ListBans")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceLiftBanHandler := connect.NewUnaryHandler(
+		RoomServiceLiftBanProcedure,
+		svc.LiftBan,
+		connect.WithSchema(roomServiceMethods.ByName("LiftBan")),
+		connect.WithHandlerOptions(opts...),
+	)
 	roomServiceTransferOwnershipHandler := connect.NewUnaryHandler(
 		RoomServiceTransferOwnershipProcedure,
 		svc.TransferOwnership,
 		connect.WithSchema(roomServiceMethods.ByName("TransferOwnership")),
 		connect.WithHandlerOptions(opts...),
 	)
+	roomServiceGrantRoleHandler := connect.NewUnaryHandler(
+		RoomServiceGrantRoleProcedure,
+		svc.GrantRole,
+		connect.WithSchema(roomServiceMethods.ByName("GrantRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceRevokeRoleHandler := connect.NewUnaryHandler(
+		RoomServiceRevokeRoleProcedure,
+		svc.RevokeRole,
+		connect.WithSchema(roomServiceMethods.ByName("RevokeRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceUpdateParticipantHandler := connect.NewUnaryHandler(
+		RoomServiceUpdateParticipantProcedure,
+		svc.UpdateParticipant,
+		connect.WithSchema(roomServiceMethods.ByName("UpdateParticipant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceSetPasscodeHandler := connect.NewUnaryHandler(
+		RoomServiceSetPasscodeProcedure,
+		svc.SetPasscode,
+		connect.WithSchema(roomServiceMethods.ByName("SetPasscode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceSetVisibilityHandler := connect.NewUnaryHandler(
+		RoomServiceSetVisibilityProcedure,
+		svc.SetVisibility,
+		connect.WithSchema(roomServiceMethods.ByName("SetVisibility")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceCreateInviteHandler := connect.NewUnaryHandler(
+		RoomServiceCreateInviteProcedure,
+		svc.CreateInvite,
+		connect.WithSchema(roomServiceMethods.ByName("CreateInvite")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceRevokeInviteHandler := connect.NewUnaryHandler(
+		RoomServiceRevokeInviteProcedure,
+		svc.RevokeInvite,
+		connect.WithSchema(roomServiceMethods.ByName("RevokeInvite")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceSetRoomLockedHandler := connect.NewUnaryHandler(
+		RoomServiceSetRoomLockedProcedure,
+		svc.SetRoomLocked,
+		connect.WithSchema(roomServiceMethods.ByName("SetRoomLocked")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceSetWaitingRoomHandler := connect.NewUnaryHandler(
+		RoomServiceSetWaitingRoomProcedure,
+		svc.SetWaitingRoom,
+		connect.WithSchema(roomServiceMethods.ByName("SetWaitingRoom")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceAdmitParticipantHandler := connect.NewUnaryHandler(
+		RoomServiceAdmitParticipantProcedure,
+		svc.AdmitParticipant,
+		connect.WithSchema(roomServiceMethods.ByName("AdmitParticipant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceRejectParticipantHandler := connect.NewUnaryHandler(
+		RoomServiceRejectParticipantProcedure,
+		svc.RejectParticipant,
+		connect.WithSchema(roomServiceMethods.ByName("RejectParticipant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceSetParticipantLimitsHandler := connect.NewUnaryHandler(
+		RoomServiceSetParticipantLimitsProcedure,
+		svc.SetParticipantLimits,
+		connect.WithSchema(roomServiceMethods.ByName("SetParticipantLimits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceSetSpectatorHandler := connect.NewUnaryHandler(
+		RoomServiceSetSpectatorProcedure,
+		svc.SetSpectator,
+		connect.WithSchema(roomServiceMethods.ByName("SetSpectator")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceSetFacilitatorRotationHandler := connect.NewUnaryHandler(
+		RoomServiceSetFacilitatorRotationProcedure,
+		svc.SetFacilitatorRotation,
+		connect.WithSchema(roomServiceMethods.ByName("SetFacilitatorRotation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceSetHostSuccessionHandler := connect.NewUnaryHandler(
+		RoomServiceSetHostSuccessionProcedure,
+		svc.SetHostSuccession,
+		connect.WithSchema(roomServiceMethods.ByName("SetHostSuccession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceClaimHostHandler := connect.NewUnaryHandler(
+		RoomServiceClaimHostProcedure,
+		svc.ClaimHost,
+		connect.WithSchema(roomServiceMethods.ByName("ClaimHost")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceKeepAliveHandler := connect.NewUnaryHandler(
+		RoomServiceKeepAliveProcedure,
+		svc.KeepAlive,
+		connect.WithSchema(roomServiceMethods.ByName("KeepAlive")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceCreateBreakoutHandler := connect.NewUnaryHandler(
+		RoomServiceCreateBreakoutProcedure,
+		svc.CreateBreakout,
+		connect.WithSchema(roomServiceMethods.ByName("CreateBreakout")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceMoveToBreakoutHandler := connect.NewUnaryHandler(
+		RoomServiceMoveToBreakoutProcedure,
+		svc.MoveToBreakout,
+		connect.WithSchema(roomServiceMethods.ByName("MoveToBreakout")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roomServiceCloseBreakoutHandler := connect.NewUnaryHandler(
+		RoomServiceCloseBreakoutProcedure,
+		svc.CloseBreakout,
+		connect.WithSchema(roomServiceMethods.ByName("CloseBreakout")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/esteemed.v1.RoomService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case RoomServiceListRoomsProcedure:
@@ -250,10 +896,62 @@ func NewRoomServiceHandler(svc RoomServiceHandler, opts ...connect.HandlerOption
 			roomServiceLeaveRoomHandler.ServeHTTP(w, r)
 		case RoomServiceWatchRoomProcedure:
 			roomServiceWatchRoomHandler.ServeHTTP(w, r)
+		case RoomServiceWatchRoomStateProcedure:
+			roomServiceWatchRoomStateHandler.ServeHTTP(w, r)
+		case RoomServiceGetRoomProcedure:
+			roomServiceGetRoomHandler.ServeHTTP(w, r)
+		case RoomServiceCreateViewTokenProcedure:
+			roomServiceCreateViewTokenHandler.ServeHTTP(w, r)
+		case RoomServiceRevokeViewTokenProcedure:
+			roomServiceRevokeViewTokenHandler.ServeHTTP(w, r)
 		case RoomServiceKickParticipantProcedure:
 			roomServiceKickParticipantHandler.ServeHTTP(w, r)
+		case RoomServiceListBansProcedure:
+			roomServiceListBansHandler.ServeHTTP(w, r)
+		case RoomServiceLiftBanProcedure:
+			roomServiceLiftBanHandler.ServeHTTP(w, r)
 		case RoomServiceTransferOwnershipProcedure:
 			roomServiceTransferOwnershipHandler.ServeHTTP(w, r)
+		case RoomServiceGrantRoleProcedure:
+			roomServiceGrantRoleHandler.ServeHTTP(w, r)
+		case RoomServiceRevokeRoleProcedure:
+			roomServiceRevokeRoleHandler.ServeHTTP(w, r)
+		case RoomServiceUpdateParticipantProcedure:
+			roomServiceUpdateParticipantHandler.ServeHTTP(w, r)
+		case RoomServiceSetPasscodeProcedure:
+			roomServiceSetPasscodeHandler.ServeHTTP(w, r)
+		case RoomServiceSetVisibilityProcedure:
+			roomServiceSetVisibilityHandler.ServeHTTP(w, r)
+		case RoomServiceCreateInviteProcedure:
+			roomServiceCreateInviteHandler.ServeHTTP(w, r)
+		case RoomServiceRevokeInviteProcedure:
+			roomServiceRevokeInviteHandler.ServeHTTP(w, r)
+		case RoomServiceSetRoomLockedProcedure:
+			roomServiceSetRoomLockedHandler.ServeHTTP(w, r)
+		case RoomServiceSetWaitingRoomProcedure:
+			roomServiceSetWaitingRoomHandler.ServeHTTP(w, r)
+		case RoomServiceAdmitParticipantProcedure:
+			roomServiceAdmitParticipantHandler.ServeHTTP(w, r)
+		case RoomServiceRejectParticipantProcedure:
+			roomServiceRejectParticipantHandler.ServeHTTP(w, r)
+		case RoomServiceSetParticipantLimitsProcedure:
+			roomServiceSetParticipantLimitsHandler.ServeHTTP(w, r)
+		case RoomServiceSetSpectatorProcedure:
+			roomServiceSetSpectatorHandler.ServeHTTP(w, r)
+		case RoomServiceSetFacilitatorRotationProcedure:
+			roomServiceSetFacilitatorRotationHandler.ServeHTTP(w, r)
+		case RoomServiceSetHostSuccessionProcedure:
+			roomServiceSetHostSuccessionHandler.ServeHTTP(w, r)
+		case RoomServiceClaimHostProcedure:
+			roomServiceClaimHostHandler.ServeHTTP(w, r)
+		case RoomServiceKeepAliveProcedure:
+			roomServiceKeepAliveHandler.ServeHTTP(w, r)
+		case RoomServiceCreateBreakoutProcedure:
+			roomServiceCreateBreakoutHandler.ServeHTTP(w, r)
+		case RoomServiceMoveToBreakoutProcedure:
+			roomServiceMoveToBreakoutHandler.ServeHTTP(w, r)
+		case RoomServiceCloseBreakoutProcedure:
+			roomServiceCloseBreakoutHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -283,10 +981,114 @@ func (UnimplementedRoomServiceHandler) WatchRoom(context.Context, *connect.Reque
 	return connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.WatchRoom is not implemented"))
 }
 
+func (UnimplementedRoomServiceHandler) WatchRoomState(context.Context, *connect.Request[v1.WatchRoomStateRequest], *connect.ServerStream[v1.RoomStateEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.WatchRoomState is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) GetRoom(context.Context, *connect.Request[v1.GetRoomRequest]) (*connect.Response[v1.GetRoomResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.GetRoom is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) CreateViewToken(context.Context, *connect.Request[v1.CreateViewTokenRequest]) (*connect.Response[v1.CreateViewTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.CreateViewToken is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) RevokeViewToken(context.Context, *connect.Request[v1.RevokeViewTokenRequest]) (*connect.Response[v1.RevokeViewTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.RevokeViewToken is not implemented"))
+}
+
 func (UnimplementedRoomServiceHandler) KickParticipant(context.Context, *connect.Request[v1.KickParticipantRequest]) (*connect.Response[v1.KickParticipantResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.KickParticipant is not implemented"))
 }
 
+func (UnimplementedRoomServiceHandler) ListBans(context.Context, *connect.Request[v1.ListBansRequest]) (*connect.Response[v1.ListBansResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.ListBans is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) LiftBan(context.Context, *connect.Request[v1.LiftBanRequest]) (*connect.Response[v1.LiftBanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.LiftBan is not implemented"))
+}
+
 func (UnimplementedRoomServiceHandler) TransferOwnership(context.Context, *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.TransferOwnershipResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.TransferOwnership is not implemented"))
 }
+
+func (UnimplementedRoomServiceHandler) GrantRole(context.Context, *connect.Request[v1.GrantRoleRequest]) (*connect.Response[v1.GrantRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.GrantRole is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) RevokeRole(context.Context, *connect.Request[v1.RevokeRoleRequest]) (*connect.Response[v1.RevokeRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.RevokeRole is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) UpdateParticipant(context.Context, *connect.Request[v1.UpdateParticipantRequest]) (*connect.Response[v1.UpdateParticipantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.UpdateParticipant is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) SetPasscode(context.Context, *connect.Request[v1.SetPasscodeRequest]) (*connect.Response[v1.SetPasscodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.SetPasscode is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) SetVisibility(context.Context, *connect.Request[v1.SetVisibilityRequest]) (*connect.Response[v1.SetVisibilityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.SetVisibility is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) CreateInvite(context.Context, *connect.Request[v1.CreateInviteRequest]) (*connect.Response[v1.CreateInviteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.CreateInvite is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) RevokeInvite(context.Context, *connect.Request[v1.RevokeInviteRequest]) (*connect.Response[v1.RevokeInviteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.RevokeInvite is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) SetRoomLocked(context.Context, *connect.Request[v1.SetRoomLockedRequest]) (*connect.Response[v1.SetRoomLockedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.SetRoomLocked is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) SetWaitingRoom(context.Context, *connect.Request[v1.SetWaitingRoomRequest]) (*connect.Response[v1.SetWaitingRoomResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.SetWaitingRoom is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) AdmitParticipant(context.Context, *connect.Request[v1.AdmitParticipantRequest]) (*connect.Response[v1.AdmitParticipantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.AdmitParticipant is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) RejectParticipant(context.Context, *connect.Request[v1.RejectParticipantRequest]) (*connect.Response[v1.RejectParticipantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.RejectParticipant is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) SetParticipantLimits(context.Context, *connect.Request[v1.SetParticipantLimitsRequest]) (*connect.Response[v1.SetParticipantLimitsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.SetParticipantLimits is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) SetSpectator(context.Context, *connect.Request[v1.SetSpectatorRequest]) (*connect.Response[v1.SetSpectatorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.SetSpectator is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) SetFacilitatorRotation(context.Context, *connect.Request[v1.SetFacilitatorRotationRequest]) (*connect.Response[v1.SetFacilitatorRotationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.SetFacilitatorRotation is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) SetHostSuccession(context.Context, *connect.Request[v1.SetHostSuccessionRequest]) (*connect.Response[v1.SetHostSuccessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.SetHostSuccession is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) ClaimHost(context.Context, *connect.Request[v1.ClaimHostRequest]) (*connect.Response[v1.ClaimHostResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.ClaimHost is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) KeepAlive(context.Context, *connect.Request[v1.KeepAliveRequest]) (*connect.Response[v1.KeepAliveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.KeepAlive is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) CreateBreakout(context.Context, *connect.Request[v1.CreateBreakoutRequest]) (*connect.Response[v1.CreateBreakoutResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.CreateBreakout is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) MoveToBreakout(context.Context, *connect.Request[v1.MoveToBreakoutRequest]) (*connect.Response[v1.MoveToBreakoutResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.MoveToBreakout is not implemented"))
+}
+
+func (UnimplementedRoomServiceHandler) CloseBreakout(context.Context, *connect.Request[v1.CloseBreakoutRequest]) (*connect.Response[v1.CloseBreakoutResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("esteemed.v1.RoomService.CloseBreakout is not implemented"))
+}
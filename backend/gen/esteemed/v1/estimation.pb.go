@@ -25,8 +25,11 @@ type Vote struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ParticipantId   string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
 	ParticipantName string                 `protobuf:"bytes,2,opt,name=participant_name,json=participantName,proto3" json:"participant_name,omitempty"`
-	Value           string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`                        // Card value as string (e.g., "5", "XL", "?")
-	HasVoted        bool                   `protobuf:"varint,4,opt,name=has_voted,json=hasVoted,proto3" json:"has_voted,omitempty"` // True if they've submitted a vote
+	Value           string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`                                  // Card value as string (e.g., "5", "XL", "?")
+	HasVoted        bool                   `protobuf:"varint,4,opt,name=has_voted,json=hasVoted,proto3" json:"has_voted,omitempty"`           // True if they've submitted a vote
+	Dots            []*DotAllocation       `protobuf:"bytes,5,rep,name=dots,proto3" json:"dots,omitempty"`                                    // Dot placement (dot-voting rooms only)
+	IsProxy         bool                   `protobuf:"varint,6,opt,name=is_proxy,json=isProxy,proto3" json:"is_proxy,omitempty"`              // Entered by the host on behalf of an absent participant
+	ProxyCastBy     string                 `protobuf:"bytes,7,opt,name=proxy_cast_by,json=proxyCastBy,proto3" json:"proxy_cast_by,omitempty"` // Name of the host who entered the proxy vote
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -89,6 +92,149 @@ func (x *Vote) GetHasVoted() bool {
 	return false
 }
 
+func (x *Vote) GetDots() []*DotAllocation {
+	if x != nil {
+		return x.Dots
+	}
+	return nil
+}
+
+func (x *Vote) GetIsProxy() bool {
+	if x != nil {
+		return x.IsProxy
+	}
+	return false
+}
+
+func (x *Vote) GetProxyCastBy() string {
+	if x != nil {
+		return x.ProxyCastBy
+	}
+	return ""
+}
+
+// DotAllocation is a number of dots placed on a single option
+type DotAllocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Option        string                 `protobuf:"bytes,1,opt,name=option,proto3" json:"option,omitempty"`
+	Dots          int32                  `protobuf:"varint,2,opt,name=dots,proto3" json:"dots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DotAllocation) Reset() {
+	*x = DotAllocation{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DotAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DotAllocation) ProtoMessage() {}
+
+func (x *DotAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DotAllocation.ProtoReflect.Descriptor instead.
+func (*DotAllocation) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DotAllocation) GetOption() string {
+	if x != nil {
+		return x.Option
+	}
+	return ""
+}
+
+func (x *DotAllocation) GetDots() int32 {
+	if x != nil {
+		return x.Dots
+	}
+	return 0
+}
+
+// DotRanking is an option's position in the revealed dot-voting results
+type DotRanking struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Option        string                 `protobuf:"bytes,1,opt,name=option,proto3" json:"option,omitempty"`
+	Dots          int32                  `protobuf:"varint,2,opt,name=dots,proto3" json:"dots,omitempty"`                               // Total dots placed on this option
+	Rank          int32                  `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`                               // 1-based rank; tied options share a rank
+	VoterCount    int32                  `protobuf:"varint,4,opt,name=voter_count,json=voterCount,proto3" json:"voter_count,omitempty"` // Number of participants who placed dots here
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DotRanking) Reset() {
+	*x = DotRanking{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DotRanking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DotRanking) ProtoMessage() {}
+
+func (x *DotRanking) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DotRanking.ProtoReflect.Descriptor instead.
+func (*DotRanking) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DotRanking) GetOption() string {
+	if x != nil {
+		return x.Option
+	}
+	return ""
+}
+
+func (x *DotRanking) GetDots() int32 {
+	if x != nil {
+		return x.Dots
+	}
+	return 0
+}
+
+func (x *DotRanking) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *DotRanking) GetVoterCount() int32 {
+	if x != nil {
+		return x.VoterCount
+	}
+	return 0
+}
+
 // VoteSummary shows statistics after reveal
 type VoteSummary struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -97,13 +243,14 @@ type VoteSummary struct {
 	Mode           string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`                                             // Most common vote (string)
 	HasConsensus   bool                   `protobuf:"varint,4,opt,name=has_consensus,json=hasConsensus,proto3" json:"has_consensus,omitempty"`        // All votes are the same
 	NumericAverage float64                `protobuf:"fixed64,5,opt,name=numeric_average,json=numericAverage,proto3" json:"numeric_average,omitempty"` // Raw numeric average (for display)
+	Ranking        []*DotRanking          `protobuf:"bytes,6,rep,name=ranking,proto3" json:"ranking,omitempty"`                                       // Ranked options (dot-voting rooms only)
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *VoteSummary) Reset() {
 	*x = VoteSummary{}
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[1]
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -115,7 +262,7 @@ func (x *VoteSummary) String() string {
 func (*VoteSummary) ProtoMessage() {}
 
 func (x *VoteSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[1]
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -128,7 +275,7 @@ func (x *VoteSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VoteSummary.ProtoReflect.Descriptor instead.
 func (*VoteSummary) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{1}
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *VoteSummary) GetVotes() []*Vote {
@@ -166,6 +313,82 @@ func (x *VoteSummary) GetNumericAverage() float64 {
 	return 0
 }
 
+func (x *VoteSummary) GetRanking() []*DotRanking {
+	if x != nil {
+		return x.Ranking
+	}
+	return nil
+}
+
+// RoundResult is a revealed round kept in the room's history
+type RoundResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RevealedAt     int64                  `protobuf:"varint,1,opt,name=revealed_at,json=revealedAt,proto3" json:"revealed_at,omitempty"`
+	SourceRoomId   string                 `protobuf:"bytes,2,opt,name=source_room_id,json=sourceRoomId,proto3" json:"source_room_id,omitempty"` // Room the round was played in (differs for merged breakouts)
+	SourceRoomName string                 `protobuf:"bytes,3,opt,name=source_room_name,json=sourceRoomName,proto3" json:"source_room_name,omitempty"`
+	Summary        *VoteSummary           `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RoundResult) Reset() {
+	*x = RoundResult{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundResult) ProtoMessage() {}
+
+func (x *RoundResult) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundResult.ProtoReflect.Descriptor instead.
+func (*RoundResult) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RoundResult) GetRevealedAt() int64 {
+	if x != nil {
+		return x.RevealedAt
+	}
+	return 0
+}
+
+func (x *RoundResult) GetSourceRoomId() string {
+	if x != nil {
+		return x.SourceRoomId
+	}
+	return ""
+}
+
+func (x *RoundResult) GetSourceRoomName() string {
+	if x != nil {
+		return x.SourceRoomName
+	}
+	return ""
+}
+
+func (x *RoundResult) GetSummary() *VoteSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
 // CastVoteRequest submits a vote
 type CastVoteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -179,7 +402,7 @@ type CastVoteRequest struct {
 
 func (x *CastVoteRequest) Reset() {
 	*x = CastVoteRequest{}
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[2]
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -191,7 +414,7 @@ func (x *CastVoteRequest) String() string {
 func (*CastVoteRequest) ProtoMessage() {}
 
 func (x *CastVoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[2]
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -204,7 +427,7 @@ func (x *CastVoteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CastVoteRequest.ProtoReflect.Descriptor instead.
 func (*CastVoteRequest) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{2}
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CastVoteRequest) GetRoomId() string {
@@ -243,7 +466,7 @@ type CastVoteResponse struct {
 
 func (x *CastVoteResponse) Reset() {
 	*x = CastVoteResponse{}
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[3]
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -255,7 +478,7 @@ func (x *CastVoteResponse) String() string {
 func (*CastVoteResponse) ProtoMessage() {}
 
 func (x *CastVoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[3]
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -268,34 +491,37 @@ func (x *CastVoteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CastVoteResponse.ProtoReflect.Descriptor instead.
 func (*CastVoteResponse) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{3}
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{6}
 }
 
-// RevealVotesRequest reveals all votes
-type RevealVotesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be host
-	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// CastProxyVoteRequest records a vote for an absent participant
+type CastProxyVoteRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	RoomId              string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId       string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be host or facilitator
+	SessionToken        string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	TargetParticipantId string                 `protobuf:"bytes,4,opt,name=target_participant_id,json=targetParticipantId,proto3" json:"target_participant_id,omitempty"` // Existing disconnected participant, or empty to use target_name
+	TargetName          string                 `protobuf:"bytes,5,opt,name=target_name,json=targetName,proto3" json:"target_name,omitempty"`                              // Name of the absent person; a placeholder is created if needed
+	Value               string                 `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *RevealVotesRequest) Reset() {
-	*x = RevealVotesRequest{}
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[4]
+func (x *CastProxyVoteRequest) Reset() {
+	*x = CastProxyVoteRequest{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevealVotesRequest) String() string {
+func (x *CastProxyVoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevealVotesRequest) ProtoMessage() {}
+func (*CastProxyVoteRequest) ProtoMessage() {}
 
-func (x *RevealVotesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[4]
+func (x *CastProxyVoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -306,54 +532,285 @@ func (x *RevealVotesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevealVotesRequest.ProtoReflect.Descriptor instead.
-func (*RevealVotesRequest) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CastProxyVoteRequest.ProtoReflect.Descriptor instead.
+func (*CastProxyVoteRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *RevealVotesRequest) GetRoomId() string {
+func (x *CastProxyVoteRequest) GetRoomId() string {
 	if x != nil {
 		return x.RoomId
 	}
 	return ""
 }
 
-func (x *RevealVotesRequest) GetParticipantId() string {
+func (x *CastProxyVoteRequest) GetParticipantId() string {
 	if x != nil {
 		return x.ParticipantId
 	}
 	return ""
 }
 
-func (x *RevealVotesRequest) GetSessionToken() string {
+func (x *CastProxyVoteRequest) GetSessionToken() string {
 	if x != nil {
 		return x.SessionToken
 	}
 	return ""
 }
 
-type RevealVotesResponse struct {
+func (x *CastProxyVoteRequest) GetTargetParticipantId() string {
+	if x != nil {
+		return x.TargetParticipantId
+	}
+	return ""
+}
+
+func (x *CastProxyVoteRequest) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+func (x *CastProxyVoteRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type CastProxyVoteResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TargetParticipantId string                 `protobuf:"bytes,1,opt,name=target_participant_id,json=targetParticipantId,proto3" json:"target_participant_id,omitempty"` // Participant the vote was recorded for
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CastProxyVoteResponse) Reset() {
+	*x = CastProxyVoteResponse{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CastProxyVoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CastProxyVoteResponse) ProtoMessage() {}
+
+func (x *CastProxyVoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CastProxyVoteResponse.ProtoReflect.Descriptor instead.
+func (*CastProxyVoteResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CastProxyVoteResponse) GetTargetParticipantId() string {
+	if x != nil {
+		return x.TargetParticipantId
+	}
+	return ""
+}
+
+// CastDotsRequest places dots on options in a dot-voting room
+type CastDotsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Summary       *VoteSummary           `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Dots          []*DotAllocation       `protobuf:"bytes,4,rep,name=dots,proto3" json:"dots,omitempty"` // Replaces any previous placement
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RevealVotesResponse) Reset() {
-	*x = RevealVotesResponse{}
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[5]
+func (x *CastDotsRequest) Reset() {
+	*x = CastDotsRequest{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevealVotesResponse) String() string {
+func (x *CastDotsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevealVotesResponse) ProtoMessage() {}
+func (*CastDotsRequest) ProtoMessage() {}
 
-func (x *RevealVotesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[5]
+func (x *CastDotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CastDotsRequest.ProtoReflect.Descriptor instead.
+func (*CastDotsRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CastDotsRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *CastDotsRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *CastDotsRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *CastDotsRequest) GetDots() []*DotAllocation {
+	if x != nil {
+		return x.Dots
+	}
+	return nil
+}
+
+type CastDotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CastDotsResponse) Reset() {
+	*x = CastDotsResponse{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CastDotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CastDotsResponse) ProtoMessage() {}
+
+func (x *CastDotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CastDotsResponse.ProtoReflect.Descriptor instead.
+func (*CastDotsResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{10}
+}
+
+// RevealVotesRequest reveals all votes
+type RevealVotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be host or facilitator
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevealVotesRequest) Reset() {
+	*x = RevealVotesRequest{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevealVotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealVotesRequest) ProtoMessage() {}
+
+func (x *RevealVotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealVotesRequest.ProtoReflect.Descriptor instead.
+func (*RevealVotesRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RevealVotesRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RevealVotesRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *RevealVotesRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type RevealVotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       *VoteSummary           `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevealVotesResponse) Reset() {
+	*x = RevealVotesResponse{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevealVotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealVotesResponse) ProtoMessage() {}
+
+func (x *RevealVotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -366,41 +823,333 @@ func (x *RevealVotesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevealVotesResponse.ProtoReflect.Descriptor instead.
 func (*RevealVotesResponse) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{5}
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RevealVotesResponse) GetSummary() *VoteSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+// ResetRoundRequest clears votes for a new round
+type ResetRoundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be host or facilitator
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetRoundRequest) Reset() {
+	*x = ResetRoundRequest{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetRoundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetRoundRequest) ProtoMessage() {}
+
+func (x *ResetRoundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetRoundRequest.ProtoReflect.Descriptor instead.
+func (*ResetRoundRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ResetRoundRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ResetRoundRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *ResetRoundRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type ResetRoundResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetRoundResponse) Reset() {
+	*x = ResetRoundResponse{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetRoundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetRoundResponse) ProtoMessage() {}
+
+func (x *ResetRoundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetRoundResponse.ProtoReflect.Descriptor instead.
+func (*ResetRoundResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{14}
+}
+
+// StartRoundRequest begins a new voting round
+type StartRoundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be host or facilitator
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartRoundRequest) Reset() {
+	*x = StartRoundRequest{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartRoundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRoundRequest) ProtoMessage() {}
+
+func (x *StartRoundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRoundRequest.ProtoReflect.Descriptor instead.
+func (*StartRoundRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StartRoundRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *StartRoundRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *StartRoundRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type StartRoundResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartRoundResponse) Reset() {
+	*x = StartRoundResponse{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartRoundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRoundResponse) ProtoMessage() {}
+
+func (x *StartRoundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRoundResponse.ProtoReflect.Descriptor instead.
+func (*StartRoundResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{16}
+}
+
+// UndoLastTransitionRequest undoes a recent start, reveal or reset
+type UndoLastTransitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndoLastTransitionRequest) Reset() {
+	*x = UndoLastTransitionRequest{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndoLastTransitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoLastTransitionRequest) ProtoMessage() {}
+
+func (x *UndoLastTransitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoLastTransitionRequest.ProtoReflect.Descriptor instead.
+func (*UndoLastTransitionRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UndoLastTransitionRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *UndoLastTransitionRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *UndoLastTransitionRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type UndoLastTransitionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndoLastTransitionResponse) Reset() {
+	*x = UndoLastTransitionResponse{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndoLastTransitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoLastTransitionResponse) ProtoMessage() {}
+
+func (x *UndoLastTransitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *RevealVotesResponse) GetSummary() *VoteSummary {
-	if x != nil {
-		return x.Summary
-	}
-	return nil
+// Deprecated: Use UndoLastTransitionResponse.ProtoReflect.Descriptor instead.
+func (*UndoLastTransitionResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{18}
 }
 
-// ResetRoundRequest clears votes for a new round
-type ResetRoundRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be host
-	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// SetVotingDeadlineRequest sets the deadline of the current async round
+type SetVotingDeadlineRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RoomId         string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId  string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	SessionToken   string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	VotingDeadline int64                  `protobuf:"varint,4,opt,name=voting_deadline,json=votingDeadline,proto3" json:"voting_deadline,omitempty"` // Unix timestamp; votes are revealed automatically at this time
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ResetRoundRequest) Reset() {
-	*x = ResetRoundRequest{}
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[6]
+func (x *SetVotingDeadlineRequest) Reset() {
+	*x = SetVotingDeadlineRequest{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetRoundRequest) String() string {
+func (x *SetVotingDeadlineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetRoundRequest) ProtoMessage() {}
+func (*SetVotingDeadlineRequest) ProtoMessage() {}
 
-func (x *ResetRoundRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[6]
+func (x *SetVotingDeadlineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,53 +1160,60 @@ func (x *ResetRoundRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetRoundRequest.ProtoReflect.Descriptor instead.
-func (*ResetRoundRequest) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use SetVotingDeadlineRequest.ProtoReflect.Descriptor instead.
+func (*SetVotingDeadlineRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ResetRoundRequest) GetRoomId() string {
+func (x *SetVotingDeadlineRequest) GetRoomId() string {
 	if x != nil {
 		return x.RoomId
 	}
 	return ""
 }
 
-func (x *ResetRoundRequest) GetParticipantId() string {
+func (x *SetVotingDeadlineRequest) GetParticipantId() string {
 	if x != nil {
 		return x.ParticipantId
 	}
 	return ""
 }
 
-func (x *ResetRoundRequest) GetSessionToken() string {
+func (x *SetVotingDeadlineRequest) GetSessionToken() string {
 	if x != nil {
 		return x.SessionToken
 	}
 	return ""
 }
 
-type ResetRoundResponse struct {
+func (x *SetVotingDeadlineRequest) GetVotingDeadline() int64 {
+	if x != nil {
+		return x.VotingDeadline
+	}
+	return 0
+}
+
+type SetVotingDeadlineResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetRoundResponse) Reset() {
-	*x = ResetRoundResponse{}
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[7]
+func (x *SetVotingDeadlineResponse) Reset() {
+	*x = SetVotingDeadlineResponse{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetRoundResponse) String() string {
+func (x *SetVotingDeadlineResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetRoundResponse) ProtoMessage() {}
+func (*SetVotingDeadlineResponse) ProtoMessage() {}
 
-func (x *ResetRoundResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[7]
+func (x *SetVotingDeadlineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -468,36 +1224,35 @@ func (x *ResetRoundResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetRoundResponse.ProtoReflect.Descriptor instead.
-func (*ResetRoundResponse) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SetVotingDeadlineResponse.ProtoReflect.Descriptor instead.
+func (*SetVotingDeadlineResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{20}
 }
 
-// StartRoundRequest begins a new voting round
-type StartRoundRequest struct {
+// GetRoundHistoryRequest fetches a room's revealed rounds
+type GetRoundHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be host
-	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	SessionToken  string                 `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"` // Must belong to a participant of the room
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartRoundRequest) Reset() {
-	*x = StartRoundRequest{}
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[8]
+func (x *GetRoundHistoryRequest) Reset() {
+	*x = GetRoundHistoryRequest{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartRoundRequest) String() string {
+func (x *GetRoundHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartRoundRequest) ProtoMessage() {}
+func (*GetRoundHistoryRequest) ProtoMessage() {}
 
-func (x *StartRoundRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[8]
+func (x *GetRoundHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -508,53 +1263,47 @@ func (x *StartRoundRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartRoundRequest.ProtoReflect.Descriptor instead.
-func (*StartRoundRequest) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetRoundHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetRoundHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *StartRoundRequest) GetRoomId() string {
+func (x *GetRoundHistoryRequest) GetRoomId() string {
 	if x != nil {
 		return x.RoomId
 	}
 	return ""
 }
 
-func (x *StartRoundRequest) GetParticipantId() string {
-	if x != nil {
-		return x.ParticipantId
-	}
-	return ""
-}
-
-func (x *StartRoundRequest) GetSessionToken() string {
+func (x *GetRoundHistoryRequest) GetSessionToken() string {
 	if x != nil {
 		return x.SessionToken
 	}
 	return ""
 }
 
-type StartRoundResponse struct {
+type GetRoundHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rounds        []*RoundResult         `protobuf:"bytes,1,rep,name=rounds,proto3" json:"rounds,omitempty"` // Oldest first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartRoundResponse) Reset() {
-	*x = StartRoundResponse{}
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[9]
+func (x *GetRoundHistoryResponse) Reset() {
+	*x = GetRoundHistoryResponse{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartRoundResponse) String() string {
+func (x *GetRoundHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartRoundResponse) ProtoMessage() {}
+func (*GetRoundHistoryResponse) ProtoMessage() {}
 
-func (x *StartRoundResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[9]
+func (x *GetRoundHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -565,23 +1314,33 @@ func (x *StartRoundResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartRoundResponse.ProtoReflect.Descriptor instead.
-func (*StartRoundResponse) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetRoundHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetRoundHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetRoundHistoryResponse) GetRounds() []*RoundResult {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
 }
 
 // WatchVotesRequest subscribes to vote updates
 type WatchVotesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	SessionToken  string                 `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	RoomId              string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	SessionToken        string                 `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Passcode            string                 `protobuf:"bytes,3,opt,name=passcode,proto3" json:"passcode,omitempty"`                                                     // Needed by non-participants if the room has a passcode
+	ResumeAfterSequence uint64                 `protobuf:"varint,4,opt,name=resume_after_sequence,json=resumeAfterSequence,proto3" json:"resume_after_sequence,omitempty"` // Last sequence received on an earlier stream; the stream continues after it
+	ResumeEpoch         uint64                 `protobuf:"varint,5,opt,name=resume_epoch,json=resumeEpoch,proto3" json:"resume_epoch,omitempty"`                           // Epoch of that sequence; a stale epoch gets a resync instead
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *WatchVotesRequest) Reset() {
 	*x = WatchVotesRequest{}
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[10]
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -593,7 +1352,7 @@ func (x *WatchVotesRequest) String() string {
 func (*WatchVotesRequest) ProtoMessage() {}
 
 func (x *WatchVotesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[10]
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -606,7 +1365,7 @@ func (x *WatchVotesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchVotesRequest.ProtoReflect.Descriptor instead.
 func (*WatchVotesRequest) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{10}
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *WatchVotesRequest) GetRoomId() string {
@@ -623,14 +1382,41 @@ func (x *WatchVotesRequest) GetSessionToken() string {
 	return ""
 }
 
-// VoteEvent is sent when vote state changes
+func (x *WatchVotesRequest) GetPasscode() string {
+	if x != nil {
+		return x.Passcode
+	}
+	return ""
+}
+
+func (x *WatchVotesRequest) GetResumeAfterSequence() uint64 {
+	if x != nil {
+		return x.ResumeAfterSequence
+	}
+	return 0
+}
+
+func (x *WatchVotesRequest) GetResumeEpoch() uint64 {
+	if x != nil {
+		return x.ResumeEpoch
+	}
+	return 0
+}
+
+// VoteEvent is sent when vote state changes. Events describing the state at the
+// start of a stream or after a resync carry the sequence that state reflects.
 type VoteEvent struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sequence uint64                 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"` // Position in the room's event sequence (heartbeats repeat the last one)
+	Epoch    uint64                 `protobuf:"varint,8,opt,name=epoch,proto3" json:"epoch,omitempty"`       // Run of the room's event sequence that sequence belongs to
 	// Types that are valid to be assigned to Event:
 	//
 	//	*VoteEvent_VoteCast
 	//	*VoteEvent_VotesRevealed
 	//	*VoteEvent_RoundReset
+	//	*VoteEvent_Heartbeat
+	//	*VoteEvent_Resync
+	//	*VoteEvent_VoteRetracted
 	Event         isVoteEvent_Event `protobuf_oneof:"event"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -638,7 +1424,7 @@ type VoteEvent struct {
 
 func (x *VoteEvent) Reset() {
 	*x = VoteEvent{}
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[11]
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -650,7 +1436,7 @@ func (x *VoteEvent) String() string {
 func (*VoteEvent) ProtoMessage() {}
 
 func (x *VoteEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[11]
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -663,7 +1449,21 @@ func (x *VoteEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VoteEvent.ProtoReflect.Descriptor instead.
 func (*VoteEvent) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{11}
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *VoteEvent) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *VoteEvent) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
 }
 
 func (x *VoteEvent) GetEvent() isVoteEvent_Event {
@@ -700,6 +1500,33 @@ func (x *VoteEvent) GetRoundReset() *RoundReset {
 	return nil
 }
 
+func (x *VoteEvent) GetHeartbeat() *VoteHeartbeat {
+	if x != nil {
+		if x, ok := x.Event.(*VoteEvent_Heartbeat); ok {
+			return x.Heartbeat
+		}
+	}
+	return nil
+}
+
+func (x *VoteEvent) GetResync() *VoteResync {
+	if x != nil {
+		if x, ok := x.Event.(*VoteEvent_Resync); ok {
+			return x.Resync
+		}
+	}
+	return nil
+}
+
+func (x *VoteEvent) GetVoteRetracted() *VoteRetracted {
+	if x != nil {
+		if x, ok := x.Event.(*VoteEvent_VoteRetracted); ok {
+			return x.VoteRetracted
+		}
+	}
+	return nil
+}
+
 type isVoteEvent_Event interface {
 	isVoteEvent_Event()
 }
@@ -716,23 +1543,42 @@ type VoteEvent_RoundReset struct {
 	RoundReset *RoundReset `protobuf:"bytes,3,opt,name=round_reset,json=roundReset,proto3,oneof"`
 }
 
+type VoteEvent_Heartbeat struct {
+	Heartbeat *VoteHeartbeat `protobuf:"bytes,4,opt,name=heartbeat,proto3,oneof"`
+}
+
+type VoteEvent_Resync struct {
+	Resync *VoteResync `protobuf:"bytes,6,opt,name=resync,proto3,oneof"`
+}
+
+type VoteEvent_VoteRetracted struct {
+	VoteRetracted *VoteRetracted `protobuf:"bytes,7,opt,name=vote_retracted,json=voteRetracted,proto3,oneof"`
+}
+
 func (*VoteEvent_VoteCast) isVoteEvent_Event() {}
 
 func (*VoteEvent_VotesRevealed) isVoteEvent_Event() {}
 
 func (*VoteEvent_RoundReset) isVoteEvent_Event() {}
 
+func (*VoteEvent_Heartbeat) isVoteEvent_Event() {}
+
+func (*VoteEvent_Resync) isVoteEvent_Event() {}
+
+func (*VoteEvent_VoteRetracted) isVoteEvent_Event() {}
+
 type VoteCast struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ParticipantId   string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
 	ParticipantName string                 `protobuf:"bytes,2,opt,name=participant_name,json=participantName,proto3" json:"participant_name,omitempty"` // Value is not included - only that they voted (until reveal)
+	IsProxy         bool                   `protobuf:"varint,3,opt,name=is_proxy,json=isProxy,proto3" json:"is_proxy,omitempty"`                        // Entered by the host on behalf of an absent participant
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *VoteCast) Reset() {
 	*x = VoteCast{}
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[12]
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -744,7 +1590,7 @@ func (x *VoteCast) String() string {
 func (*VoteCast) ProtoMessage() {}
 
 func (x *VoteCast) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[12]
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -757,7 +1603,7 @@ func (x *VoteCast) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VoteCast.ProtoReflect.Descriptor instead.
 func (*VoteCast) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{12}
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *VoteCast) GetParticipantId() string {
@@ -774,6 +1620,13 @@ func (x *VoteCast) GetParticipantName() string {
 	return ""
 }
 
+func (x *VoteCast) GetIsProxy() bool {
+	if x != nil {
+		return x.IsProxy
+	}
+	return false
+}
+
 type VotesRevealed struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Summary       *VoteSummary           `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
@@ -783,7 +1636,7 @@ type VotesRevealed struct {
 
 func (x *VotesRevealed) Reset() {
 	*x = VotesRevealed{}
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[13]
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -795,7 +1648,7 @@ func (x *VotesRevealed) String() string {
 func (*VotesRevealed) ProtoMessage() {}
 
 func (x *VotesRevealed) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[13]
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,7 +1661,7 @@ func (x *VotesRevealed) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VotesRevealed.ProtoReflect.Descriptor instead.
 func (*VotesRevealed) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{13}
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *VotesRevealed) GetSummary() *VoteSummary {
@@ -826,7 +1679,7 @@ type RoundReset struct {
 
 func (x *RoundReset) Reset() {
 	*x = RoundReset{}
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[14]
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -838,7 +1691,7 @@ func (x *RoundReset) String() string {
 func (*RoundReset) ProtoMessage() {}
 
 func (x *RoundReset) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_estimation_proto_msgTypes[14]
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -851,7 +1704,137 @@ func (x *RoundReset) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoundReset.ProtoReflect.Descriptor instead.
 func (*RoundReset) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{14}
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{27}
+}
+
+// VoteRetracted is sent when a participant's vote in the open round is dropped,
+// such as when they become a spectator
+type VoteRetracted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantId string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoteRetracted) Reset() {
+	*x = VoteRetracted{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteRetracted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteRetracted) ProtoMessage() {}
+
+func (x *VoteRetracted) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteRetracted.ProtoReflect.Descriptor instead.
+func (*VoteRetracted) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *VoteRetracted) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+// VoteResync is sent to a resuming stream when the events it missed are no
+// longer available; the client discards its vote state and the events that
+// follow rebuild it
+type VoteResync struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoteResync) Reset() {
+	*x = VoteResync{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteResync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteResync) ProtoMessage() {}
+
+func (x *VoteResync) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteResync.ProtoReflect.Descriptor instead.
+func (*VoteResync) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{29}
+}
+
+// VoteHeartbeat is sent periodically on an otherwise idle stream so proxies keep it open
+type VoteHeartbeat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SentAt        int64                  `protobuf:"varint,1,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoteHeartbeat) Reset() {
+	*x = VoteHeartbeat{}
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteHeartbeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteHeartbeat) ProtoMessage() {}
+
+func (x *VoteHeartbeat) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_estimation_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteHeartbeat.ProtoReflect.Descriptor instead.
+func (*VoteHeartbeat) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_estimation_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *VoteHeartbeat) GetSentAt() int64 {
+	if x != nil {
+		return x.SentAt
+	}
+	return 0
 }
 
 var File_esteemed_v1_estimation_proto protoreflect.FileDescriptor
@@ -859,7 +1842,7 @@ var File_esteemed_v1_estimation_proto protoreflect.FileDescriptor
 var file_esteemed_v1_estimation_proto_rawDesc = []byte{
 	0x0a, 0x1c, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x73,
 	0x74, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b,
-	0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x22, 0x8b, 0x01, 0x0a, 0x04,
+	0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x22, 0xfa, 0x01, 0x0a, 0x04,
 	0x56, 0x6f, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
 	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61,
 	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x70,
@@ -868,118 +1851,273 @@ var file_esteemed_v1_estimation_proto_rawDesc = []byte{
 	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
 	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09,
 	0x68, 0x61, 0x73, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x68, 0x61, 0x73, 0x56, 0x6f, 0x74, 0x65, 0x64, 0x22, 0xb2, 0x01, 0x0a, 0x0b, 0x56, 0x6f,
-	0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x6f, 0x74,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65,
-	0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74,
-	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x12, 0x23, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x68, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x73,
-	0x65, 0x6e, 0x73, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63,
-	0x5f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
-	0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x22, 0x8c,
-	0x01, 0x0a, 0x0f, 0x43, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x08, 0x68, 0x61, 0x73, 0x56, 0x6f, 0x74, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x6f, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d,
+	0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x6f, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x63, 0x61,
+	0x73, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x43, 0x61, 0x73, 0x74, 0x42, 0x79, 0x22, 0x3b, 0x0a, 0x0d, 0x44, 0x6f, 0x74, 0x41,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x64, 0x6f, 0x74, 0x73, 0x22, 0x6d, 0x0a, 0x0a, 0x44, 0x6f, 0x74, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x6f, 0x74, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe5, 0x01, 0x0a, 0x0b, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x68,
+	0x61, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x68, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x61, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x65, 0x72,
+	0x69, 0x63, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x61, 0x6e,
+	0x6b, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x73, 0x74,
+	0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x74, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0xb2, 0x01, 0x0a,
+	0x0b, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a,
+	0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x6f, 0x6f,
+	0x6d, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a,
+	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74,
+	0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x22, 0x8c, 0x01, 0x0a, 0x0f, 0x43, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x12, 0x0a, 0x10, 0x43, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe6, 0x01, 0x0a, 0x14, 0x43, 0x61, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x13, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4b, 0x0a,
+	0x15, 0x43, 0x61, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xa6, 0x01, 0x0a, 0x0f, 0x43,
+	0x61, 0x73, 0x74, 0x44, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x6f, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x6f, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64,
+	0x6f, 0x74, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x43, 0x61, 0x73, 0x74, 0x44, 0x6f, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x79, 0x0a, 0x12, 0x52, 0x65, 0x76, 0x65, 0x61,
+	0x6c, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x49, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x73, 0x74,
+	0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x78, 0x0a,
+	0x11, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70,
 	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
 	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f,
 	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x12, 0x0a,
-	0x10, 0x43, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x79, 0x0a, 0x12, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64,
-	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
-	0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x49, 0x0a, 0x13,
-	0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07,
-	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x78, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x74,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
-	0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
-	0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68,
-	0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
-	0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xcb, 0x01, 0x0a, 0x09, 0x56,
-	0x6f, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65,
-	0x5f, 0x63, 0x61, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x73,
-	0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x61,
-	0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x76, 0x6f, 0x74, 0x65, 0x43, 0x61, 0x73, 0x74, 0x12, 0x43,
-	0x0a, 0x0e, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c,
-	0x65, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x76, 0x65, 0x61,
-	0x6c, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x73,
-	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65,
-	0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x65,
-	0x74, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x42,
-	0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x5c, 0x0a, 0x08, 0x56, 0x6f, 0x74, 0x65,
-	0x43, 0x61, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
-	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x70,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
-	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x43, 0x0a, 0x0d, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52,
-	0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65,
-	0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x0c, 0x0a, 0x0a, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x32, 0x94, 0x03, 0x0a, 0x11, 0x45, 0x73,
-	0x74, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x47, 0x0a, 0x08, 0x43, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x65, 0x73,
-	0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x73, 0x74, 0x56, 0x6f,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x73, 0x74, 0x65,
-	0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x52, 0x65, 0x76, 0x65,
-	0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d,
-	0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65,
-	0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x6f, 0x74,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x52, 0x65,
-	0x73, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65,
-	0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65,
-	0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d,
-	0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d,
-	0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63,
-	0x68, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01,
-	0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76,
-	0x69, 0x63, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d,
-	0x65, 0x64, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x65,
-	0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x73, 0x74, 0x65, 0x65,
-	0x6d, 0x65, 0x64, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x0a,
+	0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x80, 0x01,
+	0x0a, 0x19, 0x55, 0x6e, 0x64, 0x6f, 0x4c, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72,
+	0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f,
+	0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x1c, 0x0a, 0x1a, 0x55, 0x6e, 0x64, 0x6f, 0x4c, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa8,
+	0x01, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72,
+	0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f,
+	0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x27, 0x0a, 0x0f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x76, 0x6f, 0x74, 0x69, 0x6e,
+	0x67, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x65, 0x74,
+	0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4b,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x73, 0x74, 0x65,
+	0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0xc4, 0x01, 0x0a, 0x11,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x22, 0xb1, 0x03, 0x0a, 0x09, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x34, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x61, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x61, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08,
+	0x76, 0x6f, 0x74, 0x65, 0x43, 0x61, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0e, 0x76, 0x6f, 0x74, 0x65,
+	0x73, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d,
+	0x76, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x12, 0x3a, 0x0a,
+	0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x68, 0x65, 0x61,
+	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65,
+	0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x48,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x48, 0x00, 0x52, 0x09, 0x68, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x48, 0x00,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x43, 0x0a, 0x0e, 0x76, 0x6f, 0x74, 0x65,
+	0x5f, 0x72, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x6f, 0x74, 0x65, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d,
+	0x76, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x42, 0x07, 0x0a,
+	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x77, 0x0a, 0x08, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x61,
+	0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x22,
+	0x43, 0x0a, 0x0d, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64,
+	0x12, 0x32, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x6f, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x22, 0x0c, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x22, 0x36, 0x0a, 0x0d, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x0c, 0x0a, 0x0a, 0x56, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x22, 0x28, 0x0a, 0x0d, 0x56, 0x6f, 0x74, 0x65,
+	0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x6e,
+	0x74, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x74,
+	0x41, 0x74, 0x32, 0xde, 0x06, 0x0a, 0x11, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x08, 0x43, 0x61, 0x73, 0x74,
+	0x56, 0x6f, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x56, 0x0a, 0x0d, 0x43, 0x61, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x56, 0x6f,
+	0x74, 0x65, 0x12, 0x21, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x56, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x08, 0x43, 0x61, 0x73,
+	0x74, 0x44, 0x6f, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x73, 0x74, 0x44, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x73, 0x74, 0x44, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65,
+	0x73, 0x12, 0x1f, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x1e, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x1e, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x55, 0x6e, 0x64, 0x6f, 0x4c, 0x61, 0x73, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65,
+	0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x64, 0x6f, 0x4c, 0x61, 0x73, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x6e, 0x64, 0x6f, 0x4c, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x53, 0x65, 0x74,
+	0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x25,
+	0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
+	0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x23, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x65, 0x73, 0x74, 0x65,
+	0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x56, 0x6f, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x65, 0x73, 0x74, 0x65,
+	0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x30, 0x01, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x76, 0x69, 0x63, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x65, 0x73, 0x74,
+	0x65, 0x65, 0x6d, 0x65, 0x64, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x67, 0x65,
+	0x6e, 0x2f, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x73,
+	0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -994,46 +2132,80 @@ func file_esteemed_v1_estimation_proto_rawDescGZIP() []byte {
 	return file_esteemed_v1_estimation_proto_rawDescData
 }
 
-var file_esteemed_v1_estimation_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_esteemed_v1_estimation_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_esteemed_v1_estimation_proto_goTypes = []any{
-	(*Vote)(nil),                // 0: esteemed.v1.Vote
-	(*VoteSummary)(nil),         // 1: esteemed.v1.VoteSummary
-	(*CastVoteRequest)(nil),     // 2: esteemed.v1.CastVoteRequest
-	(*CastVoteResponse)(nil),    // 3: esteemed.v1.CastVoteResponse
-	(*RevealVotesRequest)(nil),  // 4: esteemed.v1.RevealVotesRequest
-	(*RevealVotesResponse)(nil), // 5: esteemed.v1.RevealVotesResponse
-	(*ResetRoundRequest)(nil),   // 6: esteemed.v1.ResetRoundRequest
-	(*ResetRoundResponse)(nil),  // 7: esteemed.v1.ResetRoundResponse
-	(*StartRoundRequest)(nil),   // 8: esteemed.v1.StartRoundRequest
-	(*StartRoundResponse)(nil),  // 9: esteemed.v1.StartRoundResponse
-	(*WatchVotesRequest)(nil),   // 10: esteemed.v1.WatchVotesRequest
-	(*VoteEvent)(nil),           // 11: esteemed.v1.VoteEvent
-	(*VoteCast)(nil),            // 12: esteemed.v1.VoteCast
-	(*VotesRevealed)(nil),       // 13: esteemed.v1.VotesRevealed
-	(*RoundReset)(nil),          // 14: esteemed.v1.RoundReset
+	(*Vote)(nil),                       // 0: esteemed.v1.Vote
+	(*DotAllocation)(nil),              // 1: esteemed.v1.DotAllocation
+	(*DotRanking)(nil),                 // 2: esteemed.v1.DotRanking
+	(*VoteSummary)(nil),                // 3: esteemed.v1.VoteSummary
+	(*RoundResult)(nil),                // 4: esteemed.v1.RoundResult
+	(*CastVoteRequest)(nil),            // 5: esteemed.v1.CastVoteRequest
+	(*CastVoteResponse)(nil),           // 6: esteemed.v1.CastVoteResponse
+	(*CastProxyVoteRequest)(nil),       // 7: esteemed.v1.CastProxyVoteRequest
+	(*CastProxyVoteResponse)(nil),      // 8: esteemed.v1.CastProxyVoteResponse
+	(*CastDotsRequest)(nil),            // 9: esteemed.v1.CastDotsRequest
+	(*CastDotsResponse)(nil),           // 10: esteemed.v1.CastDotsResponse
+	(*RevealVotesRequest)(nil),         // 11: esteemed.v1.RevealVotesRequest
+	(*RevealVotesResponse)(nil),        // 12: esteemed.v1.RevealVotesResponse
+	(*ResetRoundRequest)(nil),          // 13: esteemed.v1.ResetRoundRequest
+	(*ResetRoundResponse)(nil),         // 14: esteemed.v1.ResetRoundResponse
+	(*StartRoundRequest)(nil),          // 15: esteemed.v1.StartRoundRequest
+	(*StartRoundResponse)(nil),         // 16: esteemed.v1.StartRoundResponse
+	(*UndoLastTransitionRequest)(nil),  // 17: esteemed.v1.UndoLastTransitionRequest
+	(*UndoLastTransitionResponse)(nil), // 18: esteemed.v1.UndoLastTransitionResponse
+	(*SetVotingDeadlineRequest)(nil),   // 19: esteemed.v1.SetVotingDeadlineRequest
+	(*SetVotingDeadlineResponse)(nil),  // 20: esteemed.v1.SetVotingDeadlineResponse
+	(*GetRoundHistoryRequest)(nil),     // 21: esteemed.v1.GetRoundHistoryRequest
+	(*GetRoundHistoryResponse)(nil),    // 22: esteemed.v1.GetRoundHistoryResponse
+	(*WatchVotesRequest)(nil),          // 23: esteemed.v1.WatchVotesRequest
+	(*VoteEvent)(nil),                  // 24: esteemed.v1.VoteEvent
+	(*VoteCast)(nil),                   // 25: esteemed.v1.VoteCast
+	(*VotesRevealed)(nil),              // 26: esteemed.v1.VotesRevealed
+	(*RoundReset)(nil),                 // 27: esteemed.v1.RoundReset
+	(*VoteRetracted)(nil),              // 28: esteemed.v1.VoteRetracted
+	(*VoteResync)(nil),                 // 29: esteemed.v1.VoteResync
+	(*VoteHeartbeat)(nil),              // 30: esteemed.v1.VoteHeartbeat
 }
 var file_esteemed_v1_estimation_proto_depIdxs = []int32{
-	0,  // 0: esteemed.v1.VoteSummary.votes:type_name -> esteemed.v1.Vote
-	1,  // 1: esteemed.v1.RevealVotesResponse.summary:type_name -> esteemed.v1.VoteSummary
-	12, // 2: esteemed.v1.VoteEvent.vote_cast:type_name -> esteemed.v1.VoteCast
-	13, // 3: esteemed.v1.VoteEvent.votes_revealed:type_name -> esteemed.v1.VotesRevealed
-	14, // 4: esteemed.v1.VoteEvent.round_reset:type_name -> esteemed.v1.RoundReset
-	1,  // 5: esteemed.v1.VotesRevealed.summary:type_name -> esteemed.v1.VoteSummary
-	2,  // 6: esteemed.v1.EstimationService.CastVote:input_type -> esteemed.v1.CastVoteRequest
-	4,  // 7: esteemed.v1.EstimationService.RevealVotes:input_type -> esteemed.v1.RevealVotesRequest
-	6,  // 8: esteemed.v1.EstimationService.ResetRound:input_type -> esteemed.v1.ResetRoundRequest
-	8,  // 9: esteemed.v1.EstimationService.StartRound:input_type -> esteemed.v1.StartRoundRequest
-	10, // 10: esteemed.v1.EstimationService.WatchVotes:input_type -> esteemed.v1.WatchVotesRequest
-	3,  // 11: esteemed.v1.EstimationService.CastVote:output_type -> esteemed.v1.CastVoteResponse
-	5,  // 12: esteemed.v1.EstimationService.RevealVotes:output_type -> esteemed.v1.RevealVotesResponse
-	7,  // 13: esteemed.v1.EstimationService.ResetRound:output_type -> esteemed.v1.ResetRoundResponse
-	9,  // 14: esteemed.v1.EstimationService.StartRound:output_type -> esteemed.v1.StartRoundResponse
-	11, // 15: esteemed.v1.EstimationService.WatchVotes:output_type -> esteemed.v1.VoteEvent
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	1,  // 0: esteemed.v1.Vote.dots:type_name -> esteemed.v1.DotAllocation
+	0,  // 1: esteemed.v1.VoteSummary.votes:type_name -> esteemed.v1.Vote
+	2,  // 2: esteemed.v1.VoteSummary.ranking:type_name -> esteemed.v1.DotRanking
+	3,  // 3: esteemed.v1.RoundResult.summary:type_name -> esteemed.v1.VoteSummary
+	1,  // 4: esteemed.v1.CastDotsRequest.dots:type_name -> esteemed.v1.DotAllocation
+	3,  // 5: esteemed.v1.RevealVotesResponse.summary:type_name -> esteemed.v1.VoteSummary
+	4,  // 6: esteemed.v1.GetRoundHistoryResponse.rounds:type_name -> esteemed.v1.RoundResult
+	25, // 7: esteemed.v1.VoteEvent.vote_cast:type_name -> esteemed.v1.VoteCast
+	26, // 8: esteemed.v1.VoteEvent.votes_revealed:type_name -> esteemed.v1.VotesRevealed
+	27, // 9: esteemed.v1.VoteEvent.round_reset:type_name -> esteemed.v1.RoundReset
+	30, // 10: esteemed.v1.VoteEvent.heartbeat:type_name -> esteemed.v1.VoteHeartbeat
+	29, // 11: esteemed.v1.VoteEvent.resync:type_name -> esteemed.v1.VoteResync
+	28, // 12: esteemed.v1.VoteEvent.vote_retracted:type_name -> esteemed.v1.VoteRetracted
+	3,  // 13: esteemed.v1.VotesRevealed.summary:type_name -> esteemed.v1.VoteSummary
+	5,  // 14: esteemed.v1.EstimationService.CastVote:input_type -> esteemed.v1.CastVoteRequest
+	7,  // 15: esteemed.v1.EstimationService.CastProxyVote:input_type -> esteemed.v1.CastProxyVoteRequest
+	9,  // 16: esteemed.v1.EstimationService.CastDots:input_type -> esteemed.v1.CastDotsRequest
+	11, // 17: esteemed.v1.EstimationService.RevealVotes:input_type -> esteemed.v1.RevealVotesRequest
+	13, // 18: esteemed.v1.EstimationService.ResetRound:input_type -> esteemed.v1.ResetRoundRequest
+	15, // 19: esteemed.v1.EstimationService.StartRound:input_type -> esteemed.v1.StartRoundRequest
+	17, // 20: esteemed.v1.EstimationService.UndoLastTransition:input_type -> esteemed.v1.UndoLastTransitionRequest
+	19, // 21: esteemed.v1.EstimationService.SetVotingDeadline:input_type -> esteemed.v1.SetVotingDeadlineRequest
+	21, // 22: esteemed.v1.EstimationService.GetRoundHistory:input_type -> esteemed.v1.GetRoundHistoryRequest
+	23, // 23: esteemed.v1.EstimationService.WatchVotes:input_type -> esteemed.v1.WatchVotesRequest
+	6,  // 24: esteemed.v1.EstimationService.CastVote:output_type -> esteemed.v1.CastVoteResponse
+	8,  // 25: esteemed.v1.EstimationService.CastProxyVote:output_type -> esteemed.v1.CastProxyVoteResponse
+	10, // 26: esteemed.v1.EstimationService.CastDots:output_type -> esteemed.v1.CastDotsResponse
+	12, // 27: esteemed.v1.EstimationService.RevealVotes:output_type -> esteemed.v1.RevealVotesResponse
+	14, // 28: esteemed.v1.EstimationService.ResetRound:output_type -> esteemed.v1.ResetRoundResponse
+	16, // 29: esteemed.v1.EstimationService.StartRound:output_type -> esteemed.v1.StartRoundResponse
+	18, // 30: esteemed.v1.EstimationService.UndoLastTransition:output_type -> esteemed.v1.UndoLastTransitionResponse
+	20, // 31: esteemed.v1.EstimationService.SetVotingDeadline:output_type -> esteemed.v1.SetVotingDeadlineResponse
+	22, // 32: esteemed.v1.EstimationService.GetRoundHistory:output_type -> esteemed.v1.GetRoundHistoryResponse
+	24, // 33: esteemed.v1.EstimationService.WatchVotes:output_type -> esteemed.v1.VoteEvent
+	24, // [24:34] is the sub-list for method output_type
+	14, // [14:24] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_esteemed_v1_estimation_proto_init() }
@@ -1041,10 +2213,13 @@ func file_esteemed_v1_estimation_proto_init() {
 	if File_esteemed_v1_estimation_proto != nil {
 		return
 	}
-	file_esteemed_v1_estimation_proto_msgTypes[11].OneofWrappers = []any{
+	file_esteemed_v1_estimation_proto_msgTypes[24].OneofWrappers = []any{
 		(*VoteEvent_VoteCast)(nil),
 		(*VoteEvent_VotesRevealed)(nil),
 		(*VoteEvent_RoundReset)(nil),
+		(*VoteEvent_Heartbeat)(nil),
+		(*VoteEvent_Resync)(nil),
+		(*VoteEvent_VoteRetracted)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1052,7 +2227,7 @@ func file_esteemed_v1_estimation_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_esteemed_v1_estimation_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
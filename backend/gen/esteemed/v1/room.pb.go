@@ -73,6 +73,112 @@ func (CardPreset) EnumDescriptor() ([]byte, []int) {
 	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{0}
 }
 
+// RoomMode selects how participants vote in a room
+type RoomMode int32
+
+const (
+	RoomMode_ROOM_MODE_UNSPECIFIED RoomMode = 0
+	RoomMode_ROOM_MODE_ESTIMATION  RoomMode = 1 // One card per participant (planning poker)
+	RoomMode_ROOM_MODE_DOT_VOTING  RoomMode = 2 // Dots spread across options (prioritization)
+)
+
+// Enum value maps for RoomMode.
+var (
+	RoomMode_name = map[int32]string{
+		0: "ROOM_MODE_UNSPECIFIED",
+		1: "ROOM_MODE_ESTIMATION",
+		2: "ROOM_MODE_DOT_VOTING",
+	}
+	RoomMode_value = map[string]int32{
+		"ROOM_MODE_UNSPECIFIED": 0,
+		"ROOM_MODE_ESTIMATION":  1,
+		"ROOM_MODE_DOT_VOTING":  2,
+	}
+)
+
+func (x RoomMode) Enum() *RoomMode {
+	p := new(RoomMode)
+	*p = x
+	return p
+}
+
+func (x RoomMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoomMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_esteemed_v1_room_proto_enumTypes[1].Descriptor()
+}
+
+func (RoomMode) Type() protoreflect.EnumType {
+	return &file_esteemed_v1_room_proto_enumTypes[1]
+}
+
+func (x RoomMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoomMode.Descriptor instead.
+func (RoomMode) EnumDescriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{1}
+}
+
+// ParticipantRole is what a participant may do in a room
+type ParticipantRole int32
+
+const (
+	ParticipantRole_PARTICIPANT_ROLE_UNSPECIFIED ParticipantRole = 0
+	ParticipantRole_PARTICIPANT_ROLE_OWNER       ParticipantRole = 1 // The host; also manages roles and room settings
+	ParticipantRole_PARTICIPANT_ROLE_FACILITATOR ParticipantRole = 2 // Runs rounds and manages participants
+	ParticipantRole_PARTICIPANT_ROLE_VOTER       ParticipantRole = 3
+	ParticipantRole_PARTICIPANT_ROLE_SPECTATOR   ParticipantRole = 4
+)
+
+// Enum value maps for ParticipantRole.
+var (
+	ParticipantRole_name = map[int32]string{
+		0: "PARTICIPANT_ROLE_UNSPECIFIED",
+		1: "PARTICIPANT_ROLE_OWNER",
+		2: "PARTICIPANT_ROLE_FACILITATOR",
+		3: "PARTICIPANT_ROLE_VOTER",
+		4: "PARTICIPANT_ROLE_SPECTATOR",
+	}
+	ParticipantRole_value = map[string]int32{
+		"PARTICIPANT_ROLE_UNSPECIFIED": 0,
+		"PARTICIPANT_ROLE_OWNER":       1,
+		"PARTICIPANT_ROLE_FACILITATOR": 2,
+		"PARTICIPANT_ROLE_VOTER":       3,
+		"PARTICIPANT_ROLE_SPECTATOR":   4,
+	}
+)
+
+func (x ParticipantRole) Enum() *ParticipantRole {
+	p := new(ParticipantRole)
+	*p = x
+	return p
+}
+
+func (x ParticipantRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ParticipantRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_esteemed_v1_room_proto_enumTypes[2].Descriptor()
+}
+
+func (ParticipantRole) Type() protoreflect.EnumType {
+	return &file_esteemed_v1_room_proto_enumTypes[2]
+}
+
+func (x ParticipantRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ParticipantRole.Descriptor instead.
+func (ParticipantRole) EnumDescriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{2}
+}
+
 // RoomState represents the current phase of estimation
 type RoomState int32
 
@@ -110,11 +216,11 @@ func (x RoomState) String() string {
 }
 
 func (RoomState) Descriptor() protoreflect.EnumDescriptor {
-	return file_esteemed_v1_room_proto_enumTypes[1].Descriptor()
+	return file_esteemed_v1_room_proto_enumTypes[3].Descriptor()
 }
 
 func (RoomState) Type() protoreflect.EnumType {
-	return &file_esteemed_v1_room_proto_enumTypes[1]
+	return &file_esteemed_v1_room_proto_enumTypes[3]
 }
 
 func (x RoomState) Number() protoreflect.EnumNumber {
@@ -123,7 +229,284 @@ func (x RoomState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RoomState.Descriptor instead.
 func (RoomState) EnumDescriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{1}
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{3}
+}
+
+// RoomVisibility decides who can find and join a room
+type RoomVisibility int32
+
+const (
+	RoomVisibility_ROOM_VISIBILITY_UNSPECIFIED RoomVisibility = 0 // Same as public
+	RoomVisibility_ROOM_VISIBILITY_PUBLIC      RoomVisibility = 1 // Listed and joinable by anyone
+	RoomVisibility_ROOM_VISIBILITY_UNLISTED    RoomVisibility = 2 // Joinable by room ID, but not listed or found by name
+	RoomVisibility_ROOM_VISIBILITY_PRIVATE     RoomVisibility = 3 // Not listed; new participants need an invite token
+)
+
+// Enum value maps for RoomVisibility.
+var (
+	RoomVisibility_name = map[int32]string{
+		0: "ROOM_VISIBILITY_UNSPECIFIED",
+		1: "ROOM_VISIBILITY_PUBLIC",
+		2: "ROOM_VISIBILITY_UNLISTED",
+		3: "ROOM_VISIBILITY_PRIVATE",
+	}
+	RoomVisibility_value = map[string]int32{
+		"ROOM_VISIBILITY_UNSPECIFIED": 0,
+		"ROOM_VISIBILITY_PUBLIC":      1,
+		"ROOM_VISIBILITY_UNLISTED":    2,
+		"ROOM_VISIBILITY_PRIVATE":     3,
+	}
+)
+
+func (x RoomVisibility) Enum() *RoomVisibility {
+	p := new(RoomVisibility)
+	*p = x
+	return p
+}
+
+func (x RoomVisibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoomVisibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_esteemed_v1_room_proto_enumTypes[4].Descriptor()
+}
+
+func (RoomVisibility) Type() protoreflect.EnumType {
+	return &file_esteemed_v1_room_proto_enumTypes[4]
+}
+
+func (x RoomVisibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoomVisibility.Descriptor instead.
+func (RoomVisibility) EnumDescriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{4}
+}
+
+// HostSuccession decides who becomes host when the host disconnects
+type HostSuccession int32
+
+const (
+	HostSuccession_HOST_SUCCESSION_UNSPECIFIED      HostSuccession = 0 // Same as earliest joiner
+	HostSuccession_HOST_SUCCESSION_EARLIEST_JOINER  HostSuccession = 1 // Earliest connected joiner takes over
+	HostSuccession_HOST_SUCCESSION_RESTORE_ORIGINAL HostSuccession = 2 // Earliest joiner stands in until the host reconnects within the grace period
+	HostSuccession_HOST_SUCCESSION_MANUAL_CLAIM     HostSuccession = 3 // The room stays host-less until someone calls ClaimHost
+	HostSuccession_HOST_SUCCESSION_DEPUTY           HostSuccession = 4 // The named deputy takes over, else the earliest joiner
+)
+
+// Enum value maps for HostSuccession.
+var (
+	HostSuccession_name = map[int32]string{
+		0: "HOST_SUCCESSION_UNSPECIFIED",
+		1: "HOST_SUCCESSION_EARLIEST_JOINER",
+		2: "HOST_SUCCESSION_RESTORE_ORIGINAL",
+		3: "HOST_SUCCESSION_MANUAL_CLAIM",
+		4: "HOST_SUCCESSION_DEPUTY",
+	}
+	HostSuccession_value = map[string]int32{
+		"HOST_SUCCESSION_UNSPECIFIED":      0,
+		"HOST_SUCCESSION_EARLIEST_JOINER":  1,
+		"HOST_SUCCESSION_RESTORE_ORIGINAL": 2,
+		"HOST_SUCCESSION_MANUAL_CLAIM":     3,
+		"HOST_SUCCESSION_DEPUTY":           4,
+	}
+)
+
+func (x HostSuccession) Enum() *HostSuccession {
+	p := new(HostSuccession)
+	*p = x
+	return p
+}
+
+func (x HostSuccession) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HostSuccession) Descriptor() protoreflect.EnumDescriptor {
+	return file_esteemed_v1_room_proto_enumTypes[5].Descriptor()
+}
+
+func (HostSuccession) Type() protoreflect.EnumType {
+	return &file_esteemed_v1_room_proto_enumTypes[5]
+}
+
+func (x HostSuccession) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HostSuccession.Descriptor instead.
+func (HostSuccession) EnumDescriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{5}
+}
+
+// CloseReason is why a room was closed
+type CloseReason int32
+
+const (
+	CloseReason_CLOSE_REASON_UNSPECIFIED     CloseReason = 0
+	CloseReason_CLOSE_REASON_ALL_LEFT        CloseReason = 1 // The last participant left or was kicked
+	CloseReason_CLOSE_REASON_INACTIVITY      CloseReason = 2 // The room expired after its inactivity timeout
+	CloseReason_CLOSE_REASON_BREAKOUT_CLOSED CloseReason = 3 // The parent's host closed this breakout
+)
+
+// Enum value maps for CloseReason.
+var (
+	CloseReason_name = map[int32]string{
+		0: "CLOSE_REASON_UNSPECIFIED",
+		1: "CLOSE_REASON_ALL_LEFT",
+		2: "CLOSE_REASON_INACTIVITY",
+		3: "CLOSE_REASON_BREAKOUT_CLOSED",
+	}
+	CloseReason_value = map[string]int32{
+		"CLOSE_REASON_UNSPECIFIED":     0,
+		"CLOSE_REASON_ALL_LEFT":        1,
+		"CLOSE_REASON_INACTIVITY":      2,
+		"CLOSE_REASON_BREAKOUT_CLOSED": 3,
+	}
+)
+
+func (x CloseReason) Enum() *CloseReason {
+	p := new(CloseReason)
+	*p = x
+	return p
+}
+
+func (x CloseReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CloseReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_esteemed_v1_room_proto_enumTypes[6].Descriptor()
+}
+
+func (CloseReason) Type() protoreflect.EnumType {
+	return &file_esteemed_v1_room_proto_enumTypes[6]
+}
+
+func (x CloseReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CloseReason.Descriptor instead.
+func (CloseReason) EnumDescriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{6}
+}
+
+// HostChangeReason is why the host changed
+type HostChangeReason int32
+
+const (
+	HostChangeReason_HOST_CHANGE_REASON_UNSPECIFIED HostChangeReason = 0
+	HostChangeReason_HOST_CHANGE_REASON_TRANSFERRED HostChangeReason = 1 // The host handed over
+	HostChangeReason_HOST_CHANGE_REASON_SUCCEEDED   HostChangeReason = 2 // A connected participant took over from a missing host
+	HostChangeReason_HOST_CHANGE_REASON_DEPUTY      HostChangeReason = 3 // The named deputy took over
+	HostChangeReason_HOST_CHANGE_REASON_RESTORED    HostChangeReason = 4 // The original host reconnected and took the room back
+	HostChangeReason_HOST_CHANGE_REASON_VACATED     HostChangeReason = 5 // The host left and the room waits for a claim
+	HostChangeReason_HOST_CHANGE_REASON_CLAIMED     HostChangeReason = 6 // A participant claimed the host-less room
+)
+
+// Enum value maps for HostChangeReason.
+var (
+	HostChangeReason_name = map[int32]string{
+		0: "HOST_CHANGE_REASON_UNSPECIFIED",
+		1: "HOST_CHANGE_REASON_TRANSFERRED",
+		2: "HOST_CHANGE_REASON_SUCCEEDED",
+		3: "HOST_CHANGE_REASON_DEPUTY",
+		4: "HOST_CHANGE_REASON_RESTORED",
+		5: "HOST_CHANGE_REASON_VACATED",
+		6: "HOST_CHANGE_REASON_CLAIMED",
+	}
+	HostChangeReason_value = map[string]int32{
+		"HOST_CHANGE_REASON_UNSPECIFIED": 0,
+		"HOST_CHANGE_REASON_TRANSFERRED": 1,
+		"HOST_CHANGE_REASON_SUCCEEDED":   2,
+		"HOST_CHANGE_REASON_DEPUTY":      3,
+		"HOST_CHANGE_REASON_RESTORED":    4,
+		"HOST_CHANGE_REASON_VACATED":     5,
+		"HOST_CHANGE_REASON_CLAIMED":     6,
+	}
+)
+
+func (x HostChangeReason) Enum() *HostChangeReason {
+	p := new(HostChangeReason)
+	*p = x
+	return p
+}
+
+func (x HostChangeReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HostChangeReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_esteemed_v1_room_proto_enumTypes[7].Descriptor()
+}
+
+func (HostChangeReason) Type() protoreflect.EnumType {
+	return &file_esteemed_v1_room_proto_enumTypes[7]
+}
+
+func (x HostChangeReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HostChangeReason.Descriptor instead.
+func (HostChangeReason) EnumDescriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{7}
+}
+
+// RoomTransition is a round state change the host can undo
+type RoomTransition int32
+
+const (
+	RoomTransition_ROOM_TRANSITION_UNSPECIFIED RoomTransition = 0
+	RoomTransition_ROOM_TRANSITION_START       RoomTransition = 1
+	RoomTransition_ROOM_TRANSITION_REVEAL      RoomTransition = 2
+	RoomTransition_ROOM_TRANSITION_RESET       RoomTransition = 3
+)
+
+// Enum value maps for RoomTransition.
+var (
+	RoomTransition_name = map[int32]string{
+		0: "ROOM_TRANSITION_UNSPECIFIED",
+		1: "ROOM_TRANSITION_START",
+		2: "ROOM_TRANSITION_REVEAL",
+		3: "ROOM_TRANSITION_RESET",
+	}
+	RoomTransition_value = map[string]int32{
+		"ROOM_TRANSITION_UNSPECIFIED": 0,
+		"ROOM_TRANSITION_START":       1,
+		"ROOM_TRANSITION_REVEAL":      2,
+		"ROOM_TRANSITION_RESET":       3,
+	}
+)
+
+func (x RoomTransition) Enum() *RoomTransition {
+	p := new(RoomTransition)
+	*p = x
+	return p
+}
+
+func (x RoomTransition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoomTransition) Descriptor() protoreflect.EnumDescriptor {
+	return file_esteemed_v1_room_proto_enumTypes[8].Descriptor()
+}
+
+func (RoomTransition) Type() protoreflect.EnumType {
+	return &file_esteemed_v1_room_proto_enumTypes[8]
+}
+
+func (x RoomTransition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoomTransition.Descriptor instead.
+func (RoomTransition) EnumDescriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{8}
 }
 
 // Card represents a single card in the deck
@@ -240,22 +623,95 @@ func (x *CardConfig) GetCards() []*Card {
 	return nil
 }
 
+// DotVoteConfig configures a dot-voting room
+type DotVoteConfig struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Options            []string               `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`                                                    // Stories or options to spread dots across
+	DotsPerParticipant int32                  `protobuf:"varint,2,opt,name=dots_per_participant,json=dotsPerParticipant,proto3" json:"dots_per_participant,omitempty"` // Number of dots each participant may place
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DotVoteConfig) Reset() {
+	*x = DotVoteConfig{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DotVoteConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DotVoteConfig) ProtoMessage() {}
+
+func (x *DotVoteConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DotVoteConfig.ProtoReflect.Descriptor instead.
+func (*DotVoteConfig) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DotVoteConfig) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *DotVoteConfig) GetDotsPerParticipant() int32 {
+	if x != nil {
+		return x.DotsPerParticipant
+	}
+	return 0
+}
+
 // Room represents a planning poker room
 type Room struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Participants  []*Participant         `protobuf:"bytes,3,rep,name=participants,proto3" json:"participants,omitempty"`
-	State         RoomState              `protobuf:"varint,4,opt,name=state,proto3,enum=esteemed.v1.RoomState" json:"state,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CardConfig    *CardConfig            `protobuf:"bytes,6,opt,name=card_config,json=cardConfig,proto3" json:"card_config,omitempty"` // Card deck configuration
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Id                       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Participants             []*Participant         `protobuf:"bytes,3,rep,name=participants,proto3" json:"participants,omitempty"`
+	State                    RoomState              `protobuf:"varint,4,opt,name=state,proto3,enum=esteemed.v1.RoomState" json:"state,omitempty"`
+	CreatedAt                int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CardConfig               *CardConfig            `protobuf:"bytes,6,opt,name=card_config,json=cardConfig,proto3" json:"card_config,omitempty"` // Card deck configuration
+	Mode                     RoomMode               `protobuf:"varint,7,opt,name=mode,proto3,enum=esteemed.v1.RoomMode" json:"mode,omitempty"`
+	DotVoteConfig            *DotVoteConfig         `protobuf:"bytes,8,opt,name=dot_vote_config,json=dotVoteConfig,proto3" json:"dot_vote_config,omitempty"`                                    // Only set for dot-voting rooms
+	ParentRoomId             string                 `protobuf:"bytes,9,opt,name=parent_room_id,json=parentRoomId,proto3" json:"parent_room_id,omitempty"`                                       // Set when this room is a breakout
+	BreakoutRoomIds          []string               `protobuf:"bytes,10,rep,name=breakout_room_ids,json=breakoutRoomIds,proto3" json:"breakout_room_ids,omitempty"`                             // Open breakouts of this room
+	Async                    bool                   `protobuf:"varint,11,opt,name=async,proto3" json:"async,omitempty"`                                                                         // Votes stay open until a deadline instead of a live session
+	VotingDeadline           int64                  `protobuf:"varint,12,opt,name=voting_deadline,json=votingDeadline,proto3" json:"voting_deadline,omitempty"`                                 // Auto-reveal time of the current async round (0 if none)
+	Persistent               bool                   `protobuf:"varint,13,opt,name=persistent,proto3" json:"persistent,omitempty"`                                                               // Long-lived team room that is never cleaned up
+	InactivityTimeoutMinutes int32                  `protobuf:"varint,14,opt,name=inactivity_timeout_minutes,json=inactivityTimeoutMinutes,proto3" json:"inactivity_timeout_minutes,omitempty"` // Custom inactivity timeout (0 uses the deployment default)
+	HostSuccession           HostSuccession         `protobuf:"varint,15,opt,name=host_succession,json=hostSuccession,proto3,enum=esteemed.v1.HostSuccession" json:"host_succession,omitempty"` // Who takes over when the host disconnects
+	DeputyId                 string                 `protobuf:"bytes,16,opt,name=deputy_id,json=deputyId,proto3" json:"deputy_id,omitempty"`                                                    // Next host under the deputy policy (empty if none)
+	RotateFacilitator        bool                   `protobuf:"varint,17,opt,name=rotate_facilitator,json=rotateFacilitator,proto3" json:"rotate_facilitator,omitempty"`                        // The facilitator role moves to the next voter for every story
+	KeepDisconnected         bool                   `protobuf:"varint,18,opt,name=keep_disconnected,json=keepDisconnected,proto3" json:"keep_disconnected,omitempty"`                           // Disconnected participants are kept instead of removed after a grace period
+	Visibility               RoomVisibility         `protobuf:"varint,19,opt,name=visibility,proto3,enum=esteemed.v1.RoomVisibility" json:"visibility,omitempty"`
+	HasPasscode              bool                   `protobuf:"varint,20,opt,name=has_passcode,json=hasPasscode,proto3" json:"has_passcode,omitempty"`                        // Newcomers need a passcode to join or watch
+	Locked                   bool                   `protobuf:"varint,21,opt,name=locked,proto3" json:"locked,omitempty"`                                                     // New participants are turned away
+	WaitingRoom              bool                   `protobuf:"varint,22,opt,name=waiting_room,json=waitingRoom,proto3" json:"waiting_room,omitempty"`                        // New participants wait until they are admitted
+	PendingParticipants      []*Participant         `protobuf:"bytes,23,rep,name=pending_participants,json=pendingParticipants,proto3" json:"pending_participants,omitempty"` // Waiting to be admitted, in the order they asked
+	MaxVoters                int32                  `protobuf:"varint,24,opt,name=max_voters,json=maxVoters,proto3" json:"max_voters,omitempty"`                              // Connected voters the room takes (0 for no limit)
+	MaxSpectators            int32                  `protobuf:"varint,25,opt,name=max_spectators,json=maxSpectators,proto3" json:"max_spectators,omitempty"`                  // Connected spectators the room takes (0 for no limit)
+	HasViewToken             bool                   `protobuf:"varint,26,opt,name=has_view_token,json=hasViewToken,proto3" json:"has_view_token,omitempty"`                   // A read-only view token is active
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *Room) Reset() {
 	*x = Room{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[2]
+	mi := &file_esteemed_v1_room_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -267,7 +723,7 @@ func (x *Room) String() string {
 func (*Room) ProtoMessage() {}
 
 func (x *Room) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[2]
+	mi := &file_esteemed_v1_room_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -280,7 +736,7 @@ func (x *Room) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Room.ProtoReflect.Descriptor instead.
 func (*Room) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{2}
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Room) GetId() string {
@@ -325,34 +781,179 @@ func (x *Room) GetCardConfig() *CardConfig {
 	return nil
 }
 
-// Participant in a room
-type Participant struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	IsHost        bool                   `protobuf:"varint,3,opt,name=is_host,json=isHost,proto3" json:"is_host,omitempty"`
-	IsConnected   bool                   `protobuf:"varint,4,opt,name=is_connected,json=isConnected,proto3" json:"is_connected,omitempty"`
-	JoinedAt      int64                  `protobuf:"varint,5,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
-	IsSpectator   bool                   `protobuf:"varint,6,opt,name=is_spectator,json=isSpectator,proto3" json:"is_spectator,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Room) GetMode() RoomMode {
+	if x != nil {
+		return x.Mode
+	}
+	return RoomMode_ROOM_MODE_UNSPECIFIED
 }
 
-func (x *Participant) Reset() {
-	*x = Participant{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Room) GetDotVoteConfig() *DotVoteConfig {
+	if x != nil {
+		return x.DotVoteConfig
+	}
+	return nil
 }
 
-func (x *Participant) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Room) GetParentRoomId() string {
+	if x != nil {
+		return x.ParentRoomId
+	}
+	return ""
 }
 
-func (*Participant) ProtoMessage() {}
-
-func (x *Participant) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[3]
+func (x *Room) GetBreakoutRoomIds() []string {
+	if x != nil {
+		return x.BreakoutRoomIds
+	}
+	return nil
+}
+
+func (x *Room) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *Room) GetVotingDeadline() int64 {
+	if x != nil {
+		return x.VotingDeadline
+	}
+	return 0
+}
+
+func (x *Room) GetPersistent() bool {
+	if x != nil {
+		return x.Persistent
+	}
+	return false
+}
+
+func (x *Room) GetInactivityTimeoutMinutes() int32 {
+	if x != nil {
+		return x.InactivityTimeoutMinutes
+	}
+	return 0
+}
+
+func (x *Room) GetHostSuccession() HostSuccession {
+	if x != nil {
+		return x.HostSuccession
+	}
+	return HostSuccession_HOST_SUCCESSION_UNSPECIFIED
+}
+
+func (x *Room) GetDeputyId() string {
+	if x != nil {
+		return x.DeputyId
+	}
+	return ""
+}
+
+func (x *Room) GetRotateFacilitator() bool {
+	if x != nil {
+		return x.RotateFacilitator
+	}
+	return false
+}
+
+func (x *Room) GetKeepDisconnected() bool {
+	if x != nil {
+		return x.KeepDisconnected
+	}
+	return false
+}
+
+func (x *Room) GetVisibility() RoomVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return RoomVisibility_ROOM_VISIBILITY_UNSPECIFIED
+}
+
+func (x *Room) GetHasPasscode() bool {
+	if x != nil {
+		return x.HasPasscode
+	}
+	return false
+}
+
+func (x *Room) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *Room) GetWaitingRoom() bool {
+	if x != nil {
+		return x.WaitingRoom
+	}
+	return false
+}
+
+func (x *Room) GetPendingParticipants() []*Participant {
+	if x != nil {
+		return x.PendingParticipants
+	}
+	return nil
+}
+
+func (x *Room) GetMaxVoters() int32 {
+	if x != nil {
+		return x.MaxVoters
+	}
+	return 0
+}
+
+func (x *Room) GetMaxSpectators() int32 {
+	if x != nil {
+		return x.MaxSpectators
+	}
+	return 0
+}
+
+func (x *Room) GetHasViewToken() bool {
+	if x != nil {
+		return x.HasViewToken
+	}
+	return false
+}
+
+// Participant in a room
+type Participant struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IsHost         bool                   `protobuf:"varint,3,opt,name=is_host,json=isHost,proto3" json:"is_host,omitempty"`
+	IsConnected    bool                   `protobuf:"varint,4,opt,name=is_connected,json=isConnected,proto3" json:"is_connected,omitempty"`
+	JoinedAt       int64                  `protobuf:"varint,5,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	IsSpectator    bool                   `protobuf:"varint,6,opt,name=is_spectator,json=isSpectator,proto3" json:"is_spectator,omitempty"`
+	BreakoutRoomId string                 `protobuf:"bytes,7,opt,name=breakout_room_id,json=breakoutRoomId,proto3" json:"breakout_room_id,omitempty"` // Breakout the participant was moved into (parent room only)
+	IsPlaceholder  bool                   `protobuf:"varint,8,opt,name=is_placeholder,json=isPlaceholder,proto3" json:"is_placeholder,omitempty"`     // Absent person added by the host for proxy voting
+	Role           ParticipantRole        `protobuf:"varint,9,opt,name=role,proto3,enum=esteemed.v1.ParticipantRole" json:"role,omitempty"`
+	AvatarSeed     string                 `protobuf:"bytes,10,opt,name=avatar_seed,json=avatarSeed,proto3" json:"avatar_seed,omitempty"` // Seed for the generated avatar (empty for the default)
+	Color          string                 `protobuf:"bytes,11,opt,name=color,proto3" json:"color,omitempty"`                             // Hex color like #1a2b3c (empty for the default)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Participant) Reset() {
+	*x = Participant{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Participant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Participant) ProtoMessage() {}
+
+func (x *Participant) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -365,7 +966,7 @@ func (x *Participant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Participant.ProtoReflect.Descriptor instead.
 func (*Participant) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{3}
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Participant) GetId() string {
@@ -410,19 +1011,69 @@ func (x *Participant) GetIsSpectator() bool {
 	return false
 }
 
+func (x *Participant) GetBreakoutRoomId() string {
+	if x != nil {
+		return x.BreakoutRoomId
+	}
+	return ""
+}
+
+func (x *Participant) GetIsPlaceholder() bool {
+	if x != nil {
+		return x.IsPlaceholder
+	}
+	return false
+}
+
+func (x *Participant) GetRole() ParticipantRole {
+	if x != nil {
+		return x.Role
+	}
+	return ParticipantRole_PARTICIPANT_ROLE_UNSPECIFIED
+}
+
+func (x *Participant) GetAvatarSeed() string {
+	if x != nil {
+		return x.AvatarSeed
+	}
+	return ""
+}
+
+func (x *Participant) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
 // CreateRoomRequest creates a new room
 type CreateRoomRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	HostName      string                 `protobuf:"bytes,1,opt,name=host_name,json=hostName,proto3" json:"host_name,omitempty"`             // Name of the person creating the room
-	SessionToken  string                 `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"` // Client-provided session token for identity
-	CardConfig    *CardConfig            `protobuf:"bytes,3,opt,name=card_config,json=cardConfig,proto3" json:"card_config,omitempty"`       // Optional card deck configuration (defaults to Fibonacci)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	HostName                 string                 `protobuf:"bytes,1,opt,name=host_name,json=hostName,proto3" json:"host_name,omitempty"`                                                     // Name of the person creating the room
+	SessionToken             string                 `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`                                         // Client-provided session token for identity
+	CardConfig               *CardConfig            `protobuf:"bytes,3,opt,name=card_config,json=cardConfig,proto3" json:"card_config,omitempty"`                                               // Optional card deck configuration (defaults to Fibonacci)
+	Mode                     RoomMode               `protobuf:"varint,4,opt,name=mode,proto3,enum=esteemed.v1.RoomMode" json:"mode,omitempty"`                                                  // Optional room mode (defaults to estimation)
+	DotVoteConfig            *DotVoteConfig         `protobuf:"bytes,5,opt,name=dot_vote_config,json=dotVoteConfig,proto3" json:"dot_vote_config,omitempty"`                                    // Required when mode is dot voting
+	Async                    bool                   `protobuf:"varint,6,opt,name=async,proto3" json:"async,omitempty"`                                                                          // Keep rounds open for voting until a deadline
+	VotingDeadline           int64                  `protobuf:"varint,7,opt,name=voting_deadline,json=votingDeadline,proto3" json:"voting_deadline,omitempty"`                                  // Optional deadline of the first async round; opens voting right away
+	Persistent               bool                   `protobuf:"varint,8,opt,name=persistent,proto3" json:"persistent,omitempty"`                                                                // Keep the room between sessions; requires a slug
+	Slug                     string                 `protobuf:"bytes,9,opt,name=slug,proto3" json:"slug,omitempty"`                                                                             // Custom room name (e.g. "platform-team"): 3-48 lowercase letters, digits or hyphens
+	InactivityTimeoutMinutes int32                  `protobuf:"varint,10,opt,name=inactivity_timeout_minutes,json=inactivityTimeoutMinutes,proto3" json:"inactivity_timeout_minutes,omitempty"` // Optional custom inactivity timeout (5-1440 minutes)
+	HostSuccession           HostSuccession         `protobuf:"varint,11,opt,name=host_succession,json=hostSuccession,proto3,enum=esteemed.v1.HostSuccession" json:"host_succession,omitempty"` // Optional succession policy (defaults to earliest joiner)
+	RotateFacilitator        bool                   `protobuf:"varint,12,opt,name=rotate_facilitator,json=rotateFacilitator,proto3" json:"rotate_facilitator,omitempty"`                        // Hand the facilitator role to the next voter for every story
+	KeepDisconnected         bool                   `protobuf:"varint,13,opt,name=keep_disconnected,json=keepDisconnected,proto3" json:"keep_disconnected,omitempty"`                           // Keep disconnected participants instead of removing them after a grace period
+	Visibility               RoomVisibility         `protobuf:"varint,14,opt,name=visibility,proto3,enum=esteemed.v1.RoomVisibility" json:"visibility,omitempty"`                               // Optional visibility (defaults to public)
+	Passcode                 string                 `protobuf:"bytes,15,opt,name=passcode,proto3" json:"passcode,omitempty"`                                                                    // Optional passcode newcomers need (4-64 characters)
+	WaitingRoom              bool                   `protobuf:"varint,16,opt,name=waiting_room,json=waitingRoom,proto3" json:"waiting_room,omitempty"`                                          // New participants wait until the host admits them
+	MaxVoters                int32                  `protobuf:"varint,17,opt,name=max_voters,json=maxVoters,proto3" json:"max_voters,omitempty"`                                                // Optional voter limit (capped at, and defaults to, the server's limit)
+	MaxSpectators            int32                  `protobuf:"varint,18,opt,name=max_spectators,json=maxSpectators,proto3" json:"max_spectators,omitempty"`                                    // Optional spectator limit (capped at, and defaults to, the server's limit)
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *CreateRoomRequest) Reset() {
 	*x = CreateRoomRequest{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[4]
+	mi := &file_esteemed_v1_room_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -434,7 +1085,7 @@ func (x *CreateRoomRequest) String() string {
 func (*CreateRoomRequest) ProtoMessage() {}
 
 func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[4]
+	mi := &file_esteemed_v1_room_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -447,7 +1098,7 @@ func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
 func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{4}
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateRoomRequest) GetHostName() string {
@@ -471,6 +1122,111 @@ func (x *CreateRoomRequest) GetCardConfig() *CardConfig {
 	return nil
 }
 
+func (x *CreateRoomRequest) GetMode() RoomMode {
+	if x != nil {
+		return x.Mode
+	}
+	return RoomMode_ROOM_MODE_UNSPECIFIED
+}
+
+func (x *CreateRoomRequest) GetDotVoteConfig() *DotVoteConfig {
+	if x != nil {
+		return x.DotVoteConfig
+	}
+	return nil
+}
+
+func (x *CreateRoomRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *CreateRoomRequest) GetVotingDeadline() int64 {
+	if x != nil {
+		return x.VotingDeadline
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetPersistent() bool {
+	if x != nil {
+		return x.Persistent
+	}
+	return false
+}
+
+func (x *CreateRoomRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetInactivityTimeoutMinutes() int32 {
+	if x != nil {
+		return x.InactivityTimeoutMinutes
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetHostSuccession() HostSuccession {
+	if x != nil {
+		return x.HostSuccession
+	}
+	return HostSuccession_HOST_SUCCESSION_UNSPECIFIED
+}
+
+func (x *CreateRoomRequest) GetRotateFacilitator() bool {
+	if x != nil {
+		return x.RotateFacilitator
+	}
+	return false
+}
+
+func (x *CreateRoomRequest) GetKeepDisconnected() bool {
+	if x != nil {
+		return x.KeepDisconnected
+	}
+	return false
+}
+
+func (x *CreateRoomRequest) GetVisibility() RoomVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return RoomVisibility_ROOM_VISIBILITY_UNSPECIFIED
+}
+
+func (x *CreateRoomRequest) GetPasscode() string {
+	if x != nil {
+		return x.Passcode
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetWaitingRoom() bool {
+	if x != nil {
+		return x.WaitingRoom
+	}
+	return false
+}
+
+func (x *CreateRoomRequest) GetMaxVoters() int32 {
+	if x != nil {
+		return x.MaxVoters
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetMaxSpectators() int32 {
+	if x != nil {
+		return x.MaxSpectators
+	}
+	return 0
+}
+
 type CreateRoomResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
@@ -482,7 +1238,7 @@ type CreateRoomResponse struct {
 
 func (x *CreateRoomResponse) Reset() {
 	*x = CreateRoomResponse{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[5]
+	mi := &file_esteemed_v1_room_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -494,7 +1250,7 @@ func (x *CreateRoomResponse) String() string {
 func (*CreateRoomResponse) ProtoMessage() {}
 
 func (x *CreateRoomResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[5]
+	mi := &file_esteemed_v1_room_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -507,7 +1263,7 @@ func (x *CreateRoomResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRoomResponse.ProtoReflect.Descriptor instead.
 func (*CreateRoomResponse) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{5}
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateRoomResponse) GetRoom() *Room {
@@ -538,13 +1294,15 @@ type JoinRoomRequest struct {
 	ParticipantName string                 `protobuf:"bytes,2,opt,name=participant_name,json=participantName,proto3" json:"participant_name,omitempty"`
 	SessionToken    string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"` // Optional: for reconnection
 	IsSpectator     bool                   `protobuf:"varint,4,opt,name=is_spectator,json=isSpectator,proto3" json:"is_spectator,omitempty"`   // Join as spectator (watch only)
+	InviteToken     string                 `protobuf:"bytes,5,opt,name=invite_token,json=inviteToken,proto3" json:"invite_token,omitempty"`    // Required for new participants of private rooms; the invite decides is_spectator
+	Passcode        string                 `protobuf:"bytes,6,opt,name=passcode,proto3" json:"passcode,omitempty"`                             // Required for new participants if the room has a passcode, unless invited
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *JoinRoomRequest) Reset() {
 	*x = JoinRoomRequest{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[6]
+	mi := &file_esteemed_v1_room_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -556,7 +1314,7 @@ func (x *JoinRoomRequest) String() string {
 func (*JoinRoomRequest) ProtoMessage() {}
 
 func (x *JoinRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[6]
+	mi := &file_esteemed_v1_room_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -569,7 +1327,7 @@ func (x *JoinRoomRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinRoomRequest.ProtoReflect.Descriptor instead.
 func (*JoinRoomRequest) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{6}
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *JoinRoomRequest) GetRoomId() string {
@@ -600,18 +1358,33 @@ func (x *JoinRoomRequest) GetIsSpectator() bool {
 	return false
 }
 
+func (x *JoinRoomRequest) GetInviteToken() string {
+	if x != nil {
+		return x.InviteToken
+	}
+	return ""
+}
+
+func (x *JoinRoomRequest) GetPasscode() string {
+	if x != nil {
+		return x.Passcode
+	}
+	return ""
+}
+
 type JoinRoomResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
 	SessionToken  string                 `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
 	ParticipantId string                 `protobuf:"bytes,3,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Pending       bool                   `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"` // In the waiting room; WatchRoom with the session token for the decision
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *JoinRoomResponse) Reset() {
 	*x = JoinRoomResponse{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[7]
+	mi := &file_esteemed_v1_room_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -623,7 +1396,7 @@ func (x *JoinRoomResponse) String() string {
 func (*JoinRoomResponse) ProtoMessage() {}
 
 func (x *JoinRoomResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[7]
+	mi := &file_esteemed_v1_room_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -636,7 +1409,7 @@ func (x *JoinRoomResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinRoomResponse.ProtoReflect.Descriptor instead.
 func (*JoinRoomResponse) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{7}
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *JoinRoomResponse) GetRoom() *Room {
@@ -660,6 +1433,13 @@ func (x *JoinRoomResponse) GetParticipantId() string {
 	return ""
 }
 
+func (x *JoinRoomResponse) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
 // LeaveRoomRequest removes a participant
 type LeaveRoomRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -672,7 +1452,7 @@ type LeaveRoomRequest struct {
 
 func (x *LeaveRoomRequest) Reset() {
 	*x = LeaveRoomRequest{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[8]
+	mi := &file_esteemed_v1_room_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -684,7 +1464,7 @@ func (x *LeaveRoomRequest) String() string {
 func (*LeaveRoomRequest) ProtoMessage() {}
 
 func (x *LeaveRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[8]
+	mi := &file_esteemed_v1_room_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -697,7 +1477,7 @@ func (x *LeaveRoomRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaveRoomRequest.ProtoReflect.Descriptor instead.
 func (*LeaveRoomRequest) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{8}
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *LeaveRoomRequest) GetRoomId() string {
@@ -729,7 +1509,7 @@ type LeaveRoomResponse struct {
 
 func (x *LeaveRoomResponse) Reset() {
 	*x = LeaveRoomResponse{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[9]
+	mi := &file_esteemed_v1_room_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -741,7 +1521,7 @@ func (x *LeaveRoomResponse) String() string {
 func (*LeaveRoomResponse) ProtoMessage() {}
 
 func (x *LeaveRoomResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[9]
+	mi := &file_esteemed_v1_room_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,7 +1534,7 @@ func (x *LeaveRoomResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaveRoomResponse.ProtoReflect.Descriptor instead.
 func (*LeaveRoomResponse) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{9}
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{10}
 }
 
 // ListRoomsRequest gets all active rooms
@@ -766,7 +1546,7 @@ type ListRoomsRequest struct {
 
 func (x *ListRoomsRequest) Reset() {
 	*x = ListRoomsRequest{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[10]
+	mi := &file_esteemed_v1_room_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -778,7 +1558,7 @@ func (x *ListRoomsRequest) String() string {
 func (*ListRoomsRequest) ProtoMessage() {}
 
 func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[10]
+	mi := &file_esteemed_v1_room_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -791,7 +1571,7 @@ func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRoomsRequest.ProtoReflect.Descriptor instead.
 func (*ListRoomsRequest) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{10}
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{11}
 }
 
 type ListRoomsResponse struct {
@@ -803,7 +1583,7 @@ type ListRoomsResponse struct {
 
 func (x *ListRoomsResponse) Reset() {
 	*x = ListRoomsResponse{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[11]
+	mi := &file_esteemed_v1_room_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -815,7 +1595,7 @@ func (x *ListRoomsResponse) String() string {
 func (*ListRoomsResponse) ProtoMessage() {}
 
 func (x *ListRoomsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[11]
+	mi := &file_esteemed_v1_room_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -828,7 +1608,7 @@ func (x *ListRoomsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRoomsResponse.ProtoReflect.Descriptor instead.
 func (*ListRoomsResponse) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{11}
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListRoomsResponse) GetRooms() []*RoomSummary {
@@ -846,14 +1626,15 @@ type RoomSummary struct {
 	ParticipantCount int32                  `protobuf:"varint,3,opt,name=participant_count,json=participantCount,proto3" json:"participant_count,omitempty"`
 	State            RoomState              `protobuf:"varint,4,opt,name=state,proto3,enum=esteemed.v1.RoomState" json:"state,omitempty"`
 	CreatedAt        int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ExpiresAt        int64                  `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ExpiresAt        int64                  `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // 0 for persistent rooms
+	Persistent       bool                   `protobuf:"varint,7,opt,name=persistent,proto3" json:"persistent,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *RoomSummary) Reset() {
 	*x = RoomSummary{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[12]
+	mi := &file_esteemed_v1_room_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -865,7 +1646,7 @@ func (x *RoomSummary) String() string {
 func (*RoomSummary) ProtoMessage() {}
 
 func (x *RoomSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[12]
+	mi := &file_esteemed_v1_room_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,7 +1659,7 @@ func (x *RoomSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoomSummary.ProtoReflect.Descriptor instead.
 func (*RoomSummary) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{12}
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *RoomSummary) GetId() string {
@@ -923,18 +1704,28 @@ func (x *RoomSummary) GetExpiresAt() int64 {
 	return 0
 }
 
+func (x *RoomSummary) GetPersistent() bool {
+	if x != nil {
+		return x.Persistent
+	}
+	return false
+}
+
 // WatchRoomRequest subscribes to room updates
 type WatchRoomRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	SessionToken  string                 `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	RoomId              string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	SessionToken        string                 `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Passcode            string                 `protobuf:"bytes,3,opt,name=passcode,proto3" json:"passcode,omitempty"`                                                     // Needed by non-participants if the room has a passcode
+	ResumeAfterSequence uint64                 `protobuf:"varint,4,opt,name=resume_after_sequence,json=resumeAfterSequence,proto3" json:"resume_after_sequence,omitempty"` // Last sequence received on an earlier stream; the stream continues after it
+	ResumeEpoch         uint64                 `protobuf:"varint,5,opt,name=resume_epoch,json=resumeEpoch,proto3" json:"resume_epoch,omitempty"`                           // Epoch of that sequence; a stale epoch gets a resync instead
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *WatchRoomRequest) Reset() {
 	*x = WatchRoomRequest{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[13]
+	mi := &file_esteemed_v1_room_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -946,7 +1737,7 @@ func (x *WatchRoomRequest) String() string {
 func (*WatchRoomRequest) ProtoMessage() {}
 
 func (x *WatchRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[13]
+	mi := &file_esteemed_v1_room_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,7 +1750,7 @@ func (x *WatchRoomRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchRoomRequest.ProtoReflect.Descriptor instead.
 func (*WatchRoomRequest) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{13}
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *WatchRoomRequest) GetRoomId() string {
@@ -976,9 +1767,35 @@ func (x *WatchRoomRequest) GetSessionToken() string {
 	return ""
 }
 
-// RoomEvent is sent when room state changes
+func (x *WatchRoomRequest) GetPasscode() string {
+	if x != nil {
+		return x.Passcode
+	}
+	return ""
+}
+
+func (x *WatchRoomRequest) GetResumeAfterSequence() uint64 {
+	if x != nil {
+		return x.ResumeAfterSequence
+	}
+	return 0
+}
+
+func (x *WatchRoomRequest) GetResumeEpoch() uint64 {
+	if x != nil {
+		return x.ResumeEpoch
+	}
+	return 0
+}
+
+// RoomEvent is sent when room state changes. Room and vote events share one
+// per-room sequence, so a room stream skips the numbers of vote events. The
+// sequence starts over in a new epoch whenever the server loses it, such as
+// after a restart.
 type RoomEvent struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sequence uint64                 `protobuf:"varint,27,opt,name=sequence,proto3" json:"sequence,omitempty"` // Position in the room's event sequence (heartbeats repeat the last one)
+	Epoch    uint64                 `protobuf:"varint,28,opt,name=epoch,proto3" json:"epoch,omitempty"`       // Run of the room's event sequence that sequence belongs to
 	// Types that are valid to be assigned to Event:
 	//
 	//	*RoomEvent_ParticipantJoined
@@ -986,6 +1803,27 @@ type RoomEvent struct {
 	//	*RoomEvent_StateChanged
 	//	*RoomEvent_RoomClosed
 	//	*RoomEvent_HostChanged
+	//	*RoomEvent_ParticipantMoved
+	//	*RoomEvent_BreakoutClosed
+	//	*RoomEvent_TransitionUndone
+	//	*RoomEvent_DeadlineChanged
+	//	*RoomEvent_ExpiryWarning
+	//	*RoomEvent_KeptAlive
+	//	*RoomEvent_HostSuccessionChanged
+	//	*RoomEvent_ParticipantUpdated
+	//	*RoomEvent_FacilitatorRotationChanged
+	//	*RoomEvent_Heartbeat
+	//	*RoomEvent_VisibilityChanged
+	//	*RoomEvent_PasscodeChanged
+	//	*RoomEvent_LockChanged
+	//	*RoomEvent_WaitingRoomChanged
+	//	*RoomEvent_JoinRequested
+	//	*RoomEvent_JoinAdmitted
+	//	*RoomEvent_JoinRejected
+	//	*RoomEvent_ParticipantRemoved
+	//	*RoomEvent_ParticipantLimitsChanged
+	//	*RoomEvent_ViewTokenRevoked
+	//	*RoomEvent_Resync
 	Event         isRoomEvent_Event `protobuf_oneof:"event"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -993,7 +1831,7 @@ type RoomEvent struct {
 
 func (x *RoomEvent) Reset() {
 	*x = RoomEvent{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[14]
+	mi := &file_esteemed_v1_room_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1005,7 +1843,7 @@ func (x *RoomEvent) String() string {
 func (*RoomEvent) ProtoMessage() {}
 
 func (x *RoomEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[14]
+	mi := &file_esteemed_v1_room_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1018,7 +1856,21 @@ func (x *RoomEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoomEvent.ProtoReflect.Descriptor instead.
 func (*RoomEvent) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{14}
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RoomEvent) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *RoomEvent) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
 }
 
 func (x *RoomEvent) GetEvent() isRoomEvent_Event {
@@ -1073,62 +1925,4492 @@ func (x *RoomEvent) GetHostChanged() *HostChanged {
 	return nil
 }
 
-type isRoomEvent_Event interface {
-	isRoomEvent_Event()
+func (x *RoomEvent) GetParticipantMoved() *ParticipantMoved {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_ParticipantMoved); ok {
+			return x.ParticipantMoved
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetBreakoutClosed() *BreakoutClosed {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_BreakoutClosed); ok {
+			return x.BreakoutClosed
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetTransitionUndone() *TransitionUndone {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_TransitionUndone); ok {
+			return x.TransitionUndone
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetDeadlineChanged() *DeadlineChanged {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_DeadlineChanged); ok {
+			return x.DeadlineChanged
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetExpiryWarning() *ExpiryWarning {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_ExpiryWarning); ok {
+			return x.ExpiryWarning
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetKeptAlive() *KeptAlive {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_KeptAlive); ok {
+			return x.KeptAlive
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetHostSuccessionChanged() *HostSuccessionChanged {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_HostSuccessionChanged); ok {
+			return x.HostSuccessionChanged
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetParticipantUpdated() *ParticipantUpdated {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_ParticipantUpdated); ok {
+			return x.ParticipantUpdated
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetFacilitatorRotationChanged() *FacilitatorRotationChanged {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_FacilitatorRotationChanged); ok {
+			return x.FacilitatorRotationChanged
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetHeartbeat() *Heartbeat {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_Heartbeat); ok {
+			return x.Heartbeat
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetVisibilityChanged() *VisibilityChanged {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_VisibilityChanged); ok {
+			return x.VisibilityChanged
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetPasscodeChanged() *PasscodeChanged {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_PasscodeChanged); ok {
+			return x.PasscodeChanged
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetLockChanged() *RoomLockChanged {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_LockChanged); ok {
+			return x.LockChanged
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetWaitingRoomChanged() *WaitingRoomChanged {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_WaitingRoomChanged); ok {
+			return x.WaitingRoomChanged
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetJoinRequested() *JoinRequested {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_JoinRequested); ok {
+			return x.JoinRequested
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetJoinAdmitted() *JoinAdmitted {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_JoinAdmitted); ok {
+			return x.JoinAdmitted
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetJoinRejected() *JoinRejected {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_JoinRejected); ok {
+			return x.JoinRejected
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetParticipantRemoved() *ParticipantRemoved {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_ParticipantRemoved); ok {
+			return x.ParticipantRemoved
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetParticipantLimitsChanged() *ParticipantLimitsChanged {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_ParticipantLimitsChanged); ok {
+			return x.ParticipantLimitsChanged
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetViewTokenRevoked() *ViewTokenRevoked {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_ViewTokenRevoked); ok {
+			return x.ViewTokenRevoked
+		}
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetResync() *Resync {
+	if x != nil {
+		if x, ok := x.Event.(*RoomEvent_Resync); ok {
+			return x.Resync
+		}
+	}
+	return nil
+}
+
+type isRoomEvent_Event interface {
+	isRoomEvent_Event()
+}
+
+type RoomEvent_ParticipantJoined struct {
+	ParticipantJoined *ParticipantJoined `protobuf:"bytes,1,opt,name=participant_joined,json=participantJoined,proto3,oneof"`
+}
+
+type RoomEvent_ParticipantLeft struct {
+	ParticipantLeft *ParticipantLeft `protobuf:"bytes,2,opt,name=participant_left,json=participantLeft,proto3,oneof"`
+}
+
+type RoomEvent_StateChanged struct {
+	StateChanged *RoomStateChanged `protobuf:"bytes,3,opt,name=state_changed,json=stateChanged,proto3,oneof"`
+}
+
+type RoomEvent_RoomClosed struct {
+	RoomClosed *RoomClosed `protobuf:"bytes,4,opt,name=room_closed,json=roomClosed,proto3,oneof"`
+}
+
+type RoomEvent_HostChanged struct {
+	HostChanged *HostChanged `protobuf:"bytes,5,opt,name=host_changed,json=hostChanged,proto3,oneof"`
+}
+
+type RoomEvent_ParticipantMoved struct {
+	ParticipantMoved *ParticipantMoved `protobuf:"bytes,6,opt,name=participant_moved,json=participantMoved,proto3,oneof"`
+}
+
+type RoomEvent_BreakoutClosed struct {
+	BreakoutClosed *BreakoutClosed `protobuf:"bytes,7,opt,name=breakout_closed,json=breakoutClosed,proto3,oneof"`
+}
+
+type RoomEvent_TransitionUndone struct {
+	TransitionUndone *TransitionUndone `protobuf:"bytes,8,opt,name=transition_undone,json=transitionUndone,proto3,oneof"`
+}
+
+type RoomEvent_DeadlineChanged struct {
+	DeadlineChanged *DeadlineChanged `protobuf:"bytes,9,opt,name=deadline_changed,json=deadlineChanged,proto3,oneof"`
+}
+
+type RoomEvent_ExpiryWarning struct {
+	ExpiryWarning *ExpiryWarning `protobuf:"bytes,10,opt,name=expiry_warning,json=expiryWarning,proto3,oneof"`
+}
+
+type RoomEvent_KeptAlive struct {
+	KeptAlive *KeptAlive `protobuf:"bytes,11,opt,name=kept_alive,json=keptAlive,proto3,oneof"`
+}
+
+type RoomEvent_HostSuccessionChanged struct {
+	HostSuccessionChanged *HostSuccessionChanged `protobuf:"bytes,12,opt,name=host_succession_changed,json=hostSuccessionChanged,proto3,oneof"`
+}
+
+type RoomEvent_ParticipantUpdated struct {
+	ParticipantUpdated *ParticipantUpdated `protobuf:"bytes,13,opt,name=participant_updated,json=participantUpdated,proto3,oneof"`
+}
+
+type RoomEvent_FacilitatorRotationChanged struct {
+	FacilitatorRotationChanged *FacilitatorRotationChanged `protobuf:"bytes,14,opt,name=facilitator_rotation_changed,json=facilitatorRotationChanged,proto3,oneof"`
+}
+
+type RoomEvent_Heartbeat struct {
+	Heartbeat *Heartbeat `protobuf:"bytes,15,opt,name=heartbeat,proto3,oneof"`
+}
+
+type RoomEvent_VisibilityChanged struct {
+	VisibilityChanged *VisibilityChanged `protobuf:"bytes,16,opt,name=visibility_changed,json=visibilityChanged,proto3,oneof"`
+}
+
+type RoomEvent_PasscodeChanged struct {
+	PasscodeChanged *PasscodeChanged `protobuf:"bytes,17,opt,name=passcode_changed,json=passcodeChanged,proto3,oneof"`
+}
+
+type RoomEvent_LockChanged struct {
+	LockChanged *RoomLockChanged `protobuf:"bytes,18,opt,name=lock_changed,json=lockChanged,proto3,oneof"`
+}
+
+type RoomEvent_WaitingRoomChanged struct {
+	WaitingRoomChanged *WaitingRoomChanged `protobuf:"bytes,19,opt,name=waiting_room_changed,json=waitingRoomChanged,proto3,oneof"`
+}
+
+type RoomEvent_JoinRequested struct {
+	JoinRequested *JoinRequested `protobuf:"bytes,20,opt,name=join_requested,json=joinRequested,proto3,oneof"`
+}
+
+type RoomEvent_JoinAdmitted struct {
+	JoinAdmitted *JoinAdmitted `protobuf:"bytes,21,opt,name=join_admitted,json=joinAdmitted,proto3,oneof"`
+}
+
+type RoomEvent_JoinRejected struct {
+	JoinRejected *JoinRejected `protobuf:"bytes,22,opt,name=join_rejected,json=joinRejected,proto3,oneof"`
+}
+
+type RoomEvent_ParticipantRemoved struct {
+	ParticipantRemoved *ParticipantRemoved `protobuf:"bytes,23,opt,name=participant_removed,json=participantRemoved,proto3,oneof"`
+}
+
+type RoomEvent_ParticipantLimitsChanged struct {
+	ParticipantLimitsChanged *ParticipantLimitsChanged `protobuf:"bytes,24,opt,name=participant_limits_changed,json=participantLimitsChanged,proto3,oneof"`
+}
+
+type RoomEvent_ViewTokenRevoked struct {
+	ViewTokenRevoked *ViewTokenRevoked `protobuf:"bytes,25,opt,name=view_token_revoked,json=viewTokenRevoked,proto3,oneof"`
+}
+
+type RoomEvent_Resync struct {
+	Resync *Resync `protobuf:"bytes,26,opt,name=resync,proto3,oneof"`
+}
+
+func (*RoomEvent_ParticipantJoined) isRoomEvent_Event() {}
+
+func (*RoomEvent_ParticipantLeft) isRoomEvent_Event() {}
+
+func (*RoomEvent_StateChanged) isRoomEvent_Event() {}
+
+func (*RoomEvent_RoomClosed) isRoomEvent_Event() {}
+
+func (*RoomEvent_HostChanged) isRoomEvent_Event() {}
+
+func (*RoomEvent_ParticipantMoved) isRoomEvent_Event() {}
+
+func (*RoomEvent_BreakoutClosed) isRoomEvent_Event() {}
+
+func (*RoomEvent_TransitionUndone) isRoomEvent_Event() {}
+
+func (*RoomEvent_DeadlineChanged) isRoomEvent_Event() {}
+
+func (*RoomEvent_ExpiryWarning) isRoomEvent_Event() {}
+
+func (*RoomEvent_KeptAlive) isRoomEvent_Event() {}
+
+func (*RoomEvent_HostSuccessionChanged) isRoomEvent_Event() {}
+
+func (*RoomEvent_ParticipantUpdated) isRoomEvent_Event() {}
+
+func (*RoomEvent_FacilitatorRotationChanged) isRoomEvent_Event() {}
+
+func (*RoomEvent_Heartbeat) isRoomEvent_Event() {}
+
+func (*RoomEvent_VisibilityChanged) isRoomEvent_Event() {}
+
+func (*RoomEvent_PasscodeChanged) isRoomEvent_Event() {}
+
+func (*RoomEvent_LockChanged) isRoomEvent_Event() {}
+
+func (*RoomEvent_WaitingRoomChanged) isRoomEvent_Event() {}
+
+func (*RoomEvent_JoinRequested) isRoomEvent_Event() {}
+
+func (*RoomEvent_JoinAdmitted) isRoomEvent_Event() {}
+
+func (*RoomEvent_JoinRejected) isRoomEvent_Event() {}
+
+func (*RoomEvent_ParticipantRemoved) isRoomEvent_Event() {}
+
+func (*RoomEvent_ParticipantLimitsChanged) isRoomEvent_Event() {}
+
+func (*RoomEvent_ViewTokenRevoked) isRoomEvent_Event() {}
+
+func (*RoomEvent_Resync) isRoomEvent_Event() {}
+
+// Resync is sent to a resuming stream when the events it missed are no longer
+// available; the snapshot replaces the client's state as of the event's sequence
+type Resync struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      *RoomSnapshot          `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Resync) Reset() {
+	*x = Resync{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Resync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resync) ProtoMessage() {}
+
+func (x *Resync) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resync.ProtoReflect.Descriptor instead.
+func (*Resync) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Resync) GetSnapshot() *RoomSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+// Heartbeat is sent periodically on an otherwise idle stream so proxies keep it
+// open; a participant whose stream stops receiving them is disconnected
+type Heartbeat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SentAt        int64                  `protobuf:"varint,1,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Heartbeat) Reset() {
+	*x = Heartbeat{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Heartbeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Heartbeat) ProtoMessage() {}
+
+func (x *Heartbeat) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Heartbeat.ProtoReflect.Descriptor instead.
+func (*Heartbeat) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Heartbeat) GetSentAt() int64 {
+	if x != nil {
+		return x.SentAt
+	}
+	return 0
+}
+
+type ParticipantJoined struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Participant   *Participant           `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParticipantJoined) Reset() {
+	*x = ParticipantJoined{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParticipantJoined) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantJoined) ProtoMessage() {}
+
+func (x *ParticipantJoined) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantJoined.ProtoReflect.Descriptor instead.
+func (*ParticipantJoined) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ParticipantJoined) GetParticipant() *Participant {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+type ParticipantLeft struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantId string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParticipantLeft) Reset() {
+	*x = ParticipantLeft{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParticipantLeft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantLeft) ProtoMessage() {}
+
+func (x *ParticipantLeft) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantLeft.ProtoReflect.Descriptor instead.
+func (*ParticipantLeft) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ParticipantLeft) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+type RoomStateChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewState      RoomState              `protobuf:"varint,1,opt,name=new_state,json=newState,proto3,enum=esteemed.v1.RoomState" json:"new_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomStateChanged) Reset() {
+	*x = RoomStateChanged{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomStateChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomStateChanged) ProtoMessage() {}
+
+func (x *RoomStateChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomStateChanged.ProtoReflect.Descriptor instead.
+func (*RoomStateChanged) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RoomStateChanged) GetNewState() RoomState {
+	if x != nil {
+		return x.NewState
+	}
+	return RoomState_ROOM_STATE_UNSPECIFIED
+}
+
+type RoomClosed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"` // Human-readable reason
+	CloseReason   CloseReason            `protobuf:"varint,2,opt,name=close_reason,json=closeReason,proto3,enum=esteemed.v1.CloseReason" json:"close_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomClosed) Reset() {
+	*x = RoomClosed{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomClosed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomClosed) ProtoMessage() {}
+
+func (x *RoomClosed) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomClosed.ProtoReflect.Descriptor instead.
+func (*RoomClosed) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RoomClosed) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RoomClosed) GetCloseReason() CloseReason {
+	if x != nil {
+		return x.CloseReason
+	}
+	return CloseReason_CLOSE_REASON_UNSPECIFIED
+}
+
+type HostChanged struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NewHostId      string                 `protobuf:"bytes,1,opt,name=new_host_id,json=newHostId,proto3" json:"new_host_id,omitempty"` // Empty when the room is now host-less
+	PreviousHostId string                 `protobuf:"bytes,2,opt,name=previous_host_id,json=previousHostId,proto3" json:"previous_host_id,omitempty"`
+	Reason         HostChangeReason       `protobuf:"varint,3,opt,name=reason,proto3,enum=esteemed.v1.HostChangeReason" json:"reason,omitempty"`
+	ReturnDeadline int64                  `protobuf:"varint,4,opt,name=return_deadline,json=returnDeadline,proto3" json:"return_deadline,omitempty"` // Until when the previous host takes the room back on reconnect (0 if not)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *HostChanged) Reset() {
+	*x = HostChanged{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostChanged) ProtoMessage() {}
+
+func (x *HostChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostChanged.ProtoReflect.Descriptor instead.
+func (*HostChanged) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *HostChanged) GetNewHostId() string {
+	if x != nil {
+		return x.NewHostId
+	}
+	return ""
+}
+
+func (x *HostChanged) GetPreviousHostId() string {
+	if x != nil {
+		return x.PreviousHostId
+	}
+	return ""
+}
+
+func (x *HostChanged) GetReason() HostChangeReason {
+	if x != nil {
+		return x.Reason
+	}
+	return HostChangeReason_HOST_CHANGE_REASON_UNSPECIFIED
+}
+
+func (x *HostChanged) GetReturnDeadline() int64 {
+	if x != nil {
+		return x.ReturnDeadline
+	}
+	return 0
+}
+
+// ParticipantUpdated is sent when a participant's role or details change
+type ParticipantUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Participant   *Participant           `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParticipantUpdated) Reset() {
+	*x = ParticipantUpdated{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParticipantUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantUpdated) ProtoMessage() {}
+
+func (x *ParticipantUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantUpdated.ProtoReflect.Descriptor instead.
+func (*ParticipantUpdated) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ParticipantUpdated) GetParticipant() *Participant {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+// FacilitatorRotationChanged is sent when the owner turns facilitator rotation on or off
+type FacilitatorRotationChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacilitatorRotationChanged) Reset() {
+	*x = FacilitatorRotationChanged{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacilitatorRotationChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacilitatorRotationChanged) ProtoMessage() {}
+
+func (x *FacilitatorRotationChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacilitatorRotationChanged.ProtoReflect.Descriptor instead.
+func (*FacilitatorRotationChanged) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FacilitatorRotationChanged) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// VisibilityChanged is sent when the owner changes who can find and join the room
+type VisibilityChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Visibility    RoomVisibility         `protobuf:"varint,1,opt,name=visibility,proto3,enum=esteemed.v1.RoomVisibility" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VisibilityChanged) Reset() {
+	*x = VisibilityChanged{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VisibilityChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisibilityChanged) ProtoMessage() {}
+
+func (x *VisibilityChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisibilityChanged.ProtoReflect.Descriptor instead.
+func (*VisibilityChanged) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *VisibilityChanged) GetVisibility() RoomVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return RoomVisibility_ROOM_VISIBILITY_UNSPECIFIED
+}
+
+// PasscodeChanged is sent when the owner sets or removes the passcode
+type PasscodeChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HasPasscode   bool                   `protobuf:"varint,1,opt,name=has_passcode,json=hasPasscode,proto3" json:"has_passcode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasscodeChanged) Reset() {
+	*x = PasscodeChanged{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasscodeChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasscodeChanged) ProtoMessage() {}
+
+func (x *PasscodeChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasscodeChanged.ProtoReflect.Descriptor instead.
+func (*PasscodeChanged) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PasscodeChanged) GetHasPasscode() bool {
+	if x != nil {
+		return x.HasPasscode
+	}
+	return false
+}
+
+// ParticipantRemoved is sent when the host or a facilitator kicks a participant.
+// The removed participant's own stream ends after it.
+type ParticipantRemoved struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantId string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Banned        bool                   `protobuf:"varint,2,opt,name=banned,proto3" json:"banned,omitempty"` // They cannot rejoin until the ban is lifted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParticipantRemoved) Reset() {
+	*x = ParticipantRemoved{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParticipantRemoved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantRemoved) ProtoMessage() {}
+
+func (x *ParticipantRemoved) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantRemoved.ProtoReflect.Descriptor instead.
+func (*ParticipantRemoved) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ParticipantRemoved) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *ParticipantRemoved) GetBanned() bool {
+	if x != nil {
+		return x.Banned
+	}
+	return false
+}
+
+// ParticipantLimitsChanged is sent when the owner changes the room's participant limits
+type ParticipantLimitsChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxVoters     int32                  `protobuf:"varint,1,opt,name=max_voters,json=maxVoters,proto3" json:"max_voters,omitempty"`
+	MaxSpectators int32                  `protobuf:"varint,2,opt,name=max_spectators,json=maxSpectators,proto3" json:"max_spectators,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParticipantLimitsChanged) Reset() {
+	*x = ParticipantLimitsChanged{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParticipantLimitsChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantLimitsChanged) ProtoMessage() {}
+
+func (x *ParticipantLimitsChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantLimitsChanged.ProtoReflect.Descriptor instead.
+func (*ParticipantLimitsChanged) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ParticipantLimitsChanged) GetMaxVoters() int32 {
+	if x != nil {
+		return x.MaxVoters
+	}
+	return 0
+}
+
+func (x *ParticipantLimitsChanged) GetMaxSpectators() int32 {
+	if x != nil {
+		return x.MaxSpectators
+	}
+	return 0
+}
+
+// ViewTokenRevoked is sent when the view token is revoked or replaced. Streams
+// opened with it end after it.
+type ViewTokenRevoked struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ViewTokenRevoked) Reset() {
+	*x = ViewTokenRevoked{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ViewTokenRevoked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViewTokenRevoked) ProtoMessage() {}
+
+func (x *ViewTokenRevoked) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViewTokenRevoked.ProtoReflect.Descriptor instead.
+func (*ViewTokenRevoked) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{29}
+}
+
+// RoomLockChanged is sent when the room is locked or unlocked
+type RoomLockChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locked        bool                   `protobuf:"varint,1,opt,name=locked,proto3" json:"locked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomLockChanged) Reset() {
+	*x = RoomLockChanged{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomLockChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomLockChanged) ProtoMessage() {}
+
+func (x *RoomLockChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomLockChanged.ProtoReflect.Descriptor instead.
+func (*RoomLockChanged) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RoomLockChanged) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+// WaitingRoomChanged is sent when the owner turns the waiting room on or off
+type WaitingRoomChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitingRoomChanged) Reset() {
+	*x = WaitingRoomChanged{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitingRoomChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitingRoomChanged) ProtoMessage() {}
+
+func (x *WaitingRoomChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitingRoomChanged.ProtoReflect.Descriptor instead.
+func (*WaitingRoomChanged) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *WaitingRoomChanged) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// JoinRequested is sent when a new participant enters the waiting room
+type JoinRequested struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Participant   *Participant           `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRequested) Reset() {
+	*x = JoinRequested{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRequested) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRequested) ProtoMessage() {}
+
+func (x *JoinRequested) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRequested.ProtoReflect.Descriptor instead.
+func (*JoinRequested) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *JoinRequested) GetParticipant() *Participant {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+// JoinAdmitted is sent to the room and the waiting participant when they are let in
+type JoinAdmitted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Participant   *Participant           `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinAdmitted) Reset() {
+	*x = JoinAdmitted{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinAdmitted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinAdmitted) ProtoMessage() {}
+
+func (x *JoinAdmitted) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinAdmitted.ProtoReflect.Descriptor instead.
+func (*JoinAdmitted) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *JoinAdmitted) GetParticipant() *Participant {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+// JoinRejected is sent to the room and the waiting participant when they are turned away
+type JoinRejected struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantId string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRejected) Reset() {
+	*x = JoinRejected{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRejected) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRejected) ProtoMessage() {}
+
+func (x *JoinRejected) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRejected.ProtoReflect.Descriptor instead.
+func (*JoinRejected) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *JoinRejected) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+// HostSuccessionChanged is sent when the host changes the succession policy
+type HostSuccessionChanged struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	HostSuccession HostSuccession         `protobuf:"varint,1,opt,name=host_succession,json=hostSuccession,proto3,enum=esteemed.v1.HostSuccession" json:"host_succession,omitempty"`
+	DeputyId       string                 `protobuf:"bytes,2,opt,name=deputy_id,json=deputyId,proto3" json:"deputy_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *HostSuccessionChanged) Reset() {
+	*x = HostSuccessionChanged{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostSuccessionChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostSuccessionChanged) ProtoMessage() {}
+
+func (x *HostSuccessionChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostSuccessionChanged.ProtoReflect.Descriptor instead.
+func (*HostSuccessionChanged) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *HostSuccessionChanged) GetHostSuccession() HostSuccession {
+	if x != nil {
+		return x.HostSuccession
+	}
+	return HostSuccession_HOST_SUCCESSION_UNSPECIFIED
+}
+
+func (x *HostSuccessionChanged) GetDeputyId() string {
+	if x != nil {
+		return x.DeputyId
+	}
+	return ""
+}
+
+// ParticipantMoved tells the participant's client to navigate to another room
+type ParticipantMoved struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantId string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"` // Room the participant was moved to
+	RoomName      string                 `protobuf:"bytes,3,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParticipantMoved) Reset() {
+	*x = ParticipantMoved{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParticipantMoved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantMoved) ProtoMessage() {}
+
+func (x *ParticipantMoved) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantMoved.ProtoReflect.Descriptor instead.
+func (*ParticipantMoved) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ParticipantMoved) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *ParticipantMoved) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ParticipantMoved) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+// BreakoutClosed is sent to the parent when a breakout's results were merged back
+type BreakoutClosed struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BreakoutRoomId string                 `protobuf:"bytes,1,opt,name=breakout_room_id,json=breakoutRoomId,proto3" json:"breakout_room_id,omitempty"`
+	RoundsMerged   int32                  `protobuf:"varint,2,opt,name=rounds_merged,json=roundsMerged,proto3" json:"rounds_merged,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BreakoutClosed) Reset() {
+	*x = BreakoutClosed{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BreakoutClosed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreakoutClosed) ProtoMessage() {}
+
+func (x *BreakoutClosed) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreakoutClosed.ProtoReflect.Descriptor instead.
+func (*BreakoutClosed) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BreakoutClosed) GetBreakoutRoomId() string {
+	if x != nil {
+		return x.BreakoutRoomId
+	}
+	return ""
+}
+
+func (x *BreakoutClosed) GetRoundsMerged() int32 {
+	if x != nil {
+		return x.RoundsMerged
+	}
+	return 0
+}
+
+// TransitionUndone is sent when the host undid a transition; clients should resync their votes
+type TransitionUndone struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transition    RoomTransition         `protobuf:"varint,1,opt,name=transition,proto3,enum=esteemed.v1.RoomTransition" json:"transition,omitempty"`
+	NewState      RoomState              `protobuf:"varint,2,opt,name=new_state,json=newState,proto3,enum=esteemed.v1.RoomState" json:"new_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitionUndone) Reset() {
+	*x = TransitionUndone{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionUndone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionUndone) ProtoMessage() {}
+
+func (x *TransitionUndone) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionUndone.ProtoReflect.Descriptor instead.
+func (*TransitionUndone) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TransitionUndone) GetTransition() RoomTransition {
+	if x != nil {
+		return x.Transition
+	}
+	return RoomTransition_ROOM_TRANSITION_UNSPECIFIED
+}
+
+func (x *TransitionUndone) GetNewState() RoomState {
+	if x != nil {
+		return x.NewState
+	}
+	return RoomState_ROOM_STATE_UNSPECIFIED
+}
+
+// DeadlineChanged is sent when the host sets the voting deadline of an async round
+type DeadlineChanged struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	VotingDeadline int64                  `protobuf:"varint,1,opt,name=voting_deadline,json=votingDeadline,proto3" json:"voting_deadline,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeadlineChanged) Reset() {
+	*x = DeadlineChanged{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadlineChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadlineChanged) ProtoMessage() {}
+
+func (x *DeadlineChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadlineChanged.ProtoReflect.Descriptor instead.
+func (*DeadlineChanged) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeadlineChanged) GetVotingDeadline() int64 {
+	if x != nil {
+		return x.VotingDeadline
+	}
+	return 0
+}
+
+// ExpiryWarning is sent shortly before an inactive room is closed
+type ExpiryWarning struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpiresAt     int64                  `protobuf:"varint,1,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpiryWarning) Reset() {
+	*x = ExpiryWarning{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpiryWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpiryWarning) ProtoMessage() {}
+
+func (x *ExpiryWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpiryWarning.ProtoReflect.Descriptor instead.
+func (*ExpiryWarning) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ExpiryWarning) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// KeptAlive is sent when a participant extended the room
+type KeptAlive struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantId string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeptAlive) Reset() {
+	*x = KeptAlive{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeptAlive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeptAlive) ProtoMessage() {}
+
+func (x *KeptAlive) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeptAlive.ProtoReflect.Descriptor instead.
+func (*KeptAlive) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *KeptAlive) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *KeptAlive) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// WatchRoomStateRequest opens the combined room stream
+type WatchRoomStateRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	RoomId              string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	SessionToken        string                 `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`                         // A participant's session token or the room's view token
+	Passcode            string                 `protobuf:"bytes,3,opt,name=passcode,proto3" json:"passcode,omitempty"`                                                     // Needed by anyone else if the room has a passcode
+	ResumeAfterSequence uint64                 `protobuf:"varint,4,opt,name=resume_after_sequence,json=resumeAfterSequence,proto3" json:"resume_after_sequence,omitempty"` // Last sequence received on an earlier stream; the stream continues after it
+	ResumeEpoch         uint64                 `protobuf:"varint,5,opt,name=resume_epoch,json=resumeEpoch,proto3" json:"resume_epoch,omitempty"`                           // Epoch of that sequence; a stale epoch gets a resync instead
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *WatchRoomStateRequest) Reset() {
+	*x = WatchRoomStateRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchRoomStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRoomStateRequest) ProtoMessage() {}
+
+func (x *WatchRoomStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRoomStateRequest.ProtoReflect.Descriptor instead.
+func (*WatchRoomStateRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *WatchRoomStateRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *WatchRoomStateRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *WatchRoomStateRequest) GetPasscode() string {
+	if x != nil {
+		return x.Passcode
+	}
+	return ""
+}
+
+func (x *WatchRoomStateRequest) GetResumeAfterSequence() uint64 {
+	if x != nil {
+		return x.ResumeAfterSequence
+	}
+	return 0
+}
+
+func (x *WatchRoomStateRequest) GetResumeEpoch() uint64 {
+	if x != nil {
+		return x.ResumeEpoch
+	}
+	return 0
+}
+
+// RoomStateEvent is one message of the combined room stream. The first carries
+// a snapshot as of its sequence, unless the stream resumes; each later room or
+// vote event carries the next sequence number. Events may repeat changes the
+// snapshot already shows. Heartbeats repeat the last sequence sent. When missed
+// events can no longer be replayed, a snapshot marked resync replaces the
+// client's state.
+type RoomStateEvent struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sequence uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Epoch    uint64                 `protobuf:"varint,6,opt,name=epoch,proto3" json:"epoch,omitempty"` // Run of the room's event sequence that sequence belongs to
+	// Types that are valid to be assigned to Event:
+	//
+	//	*RoomStateEvent_Snapshot
+	//	*RoomStateEvent_RoomEvent
+	//	*RoomStateEvent_VoteEvent
+	Event         isRoomStateEvent_Event `protobuf_oneof:"event"`
+	Resync        bool                   `protobuf:"varint,5,opt,name=resync,proto3" json:"resync,omitempty"` // The snapshot was sent because events were missed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomStateEvent) Reset() {
+	*x = RoomStateEvent{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomStateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomStateEvent) ProtoMessage() {}
+
+func (x *RoomStateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomStateEvent.ProtoReflect.Descriptor instead.
+func (*RoomStateEvent) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RoomStateEvent) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *RoomStateEvent) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *RoomStateEvent) GetEvent() isRoomStateEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *RoomStateEvent) GetSnapshot() *RoomSnapshot {
+	if x != nil {
+		if x, ok := x.Event.(*RoomStateEvent_Snapshot); ok {
+			return x.Snapshot
+		}
+	}
+	return nil
+}
+
+func (x *RoomStateEvent) GetRoomEvent() *RoomEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomStateEvent_RoomEvent); ok {
+			return x.RoomEvent
+		}
+	}
+	return nil
+}
+
+func (x *RoomStateEvent) GetVoteEvent() *VoteEvent {
+	if x != nil {
+		if x, ok := x.Event.(*RoomStateEvent_VoteEvent); ok {
+			return x.VoteEvent
+		}
+	}
+	return nil
+}
+
+func (x *RoomStateEvent) GetResync() bool {
+	if x != nil {
+		return x.Resync
+	}
+	return false
+}
+
+type isRoomStateEvent_Event interface {
+	isRoomStateEvent_Event()
+}
+
+type RoomStateEvent_Snapshot struct {
+	Snapshot *RoomSnapshot `protobuf:"bytes,2,opt,name=snapshot,proto3,oneof"`
+}
+
+type RoomStateEvent_RoomEvent struct {
+	RoomEvent *RoomEvent `protobuf:"bytes,3,opt,name=room_event,json=roomEvent,proto3,oneof"`
+}
+
+type RoomStateEvent_VoteEvent struct {
+	VoteEvent *VoteEvent `protobuf:"bytes,4,opt,name=vote_event,json=voteEvent,proto3,oneof"`
+}
+
+func (*RoomStateEvent_Snapshot) isRoomStateEvent_Event() {}
+
+func (*RoomStateEvent_RoomEvent) isRoomStateEvent_Event() {}
+
+func (*RoomStateEvent_VoteEvent) isRoomStateEvent_Event() {}
+
+// RoomSnapshot is the room's state as the caller may see it
+type RoomSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`                                        // Pending participants are only listed for the host and facilitators
+	VoteStatus    []*Vote                `protobuf:"bytes,2,rep,name=vote_status,json=voteStatus,proto3" json:"vote_status,omitempty"`          // Who has voted this round; values stay hidden except the caller's own
+	Summary       *VoteSummary           `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`                                  // Set once the votes are revealed
+	ParticipantId string                 `protobuf:"bytes,4,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // The caller's participant ID (empty for view tokens and outsiders)
+	IsViewer      bool                   `protobuf:"varint,5,opt,name=is_viewer,json=isViewer,proto3" json:"is_viewer,omitempty"`               // The caller used the room's view token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomSnapshot) Reset() {
+	*x = RoomSnapshot{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomSnapshot) ProtoMessage() {}
+
+func (x *RoomSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomSnapshot.ProtoReflect.Descriptor instead.
+func (*RoomSnapshot) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RoomSnapshot) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *RoomSnapshot) GetVoteStatus() []*Vote {
+	if x != nil {
+		return x.VoteStatus
+	}
+	return nil
+}
+
+func (x *RoomSnapshot) GetSummary() *VoteSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *RoomSnapshot) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *RoomSnapshot) GetIsViewer() bool {
+	if x != nil {
+		return x.IsViewer
+	}
+	return false
+}
+
+// GetRoomRequest fetches a room snapshot
+type GetRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	SessionToken  string                 `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"` // A participant's session token or the room's view token
+	Passcode      string                 `protobuf:"bytes,3,opt,name=passcode,proto3" json:"passcode,omitempty"`                             // Needed by anyone else if the room has a passcode
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomRequest) Reset() {
+	*x = GetRoomRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomRequest) ProtoMessage() {}
+
+func (x *GetRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetRoomRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *GetRoomRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *GetRoomRequest) GetPasscode() string {
+	if x != nil {
+		return x.Passcode
+	}
+	return ""
+}
+
+type GetRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`                                        // Pending participants are only listed for the host and facilitators
+	VoteStatus    []*Vote                `protobuf:"bytes,2,rep,name=vote_status,json=voteStatus,proto3" json:"vote_status,omitempty"`          // Who has voted this round; values stay hidden except the caller's own
+	Summary       *VoteSummary           `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`                                  // Set once the votes are revealed
+	ParticipantId string                 `protobuf:"bytes,4,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // The caller's participant ID (empty for view tokens and outsiders)
+	IsViewer      bool                   `protobuf:"varint,5,opt,name=is_viewer,json=isViewer,proto3" json:"is_viewer,omitempty"`               // The caller used the room's view token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomResponse) Reset() {
+	*x = GetRoomResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomResponse) ProtoMessage() {}
+
+func (x *GetRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomResponse.ProtoReflect.Descriptor instead.
+func (*GetRoomResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetRoomResponse) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *GetRoomResponse) GetVoteStatus() []*Vote {
+	if x != nil {
+		return x.VoteStatus
+	}
+	return nil
+}
+
+func (x *GetRoomResponse) GetSummary() *VoteSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *GetRoomResponse) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *GetRoomResponse) GetIsViewer() bool {
+	if x != nil {
+		return x.IsViewer
+	}
+	return false
+}
+
+// CreateViewTokenRequest issues a read-only view token
+type CreateViewTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be the host or a facilitator
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateViewTokenRequest) Reset() {
+	*x = CreateViewTokenRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateViewTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateViewTokenRequest) ProtoMessage() {}
+
+func (x *CreateViewTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateViewTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateViewTokenRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateViewTokenRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *CreateViewTokenRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *CreateViewTokenRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type CreateViewTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ViewToken     string                 `protobuf:"bytes,1,opt,name=view_token,json=viewToken,proto3" json:"view_token,omitempty"` // Pass as session_token to WatchRoom, WatchVotes and GetRoom
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateViewTokenResponse) Reset() {
+	*x = CreateViewTokenResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateViewTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateViewTokenResponse) ProtoMessage() {}
+
+func (x *CreateViewTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateViewTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateViewTokenResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateViewTokenResponse) GetViewToken() string {
+	if x != nil {
+		return x.ViewToken
+	}
+	return ""
+}
+
+// RevokeViewTokenRequest stops the view token from working
+type RevokeViewTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be the host or a facilitator
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeViewTokenRequest) Reset() {
+	*x = RevokeViewTokenRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeViewTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeViewTokenRequest) ProtoMessage() {}
+
+func (x *RevokeViewTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeViewTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeViewTokenRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RevokeViewTokenRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RevokeViewTokenRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *RevokeViewTokenRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type RevokeViewTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeViewTokenResponse) Reset() {
+	*x = RevokeViewTokenResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeViewTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeViewTokenResponse) ProtoMessage() {}
+
+func (x *RevokeViewTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeViewTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeViewTokenResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{50}
+}
+
+// KickParticipantRequest removes a participant from the room
+type KickParticipantRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	RoomId              string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId       string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // ID of the participant making the request (must be host)
+	SessionToken        string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	TargetParticipantId string                 `protobuf:"bytes,4,opt,name=target_participant_id,json=targetParticipantId,proto3" json:"target_participant_id,omitempty"` // ID of the participant to kick
+	Ban                 bool                   `protobuf:"varint,5,opt,name=ban,proto3" json:"ban,omitempty"`                                                             // Keep their session token out for the room's lifetime
+	BanName             bool                   `protobuf:"varint,6,opt,name=ban_name,json=banName,proto3" json:"ban_name,omitempty"`                                      // Also keep their name out (implies ban)
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *KickParticipantRequest) Reset() {
+	*x = KickParticipantRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickParticipantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickParticipantRequest) ProtoMessage() {}
+
+func (x *KickParticipantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickParticipantRequest.ProtoReflect.Descriptor instead.
+func (*KickParticipantRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *KickParticipantRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *KickParticipantRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *KickParticipantRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *KickParticipantRequest) GetTargetParticipantId() string {
+	if x != nil {
+		return x.TargetParticipantId
+	}
+	return ""
+}
+
+func (x *KickParticipantRequest) GetBan() bool {
+	if x != nil {
+		return x.Ban
+	}
+	return false
+}
+
+func (x *KickParticipantRequest) GetBanName() bool {
+	if x != nil {
+		return x.BanName
+	}
+	return false
+}
+
+type KickParticipantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickParticipantResponse) Reset() {
+	*x = KickParticipantResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickParticipantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickParticipantResponse) ProtoMessage() {}
+
+func (x *KickParticipantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickParticipantResponse.ProtoReflect.Descriptor instead.
+func (*KickParticipantResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{52}
+}
+
+// ListBansRequest lists the room's bans
+type ListBansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be the host or a facilitator
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBansRequest) Reset() {
+	*x = ListBansRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBansRequest) ProtoMessage() {}
+
+func (x *ListBansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBansRequest.ProtoReflect.Descriptor instead.
+func (*ListBansRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListBansRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ListBansRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *ListBansRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type ListBansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bans          []*Ban                 `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBansResponse) Reset() {
+	*x = ListBansResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBansResponse) ProtoMessage() {}
+
+func (x *ListBansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBansResponse.ProtoReflect.Descriptor instead.
+func (*ListBansResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListBansResponse) GetBans() []*Ban {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
+// Ban keeps a kicked participant out of the room
+type Ban struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the banned participant; pass to LiftBan
+	ParticipantName string                 `protobuf:"bytes,2,opt,name=participant_name,json=participantName,proto3" json:"participant_name,omitempty"`
+	NameBanned      bool                   `protobuf:"varint,3,opt,name=name_banned,json=nameBanned,proto3" json:"name_banned,omitempty"` // Anyone joining under the same name is turned away too
+	BannedBy        string                 `protobuf:"bytes,4,opt,name=banned_by,json=bannedBy,proto3" json:"banned_by,omitempty"`        // Participant ID of who issued the ban
+	BannedAt        int64                  `protobuf:"varint,5,opt,name=banned_at,json=bannedAt,proto3" json:"banned_at,omitempty"`       // Unix timestamp
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Ban) Reset() {
+	*x = Ban{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ban) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ban) ProtoMessage() {}
+
+func (x *Ban) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ban.ProtoReflect.Descriptor instead.
+func (*Ban) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Ban) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Ban) GetParticipantName() string {
+	if x != nil {
+		return x.ParticipantName
+	}
+	return ""
+}
+
+func (x *Ban) GetNameBanned() bool {
+	if x != nil {
+		return x.NameBanned
+	}
+	return false
+}
+
+func (x *Ban) GetBannedBy() string {
+	if x != nil {
+		return x.BannedBy
+	}
+	return ""
+}
+
+func (x *Ban) GetBannedAt() int64 {
+	if x != nil {
+		return x.BannedAt
+	}
+	return 0
+}
+
+// LiftBanRequest lets a banned participant join again
+type LiftBanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be the host or a facilitator
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	BanId         string                 `protobuf:"bytes,4,opt,name=ban_id,json=banId,proto3" json:"ban_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiftBanRequest) Reset() {
+	*x = LiftBanRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiftBanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiftBanRequest) ProtoMessage() {}
+
+func (x *LiftBanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiftBanRequest.ProtoReflect.Descriptor instead.
+func (*LiftBanRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *LiftBanRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *LiftBanRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *LiftBanRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *LiftBanRequest) GetBanId() string {
+	if x != nil {
+		return x.BanId
+	}
+	return ""
+}
+
+type LiftBanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiftBanResponse) Reset() {
+	*x = LiftBanResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiftBanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiftBanResponse) ProtoMessage() {}
+
+func (x *LiftBanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiftBanResponse.ProtoReflect.Descriptor instead.
+func (*LiftBanResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{57}
+}
+
+// TransferOwnershipRequest transfers host privileges
+type TransferOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // ID of the current host
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	NewHostId     string                 `protobuf:"bytes,4,opt,name=new_host_id,json=newHostId,proto3" json:"new_host_id,omitempty"` // ID of the new host (must not be a spectator)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipRequest) Reset() {
+	*x = TransferOwnershipRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *TransferOwnershipRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *TransferOwnershipRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *TransferOwnershipRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *TransferOwnershipRequest) GetNewHostId() string {
+	if x != nil {
+		return x.NewHostId
+	}
+	return ""
+}
+
+type TransferOwnershipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipResponse) Reset() {
+	*x = TransferOwnershipResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipResponse) ProtoMessage() {}
+
+func (x *TransferOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{59}
+}
+
+// GrantRoleRequest changes a participant's role
+type GrantRoleRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	RoomId              string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId       string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be the owner
+	SessionToken        string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	TargetParticipantId string                 `protobuf:"bytes,4,opt,name=target_participant_id,json=targetParticipantId,proto3" json:"target_participant_id,omitempty"`
+	Role                ParticipantRole        `protobuf:"varint,5,opt,name=role,proto3,enum=esteemed.v1.ParticipantRole" json:"role,omitempty"` // Facilitator, voter or spectator; ownership moves through TransferOwnership
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GrantRoleRequest) Reset() {
+	*x = GrantRoleRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantRoleRequest) ProtoMessage() {}
+
+func (x *GrantRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantRoleRequest.ProtoReflect.Descriptor instead.
+func (*GrantRoleRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GrantRoleRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *GrantRoleRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *GrantRoleRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *GrantRoleRequest) GetTargetParticipantId() string {
+	if x != nil {
+		return x.TargetParticipantId
+	}
+	return ""
+}
+
+func (x *GrantRoleRequest) GetRole() ParticipantRole {
+	if x != nil {
+		return x.Role
+	}
+	return ParticipantRole_PARTICIPANT_ROLE_UNSPECIFIED
+}
+
+type GrantRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantRoleResponse) Reset() {
+	*x = GrantRoleResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantRoleResponse) ProtoMessage() {}
+
+func (x *GrantRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantRoleResponse.ProtoReflect.Descriptor instead.
+func (*GrantRoleResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{61}
+}
+
+// RevokeRoleRequest returns a participant to the voter role
+type RevokeRoleRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	RoomId              string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId       string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be the owner
+	SessionToken        string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	TargetParticipantId string                 `protobuf:"bytes,4,opt,name=target_participant_id,json=targetParticipantId,proto3" json:"target_participant_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RevokeRoleRequest) Reset() {
+	*x = RevokeRoleRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRoleRequest) ProtoMessage() {}
+
+func (x *RevokeRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRoleRequest.ProtoReflect.Descriptor instead.
+func (*RevokeRoleRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RevokeRoleRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RevokeRoleRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *RevokeRoleRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *RevokeRoleRequest) GetTargetParticipantId() string {
+	if x != nil {
+		return x.TargetParticipantId
+	}
+	return ""
+}
+
+type RevokeRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRoleResponse) Reset() {
+	*x = RevokeRoleResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRoleResponse) ProtoMessage() {}
+
+func (x *RevokeRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRoleResponse.ProtoReflect.Descriptor instead.
+func (*RevokeRoleResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{63}
+}
+
+// UpdateParticipantRequest changes the caller's profile; empty fields are left unchanged
+type UpdateParticipantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	AvatarSeed    string                 `protobuf:"bytes,5,opt,name=avatar_seed,json=avatarSeed,proto3" json:"avatar_seed,omitempty"`
+	Color         string                 `protobuf:"bytes,6,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateParticipantRequest) Reset() {
+	*x = UpdateParticipantRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateParticipantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateParticipantRequest) ProtoMessage() {}
+
+func (x *UpdateParticipantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateParticipantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateParticipantRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UpdateParticipantRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *UpdateParticipantRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *UpdateParticipantRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *UpdateParticipantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateParticipantRequest) GetAvatarSeed() string {
+	if x != nil {
+		return x.AvatarSeed
+	}
+	return ""
+}
+
+func (x *UpdateParticipantRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type UpdateParticipantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Participant   *Participant           `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateParticipantResponse) Reset() {
+	*x = UpdateParticipantResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateParticipantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateParticipantResponse) ProtoMessage() {}
+
+func (x *UpdateParticipantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateParticipantResponse.ProtoReflect.Descriptor instead.
+func (*UpdateParticipantResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UpdateParticipantResponse) GetParticipant() *Participant {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+// SetPasscodeRequest sets or removes the room's passcode
+type SetPasscodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be the owner
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Passcode      string                 `protobuf:"bytes,4,opt,name=passcode,proto3" json:"passcode,omitempty"` // 4-64 characters, or empty to remove it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPasscodeRequest) Reset() {
+	*x = SetPasscodeRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPasscodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPasscodeRequest) ProtoMessage() {}
+
+func (x *SetPasscodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPasscodeRequest.ProtoReflect.Descriptor instead.
+func (*SetPasscodeRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SetPasscodeRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *SetPasscodeRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *SetPasscodeRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SetPasscodeRequest) GetPasscode() string {
+	if x != nil {
+		return x.Passcode
+	}
+	return ""
+}
+
+type SetPasscodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPasscodeResponse) Reset() {
+	*x = SetPasscodeResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPasscodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPasscodeResponse) ProtoMessage() {}
+
+func (x *SetPasscodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPasscodeResponse.ProtoReflect.Descriptor instead.
+func (*SetPasscodeResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{67}
+}
+
+// SetVisibilityRequest changes who can find and join the room
+type SetVisibilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be the owner
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Visibility    RoomVisibility         `protobuf:"varint,4,opt,name=visibility,proto3,enum=esteemed.v1.RoomVisibility" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVisibilityRequest) Reset() {
+	*x = SetVisibilityRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVisibilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVisibilityRequest) ProtoMessage() {}
+
+func (x *SetVisibilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVisibilityRequest.ProtoReflect.Descriptor instead.
+func (*SetVisibilityRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SetVisibilityRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *SetVisibilityRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *SetVisibilityRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SetVisibilityRequest) GetVisibility() RoomVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return RoomVisibility_ROOM_VISIBILITY_UNSPECIFIED
+}
+
+type SetVisibilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVisibilityResponse) Reset() {
+	*x = SetVisibilityResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVisibilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVisibilityResponse) ProtoMessage() {}
+
+func (x *SetVisibilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVisibilityResponse.ProtoReflect.Descriptor instead.
+func (*SetVisibilityResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{69}
+}
+
+// CreateInviteRequest creates an invite link
+type CreateInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be the host or a facilitator
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Role          ParticipantRole        `protobuf:"varint,4,opt,name=role,proto3,enum=esteemed.v1.ParticipantRole" json:"role,omitempty"` // Voter or spectator (unspecified means voter)
+	TtlMinutes    int32                  `protobuf:"varint,5,opt,name=ttl_minutes,json=ttlMinutes,proto3" json:"ttl_minutes,omitempty"`    // Optional lifetime (1 minute to 30 days, defaults to 24 hours)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteRequest) Reset() {
+	*x = CreateInviteRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteRequest) ProtoMessage() {}
+
+func (x *CreateInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateInviteRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *CreateInviteRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *CreateInviteRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *CreateInviteRequest) GetRole() ParticipantRole {
+	if x != nil {
+		return x.Role
+	}
+	return ParticipantRole_PARTICIPANT_ROLE_UNSPECIFIED
+}
+
+func (x *CreateInviteRequest) GetTtlMinutes() int32 {
+	if x != nil {
+		return x.TtlMinutes
+	}
+	return 0
+}
+
+type CreateInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`          // Used to revoke the invite
+	InviteToken   string                 `protobuf:"bytes,2,opt,name=invite_token,json=inviteToken,proto3" json:"invite_token,omitempty"` // Passed to JoinRoom by invitees
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`      // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteResponse) Reset() {
+	*x = CreateInviteResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteResponse) ProtoMessage() {}
+
+func (x *CreateInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteResponse.ProtoReflect.Descriptor instead.
+func (*CreateInviteResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateInviteResponse) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+func (x *CreateInviteResponse) GetInviteToken() string {
+	if x != nil {
+		return x.InviteToken
+	}
+	return ""
+}
+
+func (x *CreateInviteResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// RevokeInviteRequest stops an invite link from being used
+type RevokeInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be the host or a facilitator
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	InviteId      string                 `protobuf:"bytes,4,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteRequest) Reset() {
+	*x = RevokeInviteRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteRequest) ProtoMessage() {}
+
+func (x *RevokeInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInviteRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *RevokeInviteRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RevokeInviteRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *RevokeInviteRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *RevokeInviteRequest) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+type RevokeInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteResponse) Reset() {
+	*x = RevokeInviteResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteResponse) ProtoMessage() {}
+
+func (x *RevokeInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInviteResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{73}
+}
+
+// SetRoomLockedRequest locks or unlocks the room
+type SetRoomLockedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be the host or a facilitator
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Locked        bool                   `protobuf:"varint,4,opt,name=locked,proto3" json:"locked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRoomLockedRequest) Reset() {
+	*x = SetRoomLockedRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRoomLockedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRoomLockedRequest) ProtoMessage() {}
+
+func (x *SetRoomLockedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRoomLockedRequest.ProtoReflect.Descriptor instead.
+func (*SetRoomLockedRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SetRoomLockedRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *SetRoomLockedRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *SetRoomLockedRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SetRoomLockedRequest) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+type SetRoomLockedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRoomLockedResponse) Reset() {
+	*x = SetRoomLockedResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRoomLockedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRoomLockedResponse) ProtoMessage() {}
+
+func (x *SetRoomLockedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRoomLockedResponse.ProtoReflect.Descriptor instead.
+func (*SetRoomLockedResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{75}
+}
+
+// SetWaitingRoomRequest turns the waiting room on or off
+type SetWaitingRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be the owner
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Enabled       bool                   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"` // Requests already waiting stay until decided
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWaitingRoomRequest) Reset() {
+	*x = SetWaitingRoomRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWaitingRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWaitingRoomRequest) ProtoMessage() {}
+
+func (x *SetWaitingRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWaitingRoomRequest.ProtoReflect.Descriptor instead.
+func (*SetWaitingRoomRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SetWaitingRoomRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *SetWaitingRoomRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *SetWaitingRoomRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SetWaitingRoomRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetWaitingRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWaitingRoomResponse) Reset() {
+	*x = SetWaitingRoomResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWaitingRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWaitingRoomResponse) ProtoMessage() {}
+
+func (x *SetWaitingRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWaitingRoomResponse.ProtoReflect.Descriptor instead.
+func (*SetWaitingRoomResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{77}
+}
+
+// AdmitParticipantRequest lets a participant in from the waiting room
+type AdmitParticipantRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	RoomId               string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId        string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be the host or a facilitator
+	SessionToken         string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	PendingParticipantId string                 `protobuf:"bytes,4,opt,name=pending_participant_id,json=pendingParticipantId,proto3" json:"pending_participant_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AdmitParticipantRequest) Reset() {
+	*x = AdmitParticipantRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdmitParticipantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdmitParticipantRequest) ProtoMessage() {}
+
+func (x *AdmitParticipantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdmitParticipantRequest.ProtoReflect.Descriptor instead.
+func (*AdmitParticipantRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AdmitParticipantRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *AdmitParticipantRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *AdmitParticipantRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *AdmitParticipantRequest) GetPendingParticipantId() string {
+	if x != nil {
+		return x.PendingParticipantId
+	}
+	return ""
+}
+
+type AdmitParticipantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdmitParticipantResponse) Reset() {
+	*x = AdmitParticipantResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdmitParticipantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdmitParticipantResponse) ProtoMessage() {}
+
+func (x *AdmitParticipantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdmitParticipantResponse.ProtoReflect.Descriptor instead.
+func (*AdmitParticipantResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{79}
+}
+
+// RejectParticipantRequest turns away a participant in the waiting room
+type RejectParticipantRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	RoomId               string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId        string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be the host or a facilitator
+	SessionToken         string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	PendingParticipantId string                 `protobuf:"bytes,4,opt,name=pending_participant_id,json=pendingParticipantId,proto3" json:"pending_participant_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RejectParticipantRequest) Reset() {
+	*x = RejectParticipantRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectParticipantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectParticipantRequest) ProtoMessage() {}
+
+func (x *RejectParticipantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectParticipantRequest.ProtoReflect.Descriptor instead.
+func (*RejectParticipantRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RejectParticipantRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RejectParticipantRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *RejectParticipantRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *RejectParticipantRequest) GetPendingParticipantId() string {
+	if x != nil {
+		return x.PendingParticipantId
+	}
+	return ""
+}
+
+type RejectParticipantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectParticipantResponse) Reset() {
+	*x = RejectParticipantResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectParticipantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectParticipantResponse) ProtoMessage() {}
+
+func (x *RejectParticipantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectParticipantResponse.ProtoReflect.Descriptor instead.
+func (*RejectParticipantResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{81}
+}
+
+// SetParticipantLimitsRequest caps how many voters and spectators the room takes.
+// Nobody is removed if the room already has more; only newcomers are turned away.
+type SetParticipantLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be the owner
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	MaxVoters     int32                  `protobuf:"varint,4,opt,name=max_voters,json=maxVoters,proto3" json:"max_voters,omitempty"`             // 0 uses the server's limit; higher values are capped at it
+	MaxSpectators int32                  `protobuf:"varint,5,opt,name=max_spectators,json=maxSpectators,proto3" json:"max_spectators,omitempty"` // 0 uses the server's limit; higher values are capped at it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetParticipantLimitsRequest) Reset() {
+	*x = SetParticipantLimitsRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetParticipantLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetParticipantLimitsRequest) ProtoMessage() {}
+
+func (x *SetParticipantLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetParticipantLimitsRequest.ProtoReflect.Descriptor instead.
+func (*SetParticipantLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SetParticipantLimitsRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *SetParticipantLimitsRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *SetParticipantLimitsRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SetParticipantLimitsRequest) GetMaxVoters() int32 {
+	if x != nil {
+		return x.MaxVoters
+	}
+	return 0
+}
+
+func (x *SetParticipantLimitsRequest) GetMaxSpectators() int32 {
+	if x != nil {
+		return x.MaxSpectators
+	}
+	return 0
+}
+
+type SetParticipantLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetParticipantLimitsResponse) Reset() {
+	*x = SetParticipantLimitsResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetParticipantLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetParticipantLimitsResponse) ProtoMessage() {}
+
+func (x *SetParticipantLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetParticipantLimitsResponse.ProtoReflect.Descriptor instead.
+func (*SetParticipantLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{83}
+}
+
+// SetSpectatorRequest switches a participant between voting and spectating
+type SetSpectatorRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	RoomId              string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId       string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	SessionToken        string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	TargetParticipantId string                 `protobuf:"bytes,4,opt,name=target_participant_id,json=targetParticipantId,proto3" json:"target_participant_id,omitempty"` // Empty for the caller; others need the host or a facilitator
+	Spectator           bool                   `protobuf:"varint,5,opt,name=spectator,proto3" json:"spectator,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SetSpectatorRequest) Reset() {
+	*x = SetSpectatorRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSpectatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSpectatorRequest) ProtoMessage() {}
+
+func (x *SetSpectatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSpectatorRequest.ProtoReflect.Descriptor instead.
+func (*SetSpectatorRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SetSpectatorRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *SetSpectatorRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *SetSpectatorRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SetSpectatorRequest) GetTargetParticipantId() string {
+	if x != nil {
+		return x.TargetParticipantId
+	}
+	return ""
+}
+
+func (x *SetSpectatorRequest) GetSpectator() bool {
+	if x != nil {
+		return x.Spectator
+	}
+	return false
+}
+
+type SetSpectatorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSpectatorResponse) Reset() {
+	*x = SetSpectatorResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSpectatorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSpectatorResponse) ProtoMessage() {}
+
+func (x *SetSpectatorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSpectatorResponse.ProtoReflect.Descriptor instead.
+func (*SetSpectatorResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{85}
+}
+
+// SetFacilitatorRotationRequest turns facilitator rotation on or off
+type SetFacilitatorRotationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be the owner
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Enabled       bool                   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFacilitatorRotationRequest) Reset() {
+	*x = SetFacilitatorRotationRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFacilitatorRotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFacilitatorRotationRequest) ProtoMessage() {}
+
+func (x *SetFacilitatorRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFacilitatorRotationRequest.ProtoReflect.Descriptor instead.
+func (*SetFacilitatorRotationRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SetFacilitatorRotationRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *SetFacilitatorRotationRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *SetFacilitatorRotationRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SetFacilitatorRotationRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetFacilitatorRotationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFacilitatorRotationResponse) Reset() {
+	*x = SetFacilitatorRotationResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFacilitatorRotationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFacilitatorRotationResponse) ProtoMessage() {}
+
+func (x *SetFacilitatorRotationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFacilitatorRotationResponse.ProtoReflect.Descriptor instead.
+func (*SetFacilitatorRotationResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{87}
+}
+
+// SetHostSuccessionRequest sets the room's succession policy
+type SetHostSuccessionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RoomId         string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId  string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be the owner
+	SessionToken   string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	HostSuccession HostSuccession         `protobuf:"varint,4,opt,name=host_succession,json=hostSuccession,proto3,enum=esteemed.v1.HostSuccession" json:"host_succession,omitempty"`
+	DeputyId       string                 `protobuf:"bytes,5,opt,name=deputy_id,json=deputyId,proto3" json:"deputy_id,omitempty"` // Deputy policy only; may be empty and named later
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetHostSuccessionRequest) Reset() {
+	*x = SetHostSuccessionRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHostSuccessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHostSuccessionRequest) ProtoMessage() {}
+
+func (x *SetHostSuccessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHostSuccessionRequest.ProtoReflect.Descriptor instead.
+func (*SetHostSuccessionRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SetHostSuccessionRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *SetHostSuccessionRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *SetHostSuccessionRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SetHostSuccessionRequest) GetHostSuccession() HostSuccession {
+	if x != nil {
+		return x.HostSuccession
+	}
+	return HostSuccession_HOST_SUCCESSION_UNSPECIFIED
+}
+
+func (x *SetHostSuccessionRequest) GetDeputyId() string {
+	if x != nil {
+		return x.DeputyId
+	}
+	return ""
+}
+
+type SetHostSuccessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHostSuccessionResponse) Reset() {
+	*x = SetHostSuccessionResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHostSuccessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHostSuccessionResponse) ProtoMessage() {}
+
+func (x *SetHostSuccessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHostSuccessionResponse.ProtoReflect.Descriptor instead.
+func (*SetHostSuccessionResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{89}
+}
+
+// ClaimHostRequest claims a room without a host
+type ClaimHostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must not be a spectator
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimHostRequest) Reset() {
+	*x = ClaimHostRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type RoomEvent_ParticipantJoined struct {
-	ParticipantJoined *ParticipantJoined `protobuf:"bytes,1,opt,name=participant_joined,json=participantJoined,proto3,oneof"`
+func (x *ClaimHostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type RoomEvent_ParticipantLeft struct {
-	ParticipantLeft *ParticipantLeft `protobuf:"bytes,2,opt,name=participant_left,json=participantLeft,proto3,oneof"`
-}
+func (*ClaimHostRequest) ProtoMessage() {}
 
-type RoomEvent_StateChanged struct {
-	StateChanged *RoomStateChanged `protobuf:"bytes,3,opt,name=state_changed,json=stateChanged,proto3,oneof"`
+func (x *ClaimHostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type RoomEvent_RoomClosed struct {
-	RoomClosed *RoomClosed `protobuf:"bytes,4,opt,name=room_closed,json=roomClosed,proto3,oneof"`
+// Deprecated: Use ClaimHostRequest.ProtoReflect.Descriptor instead.
+func (*ClaimHostRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{90}
 }
 
-type RoomEvent_HostChanged struct {
-	HostChanged *HostChanged `protobuf:"bytes,5,opt,name=host_changed,json=hostChanged,proto3,oneof"`
+func (x *ClaimHostRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
 }
 
-func (*RoomEvent_ParticipantJoined) isRoomEvent_Event() {}
-
-func (*RoomEvent_ParticipantLeft) isRoomEvent_Event() {}
-
-func (*RoomEvent_StateChanged) isRoomEvent_Event() {}
-
-func (*RoomEvent_RoomClosed) isRoomEvent_Event() {}
+func (x *ClaimHostRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
 
-func (*RoomEvent_HostChanged) isRoomEvent_Event() {}
+func (x *ClaimHostRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
 
-type ParticipantJoined struct {
+type ClaimHostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Participant   *Participant           `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ParticipantJoined) Reset() {
-	*x = ParticipantJoined{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[15]
+func (x *ClaimHostResponse) Reset() {
+	*x = ClaimHostResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ParticipantJoined) String() string {
+func (x *ClaimHostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParticipantJoined) ProtoMessage() {}
+func (*ClaimHostResponse) ProtoMessage() {}
 
-func (x *ParticipantJoined) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[15]
+func (x *ClaimHostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1139,40 +6421,36 @@ func (x *ParticipantJoined) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ParticipantJoined.ProtoReflect.Descriptor instead.
-func (*ParticipantJoined) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *ParticipantJoined) GetParticipant() *Participant {
-	if x != nil {
-		return x.Participant
-	}
-	return nil
+// Deprecated: Use ClaimHostResponse.ProtoReflect.Descriptor instead.
+func (*ClaimHostResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{91}
 }
 
-type ParticipantLeft struct {
+// KeepAliveRequest extends a room that is about to expire
+type KeepAliveRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ParticipantId string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ParticipantLeft) Reset() {
-	*x = ParticipantLeft{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[16]
+func (x *KeepAliveRequest) Reset() {
+	*x = KeepAliveRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ParticipantLeft) String() string {
+func (x *KeepAliveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParticipantLeft) ProtoMessage() {}
+func (*KeepAliveRequest) ProtoMessage() {}
 
-func (x *ParticipantLeft) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[16]
+func (x *KeepAliveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1183,40 +6461,54 @@ func (x *ParticipantLeft) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ParticipantLeft.ProtoReflect.Descriptor instead.
-func (*ParticipantLeft) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use KeepAliveRequest.ProtoReflect.Descriptor instead.
+func (*KeepAliveRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *ParticipantLeft) GetParticipantId() string {
+func (x *KeepAliveRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *KeepAliveRequest) GetParticipantId() string {
 	if x != nil {
 		return x.ParticipantId
 	}
 	return ""
 }
 
-type RoomStateChanged struct {
+func (x *KeepAliveRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type KeepAliveResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NewState      RoomState              `protobuf:"varint,1,opt,name=new_state,json=newState,proto3,enum=esteemed.v1.RoomState" json:"new_state,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,1,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // When the room now expires (0 for persistent rooms)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoomStateChanged) Reset() {
-	*x = RoomStateChanged{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[17]
+func (x *KeepAliveResponse) Reset() {
+	*x = KeepAliveResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoomStateChanged) String() string {
+func (x *KeepAliveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoomStateChanged) ProtoMessage() {}
+func (*KeepAliveResponse) ProtoMessage() {}
 
-func (x *RoomStateChanged) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[17]
+func (x *KeepAliveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1227,40 +6519,43 @@ func (x *RoomStateChanged) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoomStateChanged.ProtoReflect.Descriptor instead.
-func (*RoomStateChanged) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use KeepAliveResponse.ProtoReflect.Descriptor instead.
+func (*KeepAliveResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *RoomStateChanged) GetNewState() RoomState {
+func (x *KeepAliveResponse) GetExpiresAt() int64 {
 	if x != nil {
-		return x.NewState
+		return x.ExpiresAt
 	}
-	return RoomState_ROOM_STATE_UNSPECIFIED
+	return 0
 }
 
-type RoomClosed struct {
+// CreateBreakoutRequest creates a child room
+type CreateBreakoutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`                      // Parent room
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be host or facilitator of the parent room
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoomClosed) Reset() {
-	*x = RoomClosed{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[18]
+func (x *CreateBreakoutRequest) Reset() {
+	*x = CreateBreakoutRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoomClosed) String() string {
+func (x *CreateBreakoutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoomClosed) ProtoMessage() {}
+func (*CreateBreakoutRequest) ProtoMessage() {}
 
-func (x *RoomClosed) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[18]
+func (x *CreateBreakoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1271,40 +6566,54 @@ func (x *RoomClosed) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoomClosed.ProtoReflect.Descriptor instead.
-func (*RoomClosed) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use CreateBreakoutRequest.ProtoReflect.Descriptor instead.
+func (*CreateBreakoutRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *RoomClosed) GetReason() string {
+func (x *CreateBreakoutRequest) GetRoomId() string {
 	if x != nil {
-		return x.Reason
+		return x.RoomId
 	}
 	return ""
 }
 
-type HostChanged struct {
+func (x *CreateBreakoutRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *CreateBreakoutRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type CreateBreakoutResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NewHostId     string                 `protobuf:"bytes,1,opt,name=new_host_id,json=newHostId,proto3" json:"new_host_id,omitempty"`
+	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"` // The new breakout room
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HostChanged) Reset() {
-	*x = HostChanged{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[19]
+func (x *CreateBreakoutResponse) Reset() {
+	*x = CreateBreakoutResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HostChanged) String() string {
+func (x *CreateBreakoutResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostChanged) ProtoMessage() {}
+func (*CreateBreakoutResponse) ProtoMessage() {}
 
-func (x *HostChanged) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[19]
+func (x *CreateBreakoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1315,44 +6624,45 @@ func (x *HostChanged) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostChanged.ProtoReflect.Descriptor instead.
-func (*HostChanged) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use CreateBreakoutResponse.ProtoReflect.Descriptor instead.
+func (*CreateBreakoutResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *HostChanged) GetNewHostId() string {
+func (x *CreateBreakoutResponse) GetRoom() *Room {
 	if x != nil {
-		return x.NewHostId
+		return x.Room
 	}
-	return ""
+	return nil
 }
 
-// KickParticipantRequest removes a participant from the room
-type KickParticipantRequest struct {
+// MoveToBreakoutRequest moves a participant into a breakout
+type MoveToBreakoutRequest struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
-	RoomId              string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	ParticipantId       string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // ID of the participant making the request (must be host)
+	RoomId              string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`                      // Parent room
+	ParticipantId       string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be host or facilitator of the parent room
 	SessionToken        string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	TargetParticipantId string                 `protobuf:"bytes,4,opt,name=target_participant_id,json=targetParticipantId,proto3" json:"target_participant_id,omitempty"` // ID of the participant to kick
+	TargetParticipantId string                 `protobuf:"bytes,4,opt,name=target_participant_id,json=targetParticipantId,proto3" json:"target_participant_id,omitempty"`
+	BreakoutRoomId      string                 `protobuf:"bytes,5,opt,name=breakout_room_id,json=breakoutRoomId,proto3" json:"breakout_room_id,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
-func (x *KickParticipantRequest) Reset() {
-	*x = KickParticipantRequest{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[20]
+func (x *MoveToBreakoutRequest) Reset() {
+	*x = MoveToBreakoutRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *KickParticipantRequest) String() string {
+func (x *MoveToBreakoutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KickParticipantRequest) ProtoMessage() {}
+func (*MoveToBreakoutRequest) ProtoMessage() {}
 
-func (x *KickParticipantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[20]
+func (x *MoveToBreakoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1363,60 +6673,67 @@ func (x *KickParticipantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KickParticipantRequest.ProtoReflect.Descriptor instead.
-func (*KickParticipantRequest) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use MoveToBreakoutRequest.ProtoReflect.Descriptor instead.
+func (*MoveToBreakoutRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *KickParticipantRequest) GetRoomId() string {
+func (x *MoveToBreakoutRequest) GetRoomId() string {
 	if x != nil {
 		return x.RoomId
 	}
 	return ""
 }
 
-func (x *KickParticipantRequest) GetParticipantId() string {
+func (x *MoveToBreakoutRequest) GetParticipantId() string {
 	if x != nil {
 		return x.ParticipantId
 	}
 	return ""
 }
 
-func (x *KickParticipantRequest) GetSessionToken() string {
+func (x *MoveToBreakoutRequest) GetSessionToken() string {
 	if x != nil {
 		return x.SessionToken
 	}
 	return ""
 }
 
-func (x *KickParticipantRequest) GetTargetParticipantId() string {
+func (x *MoveToBreakoutRequest) GetTargetParticipantId() string {
 	if x != nil {
 		return x.TargetParticipantId
 	}
 	return ""
 }
 
-type KickParticipantResponse struct {
+func (x *MoveToBreakoutRequest) GetBreakoutRoomId() string {
+	if x != nil {
+		return x.BreakoutRoomId
+	}
+	return ""
+}
+
+type MoveToBreakoutResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *KickParticipantResponse) Reset() {
-	*x = KickParticipantResponse{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[21]
+func (x *MoveToBreakoutResponse) Reset() {
+	*x = MoveToBreakoutResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *KickParticipantResponse) String() string {
+func (x *MoveToBreakoutResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KickParticipantResponse) ProtoMessage() {}
+func (*MoveToBreakoutResponse) ProtoMessage() {}
 
-func (x *KickParticipantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[21]
+func (x *MoveToBreakoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1427,37 +6744,37 @@ func (x *KickParticipantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KickParticipantResponse.ProtoReflect.Descriptor instead.
-func (*KickParticipantResponse) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use MoveToBreakoutResponse.ProtoReflect.Descriptor instead.
+func (*MoveToBreakoutResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{97}
 }
 
-// TransferOwnershipRequest transfers host privileges
-type TransferOwnershipRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // ID of the current host
-	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	NewHostId     string                 `protobuf:"bytes,4,opt,name=new_host_id,json=newHostId,proto3" json:"new_host_id,omitempty"` // ID of the new host (must not be a spectator)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// CloseBreakoutRequest closes a breakout and merges its round history into the parent
+type CloseBreakoutRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RoomId         string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`                      // Parent room
+	ParticipantId  string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Must be host or facilitator of the parent room
+	SessionToken   string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	BreakoutRoomId string                 `protobuf:"bytes,4,opt,name=breakout_room_id,json=breakoutRoomId,proto3" json:"breakout_room_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *TransferOwnershipRequest) Reset() {
-	*x = TransferOwnershipRequest{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[22]
+func (x *CloseBreakoutRequest) Reset() {
+	*x = CloseBreakoutRequest{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransferOwnershipRequest) String() string {
+func (x *CloseBreakoutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferOwnershipRequest) ProtoMessage() {}
+func (*CloseBreakoutRequest) ProtoMessage() {}
 
-func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[22]
+func (x *CloseBreakoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1468,60 +6785,61 @@ func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferOwnershipRequest.ProtoReflect.Descriptor instead.
-func (*TransferOwnershipRequest) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use CloseBreakoutRequest.ProtoReflect.Descriptor instead.
+func (*CloseBreakoutRequest) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *TransferOwnershipRequest) GetRoomId() string {
+func (x *CloseBreakoutRequest) GetRoomId() string {
 	if x != nil {
 		return x.RoomId
 	}
 	return ""
 }
 
-func (x *TransferOwnershipRequest) GetParticipantId() string {
+func (x *CloseBreakoutRequest) GetParticipantId() string {
 	if x != nil {
 		return x.ParticipantId
 	}
 	return ""
 }
 
-func (x *TransferOwnershipRequest) GetSessionToken() string {
+func (x *CloseBreakoutRequest) GetSessionToken() string {
 	if x != nil {
 		return x.SessionToken
 	}
 	return ""
 }
 
-func (x *TransferOwnershipRequest) GetNewHostId() string {
+func (x *CloseBreakoutRequest) GetBreakoutRoomId() string {
 	if x != nil {
-		return x.NewHostId
+		return x.BreakoutRoomId
 	}
 	return ""
 }
 
-type TransferOwnershipResponse struct {
+type CloseBreakoutResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoundsMerged  int32                  `protobuf:"varint,1,opt,name=rounds_merged,json=roundsMerged,proto3" json:"rounds_merged,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransferOwnershipResponse) Reset() {
-	*x = TransferOwnershipResponse{}
-	mi := &file_esteemed_v1_room_proto_msgTypes[23]
+func (x *CloseBreakoutResponse) Reset() {
+	*x = CloseBreakoutResponse{}
+	mi := &file_esteemed_v1_room_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransferOwnershipResponse) String() string {
+func (x *CloseBreakoutResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferOwnershipResponse) ProtoMessage() {}
+func (*CloseBreakoutResponse) ProtoMessage() {}
 
-func (x *TransferOwnershipResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_esteemed_v1_room_proto_msgTypes[23]
+func (x *CloseBreakoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_esteemed_v1_room_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1532,9 +6850,16 @@ func (x *TransferOwnershipResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferOwnershipResponse.ProtoReflect.Descriptor instead.
-func (*TransferOwnershipResponse) Descriptor() ([]byte, []int) {
-	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use CloseBreakoutResponse.ProtoReflect.Descriptor instead.
+func (*CloseBreakoutResponse) Descriptor() ([]byte, []int) {
+	return file_esteemed_v1_room_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *CloseBreakoutResponse) GetRoundsMerged() int32 {
+	if x != nil {
+		return x.RoundsMerged
+	}
+	return 0
 }
 
 var File_esteemed_v1_room_proto protoreflect.FileDescriptor
@@ -1542,237 +6867,1180 @@ var File_esteemed_v1_room_proto protoreflect.FileDescriptor
 var file_esteemed_v1_room_proto_rawDesc = []byte{
 	0x0a, 0x16, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f,
 	0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d,
-	0x65, 0x64, 0x2e, 0x76, 0x31, 0x22, 0x60, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x65,
-	0x72, 0x69, 0x63, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x6e,
-	0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73,
-	0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x22, 0x66, 0x0a, 0x0a, 0x43, 0x61, 0x72, 0x64, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2f, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x06,
-	0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x22,
-	0xef, 0x01, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x0c,
-	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x65, 0x64, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2f,
+	0x76, 0x31, 0x2f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x60, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69,
+	0x63, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x6e, 0x75, 0x6d,
+	0x65, 0x72, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x4e, 0x75,
+	0x6d, 0x65, 0x72, 0x69, 0x63, 0x22, 0x66, 0x0a, 0x0a, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x2f, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x06, 0x70, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x22, 0x5b, 0x0a,
+	0x0d, 0x44, 0x6f, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18,
+	0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x6f, 0x74, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x64, 0x6f, 0x74, 0x73, 0x50, 0x65, 0x72, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x22, 0xe0, 0x08, 0x0a, 0x04, 0x52,
+	0x6f, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d,
+	0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x29, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x65, 0x73, 0x74,
+	0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x64, 0x6f, 0x74, 0x5f, 0x76,
+	0x6f, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x6f, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x64, 0x6f,
+	0x74, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x0a, 0x0e, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x49,
+	0x64, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x73,
+	0x79, 0x6e, 0x63, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x76, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x1a,
+	0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x18, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x68, 0x6f,
+	0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x0e, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x75, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x70, 0x75, 0x74, 0x79, 0x49, 0x64, 0x12, 0x2d, 0x0a,
+	0x12, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72, 0x6f, 0x74, 0x61, 0x74,
+	0x65, 0x46, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x11,
+	0x6b, 0x65, 0x65, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6b, 0x65, 0x65, 0x70, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x0a, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d,
+	0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x61,
+	0x73, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61,
+	0x73, 0x50, 0x61, 0x73, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x6f, 0x6f,
+	0x6d, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x4b, 0x0a, 0x14, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x17, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x0c, 0x70, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x65, 0x73, 0x74, 0x65,
-	0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x5f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65,
-	0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x22, 0xad, 0x01, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x68, 0x6f, 0x73, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x21,
-	0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21,
-	0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f,
-	0x72, 0x22, 0x8f, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x61, 0x72,
-	0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72,
-	0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x22, 0x87, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f,
-	0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x6f,
-	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65,
-	0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f,
-	0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
-	0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x9d, 0x01,
-	0x0a, 0x0f, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x13, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x70, 0x65,
+	0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x68, 0x61, 0x73, 0x5f, 0x76,
+	0x69, 0x65, 0x77, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x68, 0x61, 0x73, 0x56, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe7, 0x02,
+	0x0a, 0x0b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73,
+	0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73,
+	0x5f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x69, 0x73, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x0a,
+	0x10, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75,
+	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x69, 0x73, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x30,
+	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65,
+	0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x53, 0x65, 0x65,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x93, 0x06, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x38, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x63,
+	0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x29, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d,
+	0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x64, 0x6f, 0x74, 0x5f, 0x76, 0x6f, 0x74, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x74, 0x56,
+	0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x64, 0x6f, 0x74, 0x56, 0x6f,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e,
+	0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x27,
+	0x0a, 0x0f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x44,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x3c, 0x0a, 0x1a, 0x69,
+	0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x18, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x68, 0x6f, 0x73,
+	0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x0e, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x2d, 0x0a, 0x12, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69,
+	0x74, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2b,
+	0x0a, 0x11, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6b, 0x65, 0x65, 0x70, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x0a, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1b, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f,
+	0x6f, 0x6d, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x77, 0x61, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x76,
+	0x6f, 0x74, 0x65, 0x72, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78,
+	0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x6d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x87, 0x01,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xdc, 0x01, 0x0a, 0x0f, 0x4a, 0x6f, 0x69, 0x6e,
+	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72,
+	0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f,
+	0x6f, 0x6d, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x70,
+	0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x10, 0x4a, 0x6f, 0x69, 0x6e, 0x52,
+	0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x72,
+	0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x73, 0x74, 0x65,
+	0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f,
+	0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x77, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f,
+	0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f,
+	0x6d, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x22,
+	0xea, 0x01, 0x0a, 0x0b, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f,
+	0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xc3, 0x01, 0x0a,
+	0x10, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73,
-	0x5f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x69, 0x73, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x85, 0x01,
-	0x0a, 0x10, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25,
-	0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
-	0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x77, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f,
-	0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f,
-	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d,
-	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74,
-	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x13,
-	0x0a, 0x11, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05,
-	0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x73,
-	0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x22, 0xca, 0x01, 0x0a,
-	0x0b, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x70, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x65,
-	0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78,
-	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x50, 0x0a, 0x10, 0x57, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xf1, 0x02, 0x0a, 0x09,
-	0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x4f, 0x0a, 0x12, 0x70, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4a,
-	0x6f, 0x69, 0x6e, 0x65, 0x64, 0x48, 0x00, 0x52, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x6e, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x10, 0x70, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4c, 0x65,
-	0x66, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x44, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65,
-	0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x0b, 0x72,
-	0x6f, 0x6f, 0x6d, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x6f, 0x6f, 0x6d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x6f, 0x6f,
-	0x6d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x22, 0xdd, 0x0f, 0x0a, 0x09, 0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x1b, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x4f, 0x0a, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4a, 0x6f, 0x69,
+	0x6e, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4c, 0x65, 0x66, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x44,
+	0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x0b, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x73, 0x74, 0x65,
+	0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x6f, 0x6f, 0x6d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x12, 0x3d, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x48, 0x00, 0x52, 0x0b, 0x68, 0x6f, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12,
+	0x4c, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x73, 0x74,
+	0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x64, 0x48, 0x00, 0x52, 0x10, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x46, 0x0a,
+	0x0f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x48,
+	0x00, 0x52, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x64,
+	0x6f, 0x6e, 0x65, 0x12, 0x49, 0x0a, 0x10, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0f, 0x64,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x43,
+	0x0a, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x57, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x57, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x0a, 0x6b, 0x65, 0x70, 0x74, 0x5f, 0x61, 0x6c, 0x69, 0x76,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d,
+	0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x70, 0x74, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x48,
+	0x00, 0x52, 0x09, 0x6b, 0x65, 0x70, 0x74, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x5c, 0x0a, 0x17,
+	0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
 	0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x68, 0x6f, 0x73, 0x74, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22,
-	0x4f, 0x0a, 0x11, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4a, 0x6f,
-	0x69, 0x6e, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
-	0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x73, 0x74, 0x65,
-	0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
-	0x61, 0x6e, 0x74, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
-	0x22, 0x38, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4c,
-	0x65, 0x66, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x10, 0x52, 0x6f,
-	0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x33,
-	0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x16, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x22, 0x24, 0x0a, 0x0a, 0x52, 0x6f, 0x6f, 0x6d, 0x43, 0x6c, 0x6f, 0x73, 0x65,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x2d, 0x0a, 0x0b, 0x48, 0x6f, 0x73,
-	0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f,
-	0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
-	0x65, 0x77, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0xb1, 0x01, 0x0a, 0x16, 0x4b, 0x69, 0x63,
-	0x6b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x48, 0x00, 0x52, 0x15, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x52, 0x0a, 0x13, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d,
+	0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x12, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x6b,
+	0x0a, 0x1c, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x48, 0x00, 0x52,
+	0x1a, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x68,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61,
+	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x48, 0x00, 0x52, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x12, 0x4f, 0x0a, 0x12, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x11, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x10, 0x70, 0x61, 0x73, 0x73, 0x63, 0x6f, 0x64, 0x65,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73,
+	0x73, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0f,
+	0x70, 0x61, 0x73, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12,
+	0x41, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x4c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x12, 0x53, 0x0a, 0x14, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x48, 0x00, 0x52, 0x12, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f, 0x6d,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x43, 0x0a, 0x0e, 0x6a, 0x6f, 0x69, 0x6e, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x6a,
+	0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0d,
+	0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x40,
+	0x0a, 0x0d, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x48, 0x00, 0x52, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x12, 0x52, 0x0a, 0x13, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x12, 0x65, 0x0a, 0x1a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65,
+	0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x18, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x4d, 0x0a, 0x12, 0x76,
+	0x69, 0x65, 0x77, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d,
+	0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x48, 0x00, 0x52, 0x10, 0x76, 0x69, 0x65, 0x77, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x79, 0x6e, 0x63, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x73, 0x74,
+	0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x48,
+	0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x35, 0x0a, 0x08,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f,
+	0x6d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x22, 0x24, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x22, 0x4f, 0x0a, 0x11, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x3a,
+	0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x0b, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x22, 0x38, 0x0a, 0x0f, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x10, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x65, 0x73,
+	0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x61, 0x0a,
+	0x0a, 0x52, 0x6f, 0x6f, 0x6d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x73, 0x74, 0x65,
+	0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0xb7, 0x01, 0x0a, 0x0b, 0x48, 0x6f, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x28, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x68, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x65, 0x73, 0x74,
+	0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x50, 0x0a, 0x12, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x3a, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52,
+	0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x22, 0x36, 0x0a, 0x1a,
+	0x46, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x22, 0x50, 0x0a, 0x11, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x0a, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d,
+	0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x34, 0x0a, 0x0f, 0x50, 0x61, 0x73, 0x73, 0x63, 0x6f,
+	0x64, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x61, 0x73,
+	0x5f, 0x70, 0x61, 0x73, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x68, 0x61, 0x73, 0x50, 0x61, 0x73, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x53, 0x0a, 0x12,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x6e,
+	0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65,
+	0x64, 0x22, 0x60, 0x0a, 0x18, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x56, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x22, 0x29, 0x0a, 0x0f, 0x52, 0x6f, 0x6f, 0x6d, 0x4c,
+	0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x22, 0x2e, 0x0a, 0x12, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f,
+	0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x22, 0x4b, 0x0a, 0x0d, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65,
+	0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x22,
+	0x4a, 0x0a, 0x0c, 0x4a, 0x6f, 0x69, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12,
+	0x3a, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x0b,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x22, 0x35, 0x0a, 0x0c, 0x4a,
+	0x6f, 0x69, 0x6e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0x7a, 0x0a, 0x15, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x0f, 0x68,
+	0x6f, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x0e, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x75, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x70, 0x75, 0x74, 0x79, 0x49, 0x64, 0x22, 0x6f,
+	0x0a, 0x10, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4d, 0x6f, 0x76,
+	0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x5f, 0x0a, 0x0e, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x64, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64,
+	0x22, 0x84, 0x01, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x55,
+	0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x73, 0x74, 0x65,
+	0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x6e,
+	0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x3a, 0x0a, 0x0f, 0x44, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x22, 0x2e, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x57, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x22, 0x51, 0x0a, 0x09, 0x4b, 0x65, 0x70, 0x74, 0x41, 0x6c, 0x69, 0x76, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0xc8, 0x01, 0x0a, 0x15, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x22, 0x8e, 0x02, 0x0a, 0x0e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65,
+	0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
+	0x37, 0x0a, 0x0a, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09, 0x72,
+	0x6f, 0x6f, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x76, 0x6f, 0x74, 0x65,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65,
+	0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0xe1, 0x01, 0x0a, 0x0c, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x32, 0x0a, 0x0b, 0x76, 0x6f,
+	0x74, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f,
+	0x74, 0x65, 0x52, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32,
+	0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f,
+	0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73,
+	0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x22, 0x6a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x63, 0x6f,
+	0x64, 0x65, 0x22, 0xe4, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x32, 0x0a,
+	0x0b, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x69, 0x73, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x69, 0x73, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x22, 0x7d, 0x0a, 0x16, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x56, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e,
 	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
 	0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
 	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x19, 0x0a, 0x17,
-	0x4b, 0x69, 0x63, 0x6b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x38, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x7d, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56, 0x69, 0x65, 0x77,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56, 0x69, 0x65, 0x77, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xde, 0x01, 0x0a,
+	0x16, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x32, 0x0a, 0x15,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x62, 0x61, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x62,
+	0x61, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x61, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x19, 0x0a,
+	0x17, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x76, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72,
+	0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f,
+	0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x38, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x62, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x61, 0x6e, 0x52, 0x04, 0x62, 0x61, 0x6e, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x03, 0x42,
+	0x61, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x62,
+	0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x66,
+	0x74, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72,
+	0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f,
+	0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x15, 0x0a, 0x06, 0x62, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x62, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x69, 0x66, 0x74, 0x42,
+	0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x18, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0b,
+	0x6e, 0x65, 0x77, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x10, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xac,
+	0x01, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x14, 0x0a,
+	0x12, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xca, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x76, 0x61,
+	0x74, 0x61, 0x72, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x53, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x22, 0x57, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
+	0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x0b, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x12, 0x53, 0x65,
+	0x74, 0x50, 0x61, 0x73, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x63, 0x6f, 0x64,
+	0x65, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x63, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x74,
+	0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3b, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x73, 0x74,
+	0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x56, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcd, 0x01, 0x0a,
+	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a,
 	0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
 	0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
 	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x65, 0x77,
-	0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6e, 0x65, 0x77, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x74, 0x0a, 0x0a, 0x43, 0x61, 0x72, 0x64, 0x50, 0x72,
-	0x65, 0x73, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x50, 0x52, 0x45,
-	0x53, 0x45, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x45, 0x54,
-	0x5f, 0x46, 0x49, 0x42, 0x4f, 0x4e, 0x41, 0x43, 0x43, 0x49, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12,
-	0x43, 0x41, 0x52, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x45, 0x54, 0x5f, 0x54, 0x53, 0x48, 0x49,
-	0x52, 0x54, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x50, 0x52, 0x45,
-	0x53, 0x45, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10, 0x06, 0x2a, 0x6f, 0x0a, 0x09,
-	0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x4f, 0x4f,
-	0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x45, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x15, 0x0a,
-	0x11, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x56, 0x4f, 0x54, 0x49,
-	0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x41, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x32, 0xc5, 0x04,
-	0x0a, 0x0b, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a,
-	0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x1d, 0x2e, 0x65, 0x73, 0x74,
-	0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f,
-	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x73, 0x74, 0x65,
-	0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1e, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d,
-	0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d,
-	0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e,
-	0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1c, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4a, 0x0a, 0x09, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1d,
-	0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61,
-	0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
-	0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x76,
-	0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
-	0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1d, 0x2e, 0x65, 0x73, 0x74,
-	0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x6f,
-	0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x65, 0x73, 0x74, 0x65,
-	0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x30, 0x01, 0x12, 0x5c, 0x0a, 0x0f, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x61, 0x72, 0x74, 0x69,
-	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x73,
-	0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x61,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x30, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d,
+	0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x74, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x74, 0x74, 0x6c, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0x75, 0x0a, 0x14,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x22, 0x97, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72,
+	0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f,
+	0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x49, 0x64, 0x22, 0x16, 0x0a,
+	0x14, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x53,
+	0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x57, 0x61, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x18, 0x0a,
+	0x16, 0x53, 0x65, 0x74, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb4, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69,
+	0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x1a,
+	0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb5, 0x01, 0x0a, 0x18, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x34, 0x0a, 0x16,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xc8, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x6f, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x56, 0x6f, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78,
+	0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x1e, 0x0a, 0x1c, 0x53, 0x65,
+	0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcc, 0x01, 0x0a, 0x13, 0x53,
+	0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x74,
+	0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x9e, 0x01, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x46, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x22, 0x20, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x46, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe2, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x44, 0x0a, 0x0f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1b, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f,
+	0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x68, 0x6f,
+	0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x65, 0x70, 0x75, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x65, 0x70, 0x75, 0x74, 0x79, 0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x65, 0x74,
+	0x48, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77, 0x0a, 0x10, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x48,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f,
+	0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x13, 0x0a, 0x11, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77, 0x0a, 0x10, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x32, 0x0a,
+	0x11, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x22, 0x7c, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f,
+	0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x3f, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x6f, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d,
+	0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d,
+	0x22, 0xda, 0x01, 0x0a, 0x15, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f,
+	0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x32, 0x0a, 0x15, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x5f,
+	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62,
+	0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x18, 0x0a,
+	0x16, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x14, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75,
+	0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22,
+	0x3c, 0x0a, 0x15, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x2a, 0x74, 0x0a,
+	0x0a, 0x43, 0x61, 0x72, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x17, 0x43,
+	0x41, 0x52, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x45, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x41, 0x52, 0x44,
+	0x5f, 0x50, 0x52, 0x45, 0x53, 0x45, 0x54, 0x5f, 0x46, 0x49, 0x42, 0x4f, 0x4e, 0x41, 0x43, 0x43,
+	0x49, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x53,
+	0x45, 0x54, 0x5f, 0x54, 0x53, 0x48, 0x49, 0x52, 0x54, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x43,
+	0x41, 0x52, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f,
+	0x4d, 0x10, 0x06, 0x2a, 0x59, 0x0a, 0x08, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x19, 0x0a, 0x15, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x4f,
+	0x4f, 0x4d, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x53, 0x54, 0x49, 0x4d, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x44, 0x4f, 0x54, 0x5f, 0x56, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x2a, 0xad,
+	0x01, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x4e,
+	0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50,
+	0x41, 0x4e, 0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x10, 0x01,
+	0x12, 0x20, 0x0a, 0x1c, 0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x4e, 0x54, 0x5f,
+	0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x46, 0x41, 0x43, 0x49, 0x4c, 0x49, 0x54, 0x41, 0x54, 0x4f, 0x52,
+	0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x4e,
+	0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x52, 0x10, 0x03, 0x12, 0x1e,
+	0x0a, 0x1a, 0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x4e, 0x54, 0x5f, 0x52, 0x4f,
+	0x4c, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x54, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x04, 0x2a, 0x6f,
+	0x0a, 0x09, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x52,
+	0x4f, 0x4f, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x4f, 0x4f, 0x4d, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x15, 0x0a, 0x11, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x56, 0x4f,
+	0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x41, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a,
+	0x88, 0x01, 0x0a, 0x0e, 0x52, 0x6f, 0x6f, 0x6d, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42,
+	0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x56, 0x49, 0x53, 0x49,
+	0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x01, 0x12,
+	0x1c, 0x0a, 0x18, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49,
+	0x54, 0x59, 0x5f, 0x55, 0x4e, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a,
+	0x17, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59,
+	0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x03, 0x2a, 0xba, 0x01, 0x0a, 0x0e, 0x48,
+	0x6f, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x1b, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23,
+	0x0a, 0x1f, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x45, 0x41, 0x52, 0x4c, 0x49, 0x45, 0x53, 0x54, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x45,
+	0x52, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x53, 0x55, 0x43, 0x43,
+	0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x4f,
+	0x52, 0x49, 0x47, 0x49, 0x4e, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x48, 0x4f, 0x53,
+	0x54, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x4e,
+	0x55, 0x41, 0x4c, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x48,
+	0x4f, 0x53, 0x54, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44,
+	0x45, 0x50, 0x55, 0x54, 0x59, 0x10, 0x04, 0x2a, 0x85, 0x01, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4c, 0x4f, 0x53, 0x45,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x01,
+	0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x49, 0x4e, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x02, 0x12, 0x20, 0x0a,
+	0x1c, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x42, 0x52,
+	0x45, 0x41, 0x4b, 0x4f, 0x55, 0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x03, 0x2a,
+	0xfc, 0x01, 0x0a, 0x10, 0x48, 0x6f, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x1e, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x48, 0x4f, 0x53, 0x54,
+	0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54,
+	0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c,
+	0x48, 0x4f, 0x53, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1d,
+	0x0a, 0x19, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x50, 0x55, 0x54, 0x59, 0x10, 0x03, 0x12, 0x1f, 0x0a,
+	0x1b, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1e,
+	0x0a, 0x1a, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x56, 0x41, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1e,
+	0x0a, 0x1a, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x45, 0x44, 0x10, 0x06, 0x2a, 0x83,
+	0x01, 0x0a, 0x0e, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x01, 0x12, 0x1a, 0x0a,
+	0x16, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x52, 0x45, 0x56, 0x45, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x4f, 0x4f,
+	0x4d, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53,
+	0x45, 0x54, 0x10, 0x03, 0x32, 0xb5, 0x16, 0x0a, 0x0b, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x73, 0x12, 0x1d, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1e,
+	0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x47, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1c, 0x2e, 0x65, 0x73,
+	0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f,
+	0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x73, 0x74, 0x65,
+	0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1d, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x6f, 0x6f,
+	0x6d, 0x12, 0x1d, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x6f, 0x6f, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x0e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x65,
+	0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12,
+	0x44, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1b, 0x2e, 0x65, 0x73, 0x74,
+	0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d,
+	0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
+	0x69, 0x65, 0x77, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65,
+	0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x65,
+	0x77, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56, 0x69, 0x65,
+	0x77, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56, 0x69, 0x65, 0x77, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x73,
+	0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x56, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5c, 0x0a, 0x0f, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x73, 0x74, 0x65,
+	0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x47, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x65, 0x73,
+	0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x73, 0x74, 0x65,
+	0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x4c, 0x69, 0x66, 0x74,
+	0x42, 0x61, 0x6e, 0x12, 0x1b, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x66, 0x74, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x66, 0x74, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62,
+	0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x12, 0x25, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x65, 0x73, 0x74,
+	0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12,
+	0x1d, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d,
+	0x0a, 0x0a, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x65,
+	0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65,
+	0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a,
+	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x12, 0x25, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x65, 0x73, 0x74, 0x65,
+	0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
 	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x62, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x25, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
-	0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x69, 0x63, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x65,
-	0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f,
-	0x67, 0x65, 0x6e, 0x2f, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2f, 0x76, 0x31, 0x3b,
-	0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x65, 0x12, 0x50, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x1f, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x21, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d,
+	0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x65, 0x73,
+	0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x53, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x12, 0x20, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x21, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x73, 0x74, 0x65,
+	0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a,
+	0x0e, 0x53, 0x65, 0x74, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f, 0x6d, 0x12,
+	0x22, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69,
+	0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x65,
+	0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x74,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x25,
+	0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a,
+	0x14, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x53, 0x65,
+	0x74, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x2e, 0x65, 0x73, 0x74,
+	0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x74, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65,
+	0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x70,
+	0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x71, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x46, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x65, 0x73, 0x74, 0x65,
+	0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x61, 0x63, 0x69, 0x6c,
+	0x69, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d,
+	0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
+	0x48, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x48,
+	0x6f, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x12,
+	0x1d, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65,
+	0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x65,
+	0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59,
+	0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74,
+	0x12, 0x22, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x4d, 0x6f, 0x76,
+	0x65, 0x54, 0x6f, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x22, 0x2e, 0x65, 0x73,
+	0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f,
+	0x76, 0x65, 0x54, 0x6f, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x21, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x73, 0x74, 0x65, 0x65,
+	0x6d, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x43, 0x5a, 0x41,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x69, 0x63, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x2f, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x73, 0x74, 0x65, 0x65,
+	0x6d, 0x65, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x73, 0x74, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x76,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1787,73 +8055,268 @@ func file_esteemed_v1_room_proto_rawDescGZIP() []byte {
 	return file_esteemed_v1_room_proto_rawDescData
 }
 
-var file_esteemed_v1_room_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_esteemed_v1_room_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_esteemed_v1_room_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_esteemed_v1_room_proto_msgTypes = make([]protoimpl.MessageInfo, 100)
 var file_esteemed_v1_room_proto_goTypes = []any{
-	(CardPreset)(0),                   // 0: esteemed.v1.CardPreset
-	(RoomState)(0),                    // 1: esteemed.v1.RoomState
-	(*Card)(nil),                      // 2: esteemed.v1.Card
-	(*CardConfig)(nil),                // 3: esteemed.v1.CardConfig
-	(*Room)(nil),                      // 4: esteemed.v1.Room
-	(*Participant)(nil),               // 5: esteemed.v1.Participant
-	(*CreateRoomRequest)(nil),         // 6: esteemed.v1.CreateRoomRequest
-	(*CreateRoomResponse)(nil),        // 7: esteemed.v1.CreateRoomResponse
-	(*JoinRoomRequest)(nil),           // 8: esteemed.v1.JoinRoomRequest
-	(*JoinRoomResponse)(nil),          // 9: esteemed.v1.JoinRoomResponse
-	(*LeaveRoomRequest)(nil),          // 10: esteemed.v1.LeaveRoomRequest
-	(*LeaveRoomResponse)(nil),         // 11: esteemed.v1.LeaveRoomResponse
-	(*ListRoomsRequest)(nil),          // 12: esteemed.v1.ListRoomsRequest
-	(*ListRoomsResponse)(nil),         // 13: esteemed.v1.ListRoomsResponse
-	(*RoomSummary)(nil),               // 14: esteemed.v1.RoomSummary
-	(*WatchRoomRequest)(nil),          // 15: esteemed.v1.WatchRoomRequest
-	(*RoomEvent)(nil),                 // 16: esteemed.v1.RoomEvent
-	(*ParticipantJoined)(nil),         // 17: esteemed.v1.ParticipantJoined
-	(*ParticipantLeft)(nil),           // 18: esteemed.v1.ParticipantLeft
-	(*RoomStateChanged)(nil),          // 19: esteemed.v1.RoomStateChanged
-	(*RoomClosed)(nil),                // 20: esteemed.v1.RoomClosed
-	(*HostChanged)(nil),               // 21: esteemed.v1.HostChanged
-	(*KickParticipantRequest)(nil),    // 22: esteemed.v1.KickParticipantRequest
-	(*KickParticipantResponse)(nil),   // 23: esteemed.v1.KickParticipantResponse
-	(*TransferOwnershipRequest)(nil),  // 24: esteemed.v1.TransferOwnershipRequest
-	(*TransferOwnershipResponse)(nil), // 25: esteemed.v1.TransferOwnershipResponse
+	(CardPreset)(0),                        // 0: esteemed.v1.CardPreset
+	(RoomMode)(0),                          // 1: esteemed.v1.RoomMode
+	(ParticipantRole)(0),                   // 2: esteemed.v1.ParticipantRole
+	(RoomState)(0),                         // 3: esteemed.v1.RoomState
+	(RoomVisibility)(0),                    // 4: esteemed.v1.RoomVisibility
+	(HostSuccession)(0),                    // 5: esteemed.v1.HostSuccession
+	(CloseReason)(0),                       // 6: esteemed.v1.CloseReason
+	(HostChangeReason)(0),                  // 7: esteemed.v1.HostChangeReason
+	(RoomTransition)(0),                    // 8: esteemed.v1.RoomTransition
+	(*Card)(nil),                           // 9: esteemed.v1.Card
+	(*CardConfig)(nil),                     // 10: esteemed.v1.CardConfig
+	(*DotVoteConfig)(nil),                  // 11: esteemed.v1.DotVoteConfig
+	(*Room)(nil),                           // 12: esteemed.v1.Room
+	(*Participant)(nil),                    // 13: esteemed.v1.Participant
+	(*CreateRoomRequest)(nil),              // 14: esteemed.v1.CreateRoomRequest
+	(*CreateRoomResponse)(nil),             // 15: esteemed.v1.CreateRoomResponse
+	(*JoinRoomRequest)(nil),                // 16: esteemed.v1.JoinRoomRequest
+	(*JoinRoomResponse)(nil),               // 17: esteemed.v1.JoinRoomResponse
+	(*LeaveRoomRequest)(nil),               // 18: esteemed.v1.LeaveRoomRequest
+	(*LeaveRoomResponse)(nil),              // 19: esteemed.v1.LeaveRoomResponse
+	(*ListRoomsRequest)(nil),               // 20: esteemed.v1.ListRoomsRequest
+	(*ListRoomsResponse)(nil),              // 21: esteemed.v1.ListRoomsResponse
+	(*RoomSummary)(nil),                    // 22: esteemed.v1.RoomSummary
+	(*WatchRoomRequest)(nil),               // 23: esteemed.v1.WatchRoomRequest
+	(*RoomEvent)(nil),                      // 24: esteemed.v1.RoomEvent
+	(*Resync)(nil),                         // 25: esteemed.v1.Resync
+	(*Heartbeat)(nil),                      // 26: esteemed.v1.Heartbeat
+	(*ParticipantJoined)(nil),              // 27: esteemed.v1.ParticipantJoined
+	(*ParticipantLeft)(nil),                // 28: esteemed.v1.ParticipantLeft
+	(*RoomStateChanged)(nil),               // 29: esteemed.v1.RoomStateChanged
+	(*RoomClosed)(nil),                     // 30: esteemed.v1.RoomClosed
+	(*HostChanged)(nil),                    // 31: esteemed.v1.HostChanged
+	(*ParticipantUpdated)(nil),             // 32: esteemed.v1.ParticipantUpdated
+	(*FacilitatorRotationChanged)(nil),     // 33: esteemed.v1.FacilitatorRotationChanged
+	(*VisibilityChanged)(nil),              // 34: esteemed.v1.VisibilityChanged
+	(*PasscodeChanged)(nil),                // 35: esteemed.v1.PasscodeChanged
+	(*ParticipantRemoved)(nil),             // 36: esteemed.v1.ParticipantRemoved
+	(*ParticipantLimitsChanged)(nil),       // 37: esteemed.v1.ParticipantLimitsChanged
+	(*ViewTokenRevoked)(nil),               // 38: esteemed.v1.ViewTokenRevoked
+	(*RoomLockChanged)(nil),                // 39: esteemed.v1.RoomLockChanged
+	(*WaitingRoomChanged)(nil),             // 40: esteemed.v1.WaitingRoomChanged
+	(*JoinRequested)(nil),                  // 41: esteemed.v1.JoinRequested
+	(*JoinAdmitted)(nil),                   // 42: esteemed.v1.JoinAdmitted
+	(*JoinRejected)(nil),                   // 43: esteemed.v1.JoinRejected
+	(*HostSuccessionChanged)(nil),          // 44: esteemed.v1.HostSuccessionChanged
+	(*ParticipantMoved)(nil),               // 45: esteemed.v1.ParticipantMoved
+	(*BreakoutClosed)(nil),                 // 46: esteemed.v1.BreakoutClosed
+	(*TransitionUndone)(nil),               // 47: esteemed.v1.TransitionUndone
+	(*DeadlineChanged)(nil),                // 48: esteemed.v1.DeadlineChanged
+	(*ExpiryWarning)(nil),                  // 49: esteemed.v1.ExpiryWarning
+	(*KeptAlive)(nil),                      // 50: esteemed.v1.KeptAlive
+	(*WatchRoomStateRequest)(nil),          // 51: esteemed.v1.WatchRoomStateRequest
+	(*RoomStateEvent)(nil),                 // 52: esteemed.v1.RoomStateEvent
+	(*RoomSnapshot)(nil),                   // 53: esteemed.v1.RoomSnapshot
+	(*GetRoomRequest)(nil),                 // 54: esteemed.v1.GetRoomRequest
+	(*GetRoomResponse)(nil),                // 55: esteemed.v1.GetRoomResponse
+	(*CreateViewTokenRequest)(nil),         // 56: esteemed.v1.CreateViewTokenRequest
+	(*CreateViewTokenResponse)(nil),        // 57: esteemed.v1.CreateViewTokenResponse
+	(*RevokeViewTokenRequest)(nil),         // 58: esteemed.v1.RevokeViewTokenRequest
+	(*RevokeViewTokenResponse)(nil),        // 59: esteemed.v1.RevokeViewTokenResponse
+	(*KickParticipantRequest)(nil),         // 60: esteemed.v1.KickParticipantRequest
+	(*KickParticipantResponse)(nil),        // 61: esteemed.v1.KickParticipantResponse
+	(*ListBansRequest)(nil),                // 62: esteemed.v1.ListBansRequest
+	(*ListBansResponse)(nil),               // 63: esteemed.v1.ListBansResponse
+	(*Ban)(nil),                            // 64: esteemed.v1.Ban
+	(*LiftBanRequest)(nil),                 // 65: esteemed.v1.LiftBanRequest
+	(*LiftBanResponse)(nil),                // 66: esteemed.v1.LiftBanResponse
+	(*TransferOwnershipRequest)(nil),       // 67: esteemed.v1.TransferOwnershipRequest
+	(*TransferOwnershipResponse)(nil),      // 68: esteemed.v1.TransferOwnershipResponse
+	(*GrantRoleRequest)(nil),               // 69: esteemed.v1.GrantRoleRequest
+	(*GrantRoleResponse)(nil),              // 70: esteemed.v1.GrantRoleResponse
+	(*RevokeRoleRequest)(nil),              // 71: esteemed.v1.RevokeRoleRequest
+	(*RevokeRoleResponse)(nil),             // 72: esteemed.v1.RevokeRoleResponse
+	(*UpdateParticipantRequest)(nil),       // 73: esteemed.v1.UpdateParticipantRequest
+	(*UpdateParticipantResponse)(nil),      // 74: esteemed.v1.UpdateParticipantResponse
+	(*SetPasscodeRequest)(nil),             // 75: esteemed.v1.SetPasscodeRequest
+	(*SetPasscodeResponse)(nil),            // 76: esteemed.v1.SetPasscodeResponse
+	(*SetVisibilityRequest)(nil),           // 77: esteemed.v1.SetVisibilityRequest
+	(*SetVisibilityResponse)(nil),          // 78: esteemed.v1.SetVisibilityResponse
+	(*CreateInviteRequest)(nil),            // 79: esteemed.v1.CreateInviteRequest
+	(*CreateInviteResponse)(nil),           // 80: esteemed.v1.CreateInviteResponse
+	(*RevokeInviteRequest)(nil),            // 81: esteemed.v1.RevokeInviteRequest
+	(*RevokeInviteResponse)(nil),           // 82: esteemed.v1.RevokeInviteResponse
+	(*SetRoomLockedRequest)(nil),           // 83: esteemed.v1.SetRoomLockedRequest
+	(*SetRoomLockedResponse)(nil),          // 84: esteemed.v1.SetRoomLockedResponse
+	(*SetWaitingRoomRequest)(nil),          // 85: esteemed.v1.SetWaitingRoomRequest
+	(*SetWaitingRoomResponse)(nil),         // 86: esteemed.v1.SetWaitingRoomResponse
+	(*AdmitParticipantRequest)(nil),        // 87: esteemed.v1.AdmitParticipantRequest
+	(*AdmitParticipantResponse)(nil),       // 88: esteemed.v1.AdmitParticipantResponse
+	(*RejectParticipantRequest)(nil),       // 89: esteemed.v1.RejectParticipantRequest
+	(*RejectParticipantResponse)(nil),      // 90: esteemed.v1.RejectParticipantResponse
+	(*SetParticipantLimitsRequest)(nil),    // 91: esteemed.v1.SetParticipantLimitsRequest
+	(*SetParticipantLimitsResponse)(nil),   // 92: esteemed.v1.SetParticipantLimitsResponse
+	(*SetSpectatorRequest)(nil),            // 93: esteemed.v1.SetSpectatorRequest
+	(*SetSpectatorResponse)(nil),           // 94: esteemed.v1.SetSpectatorResponse
+	(*SetFacilitatorRotationRequest)(nil),  // 95: esteemed.v1.SetFacilitatorRotationRequest
+	(*SetFacilitatorRotationResponse)(nil), // 96: esteemed.v1.SetFacilitatorRotationResponse
+	(*SetHostSuccessionRequest)(nil),       // 97: esteemed.v1.SetHostSuccessionRequest
+	(*SetHostSuccessionResponse)(nil),      // 98: esteemed.v1.SetHostSuccessionResponse
+	(*ClaimHostRequest)(nil),               // 99: esteemed.v1.ClaimHostRequest
+	(*ClaimHostResponse)(nil),              // 100: esteemed.v1.ClaimHostResponse
+	(*KeepAliveRequest)(nil),               // 101: esteemed.v1.KeepAliveRequest
+	(*KeepAliveResponse)(nil),              // 102: esteemed.v1.KeepAliveResponse
+	(*CreateBreakoutRequest)(nil),          // 103: esteemed.v1.CreateBreakoutRequest
+	(*CreateBreakoutResponse)(nil),         // 104: esteemed.v1.CreateBreakoutResponse
+	(*MoveToBreakoutRequest)(nil),          // 105: esteemed.v1.MoveToBreakoutRequest
+	(*MoveToBreakoutResponse)(nil),         // 106: esteemed.v1.MoveToBreakoutResponse
+	(*CloseBreakoutRequest)(nil),           // 107: esteemed.v1.CloseBreakoutRequest
+	(*CloseBreakoutResponse)(nil),          // 108: esteemed.v1.CloseBreakoutResponse
+	(*VoteEvent)(nil),                      // 109: esteemed.v1.VoteEvent
+	(*Vote)(nil),                           // 110: esteemed.v1.Vote
+	(*VoteSummary)(nil),                    // 111: esteemed.v1.VoteSummary
 }
 var file_esteemed_v1_room_proto_depIdxs = []int32{
-	0,  // 0: esteemed.v1.CardConfig.preset:type_name -> esteemed.v1.CardPreset
-	2,  // 1: esteemed.v1.CardConfig.cards:type_name -> esteemed.v1.Card
-	5,  // 2: esteemed.v1.Room.participants:type_name -> esteemed.v1.Participant
-	1,  // 3: esteemed.v1.Room.state:type_name -> esteemed.v1.RoomState
-	3,  // 4: esteemed.v1.Room.card_config:type_name -> esteemed.v1.CardConfig
-	3,  // 5: esteemed.v1.CreateRoomRequest.card_config:type_name -> esteemed.v1.CardConfig
-	4,  // 6: esteemed.v1.CreateRoomResponse.room:type_name -> esteemed.v1.Room
-	4,  // 7: esteemed.v1.JoinRoomResponse.room:type_name -> esteemed.v1.Room
-	14, // 8: esteemed.v1.ListRoomsResponse.rooms:type_name -> esteemed.v1.RoomSummary
-	1,  // 9: esteemed.v1.RoomSummary.state:type_name -> esteemed.v1.RoomState
-	17, // 10: esteemed.v1.RoomEvent.participant_joined:type_name -> esteemed.v1.ParticipantJoined
-	18, // 11: esteemed.v1.RoomEvent.participant_left:type_name -> esteemed.v1.ParticipantLeft
-	19, // 12: esteemed.v1.RoomEvent.state_changed:type_name -> esteemed.v1.RoomStateChanged
-	20, // 13: esteemed.v1.RoomEvent.room_closed:type_name -> esteemed.v1.RoomClosed
-	21, // 14: esteemed.v1.RoomEvent.host_changed:type_name -> esteemed.v1.HostChanged
-	5,  // 15: esteemed.v1.ParticipantJoined.participant:type_name -> esteemed.v1.Participant
-	1,  // 16: esteemed.v1.RoomStateChanged.new_state:type_name -> esteemed.v1.RoomState
-	12, // 17: esteemed.v1.RoomService.ListRooms:input_type -> esteemed.v1.ListRoomsRequest
-	6,  // 18: esteemed.v1.RoomService.CreateRoom:input_type -> esteemed.v1.CreateRoomRequest
-	8,  // 19: esteemed.v1.RoomService.JoinRoom:input_type -> esteemed.v1.JoinRoomRequest
-	10, // 20: esteemed.v1.RoomService.LeaveRoom:input_type -> esteemed.v1.LeaveRoomRequest
-	15, // 21: esteemed.v1.RoomService.WatchRoom:input_type -> esteemed.v1.WatchRoomRequest
-	22, // 22: esteemed.v1.RoomService.KickParticipant:input_type -> esteemed.v1.KickParticipantRequest
-	24, // 23: esteemed.v1.RoomService.TransferOwnership:input_type -> esteemed.v1.TransferOwnershipRequest
-	13, // 24: esteemed.v1.RoomService.ListRooms:output_type -> esteemed.v1.ListRoomsResponse
-	7,  // 25: esteemed.v1.RoomService.CreateRoom:output_type -> esteemed.v1.CreateRoomResponse
-	9,  // 26: esteemed.v1.RoomService.JoinRoom:output_type -> esteemed.v1.JoinRoomResponse
-	11, // 27: esteemed.v1.RoomService.LeaveRoom:output_type -> esteemed.v1.LeaveRoomResponse
-	16, // 28: esteemed.v1.RoomService.WatchRoom:output_type -> esteemed.v1.RoomEvent
-	23, // 29: esteemed.v1.RoomService.KickParticipant:output_type -> esteemed.v1.KickParticipantResponse
-	25, // 30: esteemed.v1.RoomService.TransferOwnership:output_type -> esteemed.v1.TransferOwnershipResponse
-	24, // [24:31] is the sub-list for method output_type
-	17, // [17:24] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	0,   // 0: esteemed.v1.CardConfig.preset:type_name -> esteemed.v1.CardPreset
+	9,   // 1: esteemed.v1.CardConfig.cards:type_name -> esteemed.v1.Card
+	13,  // 2: esteemed.v1.Room.participants:type_name -> esteemed.v1.Participant
+	3,   // 3: esteemed.v1.Room.state:type_name -> esteemed.v1.RoomState
+	10,  // 4: esteemed.v1.Room.card_config:type_name -> esteemed.v1.CardConfig
+	1,   // 5: esteemed.v1.Room.mode:type_name -> esteemed.v1.RoomMode
+	11,  // 6: esteemed.v1.Room.dot_vote_config:type_name -> esteemed.v1.DotVoteConfig
+	5,   // 7: esteemed.v1.Room.host_succession:type_name -> esteemed.v1.HostSuccession
+	4,   // 8: esteemed.v1.Room.visibility:type_name -> esteemed.v1.RoomVisibility
+	13,  // 9: esteemed.v1.Room.pending_participants:type_name -> esteemed.v1.Participant
+	2,   // 10: esteemed.v1.Participant.role:type_name -> esteemed.v1.ParticipantRole
+	10,  // 11: esteemed.v1.CreateRoomRequest.card_config:type_name -> esteemed.v1.CardConfig
+	1,   // 12: esteemed.v1.CreateRoomRequest.mode:type_name -> esteemed.v1.RoomMode
+	11,  // 13: esteemed.v1.CreateRoomRequest.dot_vote_config:type_name -> esteemed.v1.DotVoteConfig
+	5,   // 14: esteemed.v1.CreateRoomRequest.host_succession:type_name -> esteemed.v1.HostSuccession
+	4,   // 15: esteemed.v1.CreateRoomRequest.visibility:type_name -> esteemed.v1.RoomVisibility
+	12,  // 16: esteemed.v1.CreateRoomResponse.room:type_name -> esteemed.v1.Room
+	12,  // 17: esteemed.v1.JoinRoomResponse.room:type_name -> esteemed.v1.Room
+	22,  // 18: esteemed.v1.ListRoomsResponse.rooms:type_name -> esteemed.v1.RoomSummary
+	3,   // 19: esteemed.v1.RoomSummary.state:type_name -> esteemed.v1.RoomState
+	27,  // 20: esteemed.v1.RoomEvent.participant_joined:type_name -> esteemed.v1.ParticipantJoined
+	28,  // 21: esteemed.v1.RoomEvent.participant_left:type_name -> esteemed.v1.ParticipantLeft
+	29,  // 22: esteemed.v1.RoomEvent.state_changed:type_name -> esteemed.v1.RoomStateChanged
+	30,  // 23: esteemed.v1.RoomEvent.room_closed:type_name -> esteemed.v1.RoomClosed
+	31,  // 24: esteemed.v1.RoomEvent.host_changed:type_name -> esteemed.v1.HostChanged
+	45,  // 25: esteemed.v1.RoomEvent.participant_moved:type_name -> esteemed.v1.ParticipantMoved
+	46,  // 26: esteemed.v1.RoomEvent.breakout_closed:type_name -> esteemed.v1.BreakoutClosed
+	47,  // 27: esteemed.v1.RoomEvent.transition_undone:type_name -> esteemed.v1.TransitionUndone
+	48,  // 28: esteemed.v1.RoomEvent.deadline_changed:type_name -> esteemed.v1.DeadlineChanged
+	49,  // 29: esteemed.v1.RoomEvent.expiry_warning:type_name -> esteemed.v1.ExpiryWarning
+	50,  // 30: esteemed.v1.RoomEvent.kept_alive:type_name -> esteemed.v1.KeptAlive
+	44,  // 31: esteemed.v1.RoomEvent.host_succession_changed:type_name -> esteemed.v1.HostSuccessionChanged
+	32,  // 32: esteemed.v1.RoomEvent.participant_updated:type_name -> esteemed.v1.ParticipantUpdated
+	33,  // 33: esteemed.v1.RoomEvent.facilitator_rotation_changed:type_name -> esteemed.v1.FacilitatorRotationChanged
+	26,  // 34: esteemed.v1.RoomEvent.heartbeat:type_name -> esteemed.v1.Heartbeat
+	34,  // 35: esteemed.v1.RoomEvent.visibility_changed:type_name -> esteemed.v1.VisibilityChanged
+	35,  // 36: esteemed.v1.RoomEvent.passcode_changed:type_name -> esteemed.v1.PasscodeChanged
+	39,  // 37: esteemed.v1.RoomEvent.lock_changed:type_name -> esteemed.v1.RoomLockChanged
+	40,  // 38: esteemed.v1.RoomEvent.waiting_room_changed:type_name -> esteemed.v1.WaitingRoomChanged
+	41,  // 39: esteemed.v1.RoomEvent.join_requested:type_name -> esteemed.v1.JoinRequested
+	42,  // 40: esteemed.v1.RoomEvent.join_admitted:type_name -> esteemed.v1.JoinAdmitted
+	43,  // 41: esteemed.v1.RoomEvent.join_rejected:type_name -> esteemed.v1.JoinRejected
+	36,  // 42: esteemed.v1.RoomEvent.participant_removed:type_name -> esteemed.v1.ParticipantRemoved
+	37,  // 43: esteemed.v1.RoomEvent.participant_limits_changed:type_name -> esteemed.v1.ParticipantLimitsChanged
+	38,  // 44: esteemed.v1.RoomEvent.view_token_revoked:type_name -> esteemed.v1.ViewTokenRevoked
+	25,  // 45: esteemed.v1.RoomEvent.resync:type_name -> esteemed.v1.Resync
+	53,  // 46: esteemed.v1.Resync.snapshot:type_name -> esteemed.v1.RoomSnapshot
+	13,  // 47: esteemed.v1.ParticipantJoined.participant:type_name -> esteemed.v1.Participant
+	3,   // 48: esteemed.v1.RoomStateChanged.new_state:type_name -> esteemed.v1.RoomState
+	6,   // 49: esteemed.v1.RoomClosed.close_reason:type_name -> esteemed.v1.CloseReason
+	7,   // 50: esteemed.v1.HostChanged.reason:type_name -> esteemed.v1.HostChangeReason
+	13,  // 51: esteemed.v1.ParticipantUpdated.participant:type_name -> esteemed.v1.Participant
+	4,   // 52: esteemed.v1.VisibilityChanged.visibility:type_name -> esteemed.v1.RoomVisibility
+	13,  // 53: esteemed.v1.JoinRequested.participant:type_name -> esteemed.v1.Participant
+	13,  // 54: esteemed.v1.JoinAdmitted.participant:type_name -> esteemed.v1.Participant
+	5,   // 55: esteemed.v1.HostSuccessionChanged.host_succession:type_name -> esteemed.v1.HostSuccession
+	8,   // 56: esteemed.v1.TransitionUndone.transition:type_name -> esteemed.v1.RoomTransition
+	3,   // 57: esteemed.v1.TransitionUndone.new_state:type_name -> esteemed.v1.RoomState
+	53,  // 58: esteemed.v1.RoomStateEvent.snapshot:type_name -> esteemed.v1.RoomSnapshot
+	24,  // 59: esteemed.v1.RoomStateEvent.room_event:type_name -> esteemed.v1.RoomEvent
+	109, // 60: esteemed.v1.RoomStateEvent.vote_event:type_name -> esteemed.v1.VoteEvent
+	12,  // 61: esteemed.v1.RoomSnapshot.room:type_name -> esteemed.v1.Room
+	110, // 62: esteemed.v1.RoomSnapshot.vote_status:type_name -> esteemed.v1.Vote
+	111, // 63: esteemed.v1.RoomSnapshot.summary:type_name -> esteemed.v1.VoteSummary
+	12,  // 64: esteemed.v1.GetRoomResponse.room:type_name -> esteemed.v1.Room
+	110, // 65: esteemed.v1.GetRoomResponse.vote_status:type_name -> esteemed.v1.Vote
+	111, // 66: esteemed.v1.GetRoomResponse.summary:type_name -> esteemed.v1.VoteSummary
+	64,  // 67: esteemed.v1.ListBansResponse.bans:type_name -> esteemed.v1.Ban
+	2,   // 68: esteemed.v1.GrantRoleRequest.role:type_name -> esteemed.v1.ParticipantRole
+	13,  // 69: esteemed.v1.UpdateParticipantResponse.participant:type_name -> esteemed.v1.Participant
+	4,   // 70: esteemed.v1.SetVisibilityRequest.visibility:type_name -> esteemed.v1.RoomVisibility
+	2,   // 71: esteemed.v1.CreateInviteRequest.role:type_name -> esteemed.v1.ParticipantRole
+	5,   // 72: esteemed.v1.SetHostSuccessionRequest.host_succession:type_name -> esteemed.v1.HostSuccession
+	12,  // 73: esteemed.v1.CreateBreakoutResponse.room:type_name -> esteemed.v1.Room
+	20,  // 74: esteemed.v1.RoomService.ListRooms:input_type -> esteemed.v1.ListRoomsRequest
+	14,  // 75: esteemed.v1.RoomService.CreateRoom:input_type -> esteemed.v1.CreateRoomRequest
+	16,  // 76: esteemed.v1.RoomService.JoinRoom:input_type -> esteemed.v1.JoinRoomRequest
+	18,  // 77: esteemed.v1.RoomService.LeaveRoom:input_type -> esteemed.v1.LeaveRoomRequest
+	23,  // 78: esteemed.v1.RoomService.WatchRoom:input_type -> esteemed.v1.WatchRoomRequest
+	51,  // 79: esteemed.v1.RoomService.WatchRoomState:input_type -> esteemed.v1.WatchRoomStateRequest
+	54,  // 80: esteemed.v1.RoomService.GetRoom:input_type -> esteemed.v1.GetRoomRequest
+	56,  // 81: esteemed.v1.RoomService.CreateViewToken:input_type -> esteemed.v1.CreateViewTokenRequest
+	58,  // 82: esteemed.v1.RoomService.RevokeViewToken:input_type -> esteemed.v1.RevokeViewTokenRequest
+	60,  // 83: esteemed.v1.RoomService.KickParticipant:input_type -> esteemed.v1.KickParticipantRequest
+	62,  // 84: esteemed.v1.RoomService.ListBans:input_type -> esteemed.v1.ListBansRequest
+	65,  // 85: esteemed.v1.RoomService.LiftBan:input_type -> esteemed.v1.LiftBanRequest
+	67,  // 86: esteemed.v1.RoomService.TransferOwnership:input_type -> esteemed.v1.TransferOwnershipRequest
+	69,  // 87: esteemed.v1.RoomService.GrantRole:input_type -> esteemed.v1.GrantRoleRequest
+	71,  // 88: esteemed.v1.RoomService.RevokeRole:input_type -> esteemed.v1.RevokeRoleRequest
+	73,  // 89: esteemed.v1.RoomService.UpdateParticipant:input_type -> esteemed.v1.UpdateParticipantRequest
+	75,  // 90: esteemed.v1.RoomService.SetPasscode:input_type -> esteemed.v1.SetPasscodeRequest
+	77,  // 91: esteemed.v1.RoomService.SetVisibility:input_type -> esteemed.v1.SetVisibilityRequest
+	79,  // 92: esteemed.v1.RoomService.CreateInvite:input_type -> esteemed.v1.CreateInviteRequest
+	81,  // 93: esteemed.v1.RoomService.RevokeInvite:input_type -> esteemed.v1.RevokeInviteRequest
+	83,  // 94: esteemed.v1.RoomService.SetRoomLocked:input_type -> esteemed.v1.SetRoomLockedRequest
+	85,  // 95: esteemed.v1.RoomService.SetWaitingRoom:input_type -> esteemed.v1.SetWaitingRoomRequest
+	87,  // 96: esteemed.v1.RoomService.AdmitParticipant:input_type -> esteemed.v1.AdmitParticipantRequest
+	89,  // 97: esteemed.v1.RoomService.RejectParticipant:input_type -> esteemed.v1.RejectParticipantRequest
+	91,  // 98: esteemed.v1.RoomService.SetParticipantLimits:input_type -> esteemed.v1.SetParticipantLimitsRequest
+	93,  // 99: esteemed.v1.RoomService.SetSpectator:input_type -> esteemed.v1.SetSpectatorRequest
+	95,  // 100: esteemed.v1.RoomService.SetFacilitatorRotation:input_type -> esteemed.v1.SetFacilitatorRotationRequest
+	97,  // 101: esteemed.v1.RoomService.SetHostSuccession:input_type -> esteemed.v1.SetHostSuccessionRequest
+	99,  // 102: esteemed.v1.RoomService.ClaimHost:input_type -> esteemed.v1.ClaimHostRequest
+	101, // 103: esteemed.v1.RoomService.KeepAlive:input_type -> esteemed.v1.KeepAliveRequest
+	103, // 104: esteemed.v1.RoomService.CreateBreakout:input_type -> esteemed.v1.CreateBreakoutRequest
+	105, // 105: esteemed.v1.RoomService.MoveToBreakout:input_type -> esteemed.v1.MoveToBreakoutRequest
+	107, // 106: esteemed.v1.RoomService.CloseBreakout:input_type -> esteemed.v1.CloseBreakoutRequest
+	21,  // 107: esteemed.v1.RoomService.ListRooms:output_type -> esteemed.v1.ListRoomsResponse
+	15,  // 108: esteemed.v1.RoomService.CreateRoom:output_type -> esteemed.v1.CreateRoomResponse
+	17,  // 109: esteemed.v1.RoomService.JoinRoom:output_type -> esteemed.v1.JoinRoomResponse
+	19,  // 110: esteemed.v1.RoomService.LeaveRoom:output_type -> esteemed.v1.LeaveRoomResponse
+	24,  // 111: esteemed.v1.RoomService.WatchRoom:output_type -> esteemed.v1.RoomEvent
+	52,  // 112: esteemed.v1.RoomService.WatchRoomState:output_type -> esteemed.v1.RoomStateEvent
+	55,  // 113: esteemed.v1.RoomService.GetRoom:output_type -> esteemed.v1.GetRoomResponse
+	57,  // 114: esteemed.v1.RoomService.CreateViewToken:output_type -> esteemed.v1.CreateViewTokenResponse
+	59,  // 115: esteemed.v1.RoomService.RevokeViewToken:output_type -> esteemed.v1.RevokeViewTokenResponse
+	61,  // 116: esteemed.v1.RoomService.KickParticipant:output_type -> esteemed.v1.KickParticipantResponse
+	63,  // 117: esteemed.v1.RoomService.ListBans:output_type -> esteemed.v1.ListBansResponse
+	66,  // 118: esteemed.v1.RoomService.LiftBan:output_type -> esteemed.v1.LiftBanResponse
+	68,  // 119: esteemed.v1.RoomService.TransferOwnership:output_type -> esteemed.v1.TransferOwnershipResponse
+	70,  // 120: esteemed.v1.RoomService.GrantRole:output_type -> esteemed.v1.GrantRoleResponse
+	72,  // 121: esteemed.v1.RoomService.RevokeRole:output_type -> esteemed.v1.RevokeRoleResponse
+	74,  // 122: esteemed.v1.RoomService.UpdateParticipant:output_type -> esteemed.v1.UpdateParticipantResponse
+	76,  // 123: esteemed.v1.RoomService.SetPasscode:output_type -> esteemed.v1.SetPasscodeResponse
+	78,  // 124: esteemed.v1.RoomService.SetVisibility:output_type -> esteemed.v1.SetVisibilityResponse
+	80,  // 125: esteemed.v1.RoomService.CreateInvite:output_type -> esteemed.v1.CreateInviteResponse
+	82,  // 126: esteemed.v1.RoomService.RevokeInvite:output_type -> esteemed.v1.RevokeInviteResponse
+	84,  // 127: esteemed.v1.RoomService.SetRoomLocked:output_type -> esteemed.v1.SetRoomLockedResponse
+	86,  // 128: esteemed.v1.RoomService.SetWaitingRoom:output_type -> esteemed.v1.SetWaitingRoomResponse
+	88,  // 129: esteemed.v1.RoomService.AdmitParticipant:output_type -> esteemed.v1.AdmitParticipantResponse
+	90,  // 130: esteemed.v1.RoomService.RejectParticipant:output_type -> esteemed.v1.RejectParticipantResponse
+	92,  // 131: esteemed.v1.RoomService.SetParticipantLimits:output_type -> esteemed.v1.SetParticipantLimitsResponse
+	94,  // 132: esteemed.v1.RoomService.SetSpectator:output_type -> esteemed.v1.SetSpectatorResponse
+	96,  // 133: esteemed.v1.RoomService.SetFacilitatorRotation:output_type -> esteemed.v1.SetFacilitatorRotationResponse
+	98,  // 134: esteemed.v1.RoomService.SetHostSuccession:output_type -> esteemed.v1.SetHostSuccessionResponse
+	100, // 135: esteemed.v1.RoomService.ClaimHost:output_type -> esteemed.v1.ClaimHostResponse
+	102, // 136: esteemed.v1.RoomService.KeepAlive:output_type -> esteemed.v1.KeepAliveResponse
+	104, // 137: esteemed.v1.RoomService.CreateBreakout:output_type -> esteemed.v1.CreateBreakoutResponse
+	106, // 138: esteemed.v1.RoomService.MoveToBreakout:output_type -> esteemed.v1.MoveToBreakoutResponse
+	108, // 139: esteemed.v1.RoomService.CloseBreakout:output_type -> esteemed.v1.CloseBreakoutResponse
+	107, // [107:140] is the sub-list for method output_type
+	74,  // [74:107] is the sub-list for method input_type
+	74,  // [74:74] is the sub-list for extension type_name
+	74,  // [74:74] is the sub-list for extension extendee
+	0,   // [0:74] is the sub-list for field type_name
 }
 
 func init() { file_esteemed_v1_room_proto_init() }
@@ -1861,20 +8324,47 @@ func file_esteemed_v1_room_proto_init() {
 	if File_esteemed_v1_room_proto != nil {
 		return
 	}
-	file_esteemed_v1_room_proto_msgTypes[14].OneofWrappers = []any{
+	file_esteemed_v1_estimation_proto_init()
+	file_esteemed_v1_room_proto_msgTypes[15].OneofWrappers = []any{
 		(*RoomEvent_ParticipantJoined)(nil),
 		(*RoomEvent_ParticipantLeft)(nil),
 		(*RoomEvent_StateChanged)(nil),
 		(*RoomEvent_RoomClosed)(nil),
 		(*RoomEvent_HostChanged)(nil),
+		(*RoomEvent_ParticipantMoved)(nil),
+		(*RoomEvent_BreakoutClosed)(nil),
+		(*RoomEvent_TransitionUndone)(nil),
+		(*RoomEvent_DeadlineChanged)(nil),
+		(*RoomEvent_ExpiryWarning)(nil),
+		(*RoomEvent_KeptAlive)(nil),
+		(*RoomEvent_HostSuccessionChanged)(nil),
+		(*RoomEvent_ParticipantUpdated)(nil),
+		(*RoomEvent_FacilitatorRotationChanged)(nil),
+		(*RoomEvent_Heartbeat)(nil),
+		(*RoomEvent_VisibilityChanged)(nil),
+		(*RoomEvent_PasscodeChanged)(nil),
+		(*RoomEvent_LockChanged)(nil),
+		(*RoomEvent_WaitingRoomChanged)(nil),
+		(*RoomEvent_JoinRequested)(nil),
+		(*RoomEvent_JoinAdmitted)(nil),
+		(*RoomEvent_JoinRejected)(nil),
+		(*RoomEvent_ParticipantRemoved)(nil),
+		(*RoomEvent_ParticipantLimitsChanged)(nil),
+		(*RoomEvent_ViewTokenRevoked)(nil),
+		(*RoomEvent_Resync)(nil),
+	}
+	file_esteemed_v1_room_proto_msgTypes[43].OneofWrappers = []any{
+		(*RoomStateEvent_Snapshot)(nil),
+		(*RoomStateEvent_RoomEvent)(nil),
+		(*RoomStateEvent_VoteEvent)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_esteemed_v1_room_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   24,
+			NumEnums:      9,
+			NumMessages:   100,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
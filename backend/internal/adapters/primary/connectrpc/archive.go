@@ -0,0 +1,147 @@
+package connectrpc
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+
+	esteemedv1 "github.com/vicmanager/esteemed/backend/gen/esteemed/v1"
+	"github.com/vicmanager/esteemed/backend/gen/esteemed/v1/esteemedv1connect"
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+	"github.com/vicmanager/esteemed/backend/internal/ports/primary"
+)
+
+// ArchiveHandler implements the ConnectRPC ArchiveService
+type ArchiveHandler struct {
+	service primary.ArchiveService
+}
+
+// NewArchiveHandler creates a new archive handler
+func NewArchiveHandler(service primary.ArchiveService) *ArchiveHandler {
+	return &ArchiveHandler{service: service}
+}
+
+// Handler returns the ConnectRPC handler
+func (h *ArchiveHandler) Handler() (string, http.Handler) {
+	return esteemedv1connect.NewArchiveServiceHandler(h)
+}
+
+// ListArchivedSessions returns the caller's closed sessions, most recently closed first
+func (h *ArchiveHandler) ListArchivedSessions(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.ListArchivedSessionsRequest],
+) (*connect.Response[esteemedv1.ListArchivedSessionsResponse], error) {
+	filter := domain.ArchiveFilter{
+		Query: req.Msg.Query,
+		Limit: int(req.Msg.Limit),
+	}
+	if req.Msg.ClosedAfter > 0 {
+		filter.From = time.Unix(req.Msg.ClosedAfter, 0)
+	}
+	if req.Msg.ClosedBefore > 0 {
+		filter.To = time.Unix(req.Msg.ClosedBefore, 0)
+	}
+
+	sessions, err := h.service.ListArchivedSessions(ctx, filter, primary.ArchiveAccess{
+		SessionTokens: req.Msg.SessionTokens,
+		AdminToken:    req.Msg.AdminToken,
+	})
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	protoSessions := make([]*esteemedv1.ArchivedSessionSummary, 0, len(sessions))
+	for _, s := range sessions {
+		protoSessions = append(protoSessions, domainArchivedSummaryToProto(s))
+	}
+
+	return connect.NewResponse(&esteemedv1.ListArchivedSessionsResponse{
+		Sessions: protoSessions,
+	}), nil
+}
+
+// GetArchivedSession returns a closed session the caller took part in, with its participants, deck and rounds
+func (h *ArchiveHandler) GetArchivedSession(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.GetArchivedSessionRequest],
+) (*connect.Response[esteemedv1.GetArchivedSessionResponse], error) {
+	session, err := h.service.GetArchivedSession(ctx, req.Msg.Id, primary.ArchiveAccess{
+		SessionTokens: []string{req.Msg.SessionToken},
+		AdminToken:    req.Msg.AdminToken,
+	})
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	participants := make([]*esteemedv1.ArchivedParticipant, 0, len(session.Participants))
+	for _, p := range session.Participants {
+		participants = append(participants, &esteemedv1.ArchivedParticipant{
+			Name:          p.Name,
+			WasHost:       p.WasHost,
+			IsSpectator:   p.IsSpectator,
+			IsPlaceholder: p.IsPlaceholder,
+		})
+	}
+
+	rounds := make([]*esteemedv1.ArchivedRound, 0, len(session.Rounds))
+	for _, r := range session.Rounds {
+		rounds = append(rounds, domainRoundToArchivedProto(r))
+	}
+
+	return connect.NewResponse(&esteemedv1.GetArchivedSessionResponse{
+		Session: &esteemedv1.ArchivedSession{
+			Summary:      domainArchivedSummaryToProto(session.Summary()),
+			ParentRoomId: session.ParentID,
+			Deck:         session.Deck,
+			Participants: participants,
+			Rounds:       rounds,
+		},
+	}), nil
+}
+
+func domainArchivedSummaryToProto(s *domain.ArchivedSessionSummary) *esteemedv1.ArchivedSessionSummary {
+	return &esteemedv1.ArchivedSessionSummary{
+		Id:               s.ID,
+		RoomName:         s.RoomName,
+		DotVoting:        s.Mode == domain.RoomModeDotVoting,
+		CreatedAt:        s.CreatedAt.Unix(),
+		ClosedAt:         s.ClosedAt.Unix(),
+		CloseReason:      s.CloseReason.String(),
+		ParticipantCount: int32(s.ParticipantCount),
+		RoundCount:       int32(s.RoundCount),
+	}
+}
+
+func domainRoundToArchivedProto(r *domain.RoundResult) *esteemedv1.ArchivedRound {
+	round := &esteemedv1.ArchivedRound{
+		RevealedAt:     r.RevealedAt.Unix(),
+		SourceRoomName: r.SourceRoomName,
+	}
+	if r.Summary == nil {
+		return round
+	}
+
+	round.Average = r.Summary.Average
+	round.Mode = r.Summary.Mode
+	round.HasConsensus = r.Summary.HasConsensus
+	round.NumericAverage = r.Summary.NumericAverage
+
+	for _, v := range r.Summary.Votes {
+		round.Votes = append(round.Votes, &esteemedv1.ArchivedVote{
+			ParticipantName: v.ParticipantName,
+			Value:           v.Value,
+			IsProxy:         v.IsProxy,
+		})
+	}
+	for _, rank := range r.Summary.Ranking {
+		round.Ranking = append(round.Ranking, &esteemedv1.ArchivedRanking{
+			Option: rank.Option,
+			Dots:   int32(rank.Dots),
+			Rank:   int32(rank.Rank),
+		})
+	}
+
+	return round
+}
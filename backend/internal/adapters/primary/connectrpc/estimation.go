@@ -3,6 +3,7 @@ package connectrpc
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"connectrpc.com/connect"
 
@@ -40,6 +41,42 @@ func (h *EstimationHandler) CastVote(
 	return connect.NewResponse(&esteemedv1.CastVoteResponse{}), nil
 }
 
+// CastDots places dots in a dot-voting room
+func (h *EstimationHandler) CastDots(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.CastDotsRequest],
+) (*connect.Response[esteemedv1.CastDotsResponse], error) {
+	err := h.service.CastDots(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, protoDotsToDomain(req.Msg.Dots))
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.CastDotsResponse{}), nil
+}
+
+// CastProxyVote records a vote on behalf of an absent participant
+func (h *EstimationHandler) CastProxyVote(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.CastProxyVoteRequest],
+) (*connect.Response[esteemedv1.CastProxyVoteResponse], error) {
+	target, err := h.service.CastProxyVote(
+		ctx,
+		req.Msg.RoomId,
+		req.Msg.ParticipantId,
+		req.Msg.SessionToken,
+		req.Msg.TargetParticipantId,
+		req.Msg.TargetName,
+		req.Msg.Value,
+	)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.CastProxyVoteResponse{
+		TargetParticipantId: target.ID,
+	}), nil
+}
+
 // RevealVotes reveals all votes
 func (h *EstimationHandler) RevealVotes(
 	ctx context.Context,
@@ -81,26 +118,93 @@ func (h *EstimationHandler) StartRound(
 	return connect.NewResponse(&esteemedv1.StartRoundResponse{}), nil
 }
 
+// UndoLastTransition undoes the last start, reveal or reset
+func (h *EstimationHandler) UndoLastTransition(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.UndoLastTransitionRequest],
+) (*connect.Response[esteemedv1.UndoLastTransitionResponse], error) {
+	err := h.service.UndoLastTransition(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.UndoLastTransitionResponse{}), nil
+}
+
+// SetVotingDeadline sets the deadline of the current async round
+func (h *EstimationHandler) SetVotingDeadline(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.SetVotingDeadlineRequest],
+) (*connect.Response[esteemedv1.SetVotingDeadlineResponse], error) {
+	deadline := time.Unix(req.Msg.VotingDeadline, 0)
+	err := h.service.SetVotingDeadline(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, deadline)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.SetVotingDeadlineResponse{}), nil
+}
+
+// GetRoundHistory returns a room's revealed rounds
+func (h *EstimationHandler) GetRoundHistory(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.GetRoundHistoryRequest],
+) (*connect.Response[esteemedv1.GetRoundHistoryResponse], error) {
+	history, err := h.service.GetRoundHistory(ctx, req.Msg.RoomId, req.Msg.SessionToken)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	rounds := make([]*esteemedv1.RoundResult, 0, len(history))
+	for _, r := range history {
+		rounds = append(rounds, &esteemedv1.RoundResult{
+			RevealedAt:     r.RevealedAt.Unix(),
+			SourceRoomId:   r.SourceRoomID,
+			SourceRoomName: r.SourceRoomName,
+			Summary:        domainSummaryToProto(r.Summary),
+		})
+	}
+
+	return connect.NewResponse(&esteemedv1.GetRoundHistoryResponse{
+		Rounds: rounds,
+	}), nil
+}
+
 // WatchVotes streams vote events
 func (h *EstimationHandler) WatchVotes(
 	ctx context.Context,
 	req *connect.Request[esteemedv1.WatchVotesRequest],
 	stream *connect.ServerStream[esteemedv1.VoteEvent],
 ) error {
-	eventCh, err := h.service.WatchVotes(ctx, req.Msg.RoomId, req.Msg.SessionToken)
+	resume := primary.ResumePoint{Epoch: req.Msg.ResumeEpoch, Sequence: req.Msg.ResumeAfterSequence}
+	eventCh, err := h.service.WatchVotes(ctx, req.Msg.RoomId, req.Msg.SessionToken, req.Msg.Passcode, resume)
 	if err != nil {
 		return mapDomainError(err)
 	}
 
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
+		case now := <-heartbeat.C:
+			// A failed heartbeat ends the stream, which disconnects the participant
+			if err := stream.Send(&esteemedv1.VoteEvent{
+				Sequence: resume.Sequence,
+				Epoch:    resume.Epoch,
+				Event:    &esteemedv1.VoteEvent_Heartbeat{Heartbeat: &esteemedv1.VoteHeartbeat{SentAt: now.Unix()}},
+			}); err != nil {
+				return err
+			}
 		case event, ok := <-eventCh:
 			if !ok {
 				return nil
 			}
 
+			resume = primary.ResumePoint{Epoch: event.Epoch, Sequence: event.Sequence}
+
 			protoEvent := domainVoteEventToProto(event)
 			if err := stream.Send(protoEvent); err != nil {
 				return err
@@ -114,11 +218,16 @@ func (h *EstimationHandler) WatchVotes(
 func domainSummaryToProto(summary *domain.VoteSummary) *esteemedv1.VoteSummary {
 	votes := make([]*esteemedv1.Vote, 0, len(summary.Votes))
 	for _, v := range summary.Votes {
-		votes = append(votes, &esteemedv1.Vote{
-			ParticipantId:   v.ParticipantID,
-			ParticipantName: v.ParticipantName,
-			Value:           v.Value,
-			HasVoted:        v.HasVoted,
+		votes = append(votes, domainVoteToProto(v))
+	}
+
+	ranking := make([]*esteemedv1.DotRanking, 0, len(summary.Ranking))
+	for _, r := range summary.Ranking {
+		ranking = append(ranking, &esteemedv1.DotRanking{
+			Option:     r.Option,
+			Dots:       int32(r.Dots),
+			Rank:       int32(r.Rank),
+			VoterCount: int32(r.VoterCount),
 		})
 	}
 
@@ -128,11 +237,50 @@ func domainSummaryToProto(summary *domain.VoteSummary) *esteemedv1.VoteSummary {
 		Mode:           summary.Mode,
 		HasConsensus:   summary.HasConsensus,
 		NumericAverage: summary.NumericAverage,
+		Ranking:        ranking,
+	}
+}
+
+func domainVoteToProto(v *domain.Vote) *esteemedv1.Vote {
+	return &esteemedv1.Vote{
+		ParticipantId:   v.ParticipantID,
+		ParticipantName: v.ParticipantName,
+		Value:           v.Value,
+		HasVoted:        v.HasVoted,
+		Dots:            domainDotsToProto(v.Dots),
+		IsProxy:         v.IsProxy,
+		ProxyCastBy:     v.ProxyCastBy,
+	}
+}
+
+func protoDotsToDomain(dots []*esteemedv1.DotAllocation) []*domain.DotAllocation {
+	result := make([]*domain.DotAllocation, 0, len(dots))
+	for _, d := range dots {
+		result = append(result, &domain.DotAllocation{
+			Option: d.Option,
+			Dots:   int(d.Dots),
+		})
+	}
+	return result
+}
+
+func domainDotsToProto(dots []*domain.DotAllocation) []*esteemedv1.DotAllocation {
+	if len(dots) == 0 {
+		return nil
+	}
+
+	result := make([]*esteemedv1.DotAllocation, 0, len(dots))
+	for _, d := range dots {
+		result = append(result, &esteemedv1.DotAllocation{
+			Option: d.Option,
+			Dots:   int32(d.Dots),
+		})
 	}
+	return result
 }
 
 func domainVoteEventToProto(event primary.VoteEvent) *esteemedv1.VoteEvent {
-	protoEvent := &esteemedv1.VoteEvent{}
+	protoEvent := &esteemedv1.VoteEvent{Sequence: event.Sequence, Epoch: event.Epoch}
 
 	switch event.Type {
 	case primary.VoteEventCast:
@@ -140,6 +288,7 @@ func domainVoteEventToProto(event primary.VoteEvent) *esteemedv1.VoteEvent {
 			VoteCast: &esteemedv1.VoteCast{
 				ParticipantId:   event.ParticipantID,
 				ParticipantName: event.ParticipantName,
+				IsProxy:         event.IsProxy,
 			},
 		}
 	case primary.VoteEventRevealed:
@@ -152,6 +301,16 @@ func domainVoteEventToProto(event primary.VoteEvent) *esteemedv1.VoteEvent {
 		protoEvent.Event = &esteemedv1.VoteEvent_RoundReset{
 			RoundReset: &esteemedv1.RoundReset{},
 		}
+	case primary.VoteEventResync:
+		protoEvent.Event = &esteemedv1.VoteEvent_Resync{
+			Resync: &esteemedv1.VoteResync{},
+		}
+	case primary.VoteEventRetracted:
+		protoEvent.Event = &esteemedv1.VoteEvent_VoteRetracted{
+			VoteRetracted: &esteemedv1.VoteRetracted{
+				ParticipantId: event.ParticipantID,
+			},
+		}
 	}
 
 	return protoEvent
@@ -161,18 +320,82 @@ func mapDomainError(err error) error {
 	switch err {
 	case domain.ErrRoomNotFound:
 		return connect.NewError(connect.CodeNotFound, err)
+	case domain.ErrSessionNotFound:
+		return connect.NewError(connect.CodeNotFound, err)
 	case domain.ErrParticipantNotFound:
 		return connect.NewError(connect.CodeNotFound, err)
 	case domain.ErrInvalidToken:
 		return connect.NewError(connect.CodePermissionDenied, err)
-	case domain.ErrNotHost:
+	case domain.ErrNotHost, domain.ErrNotOwner:
 		return connect.NewError(connect.CodePermissionDenied, err)
+	case domain.ErrInvalidRole:
+		return connect.NewError(connect.CodeInvalidArgument, err)
 	case domain.ErrInvalidState:
 		return connect.NewError(connect.CodeFailedPrecondition, err)
 	case domain.ErrInvalidCardValue:
 		return connect.NewError(connect.CodeInvalidArgument, err)
 	case domain.ErrSpectatorCannotVote:
 		return connect.NewError(connect.CodePermissionDenied, err)
+	case domain.ErrBreakoutNotFound:
+		return connect.NewError(connect.CodeNotFound, err)
+	case domain.ErrNestedBreakout:
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case domain.ErrNothingToUndo, domain.ErrUndoExpired:
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case domain.ErrNotAsync:
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case domain.ErrDeadlineInPast, domain.ErrDeadlineTooLate:
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case domain.ErrInvalidTimeout:
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case domain.ErrInvalidName, domain.ErrInvalidAvatarSeed, domain.ErrInvalidColor:
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case domain.ErrInviteRequired, domain.ErrInvalidInvite:
+		return connect.NewError(connect.CodePermissionDenied, err)
+	case domain.ErrPasscodeRequired, domain.ErrWrongPasscode:
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	case domain.ErrTooManyAttempts:
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	case domain.ErrInvalidPasscode:
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case domain.ErrVoterLimitReached, domain.ErrSpectatorLimitReached:
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	case domain.ErrInvalidLimit:
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case domain.ErrViewOnly:
+		return connect.NewError(connect.CodePermissionDenied, err)
+	case domain.ErrBanned:
+		return connect.NewError(connect.CodePermissionDenied, err)
+	case domain.ErrBanNotFound:
+		return connect.NewError(connect.CodeNotFound, err)
+	case domain.ErrRoomLocked:
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case domain.ErrPendingNotFound:
+		return connect.NewError(connect.CodeNotFound, err)
+	case domain.ErrWaitingRoomFull:
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	case domain.ErrAlreadyWaiting:
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case domain.ErrInviteNotFound:
+		return connect.NewError(connect.CodeNotFound, err)
+	case domain.ErrInvalidInviteTTL:
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case domain.ErrInvalidSlug:
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case domain.ErrRoomNameTaken:
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case domain.ErrCannotTransferToSpectator, domain.ErrInvalidDeputy:
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case domain.ErrHostPresent, domain.ErrHostCannotSpectate:
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case domain.ErrInvalidRoomMode:
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case domain.ErrParticipantPresent:
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case domain.ErrProxyTargetMissing:
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case domain.ErrInvalidDotOption, domain.ErrTooManyDots, domain.ErrNoDotsPlaced:
+		return connect.NewError(connect.CodeInvalidArgument, err)
 	default:
 		return connect.NewError(connect.CodeInternal, err)
 	}
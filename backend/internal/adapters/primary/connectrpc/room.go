@@ -3,6 +3,7 @@ package connectrpc
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"connectrpc.com/connect"
 
@@ -12,6 +13,10 @@ import (
 	"github.com/vicmanager/esteemed/backend/internal/ports/primary"
 )
 
+// heartbeatInterval is how often event streams carry a heartbeat, well below
+// the idle timeouts of common proxies
+const heartbeatInterval = 15 * time.Second
+
 // RoomHandler implements the ConnectRPC RoomService
 type RoomHandler struct {
 	service primary.RoomService
@@ -46,6 +51,7 @@ func (h *RoomHandler) ListRooms(
 			State:            domainStateToProto(s.State),
 			CreatedAt:        s.CreatedAt,
 			ExpiresAt:        s.ExpiresAt,
+			Persistent:       s.Persistent,
 		})
 	}
 
@@ -59,15 +65,48 @@ func (h *RoomHandler) CreateRoom(
 	ctx context.Context,
 	req *connect.Request[esteemedv1.CreateRoomRequest],
 ) (*connect.Response[esteemedv1.CreateRoomResponse], error) {
-	cardConfig := protoCardConfigToDomain(req.Msg.CardConfig)
+	opts := primary.RoomOptions{
+		CardConfig: protoCardConfigToDomain(req.Msg.CardConfig),
+		Persistent: req.Msg.Persistent,
+		Slug:       req.Msg.Slug,
+
+		InactivityTimeout: time.Duration(req.Msg.InactivityTimeoutMinutes) * time.Minute,
+		HostSuccession:    protoHostSuccessionToDomain(req.Msg.HostSuccession),
+		RotateFacilitator: req.Msg.RotateFacilitator,
+		KeepDisconnected:  req.Msg.KeepDisconnected,
+		Visibility:        protoVisibilityToDomain(req.Msg.Visibility),
+		Passcode:          req.Msg.Passcode,
+		WaitingRoom:       req.Msg.WaitingRoom,
+		MaxVoters:         int(req.Msg.MaxVoters),
+		MaxSpectators:     int(req.Msg.MaxSpectators),
+	}
+
+	if req.Msg.Mode == esteemedv1.RoomMode_ROOM_MODE_DOT_VOTING {
+		dotConfig, err := protoDotVoteConfigToDomain(req.Msg.DotVoteConfig)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		opts.DotConfig = dotConfig
+	}
+
+	if req.Msg.Async {
+		opts.Async = true
+		if req.Msg.VotingDeadline != 0 {
+			deadline := time.Unix(req.Msg.VotingDeadline, 0)
+			if err := domain.ValidateDeadline(deadline); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			opts.Deadline = deadline
+		}
+	}
 
-	result, err := h.service.CreateRoom(ctx, req.Msg.HostName, req.Msg.SessionToken, cardConfig)
+	result, err := h.service.CreateRoom(ctx, req.Msg.HostName, req.Msg.SessionToken, opts)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, mapDomainError(err)
 	}
 
 	return connect.NewResponse(&esteemedv1.CreateRoomResponse{
-		Room:          domainRoomToProto(result.Room),
+		Room:          domainRoomToProto(result.Room, managesWaitingRoom(result.Room, result.ParticipantID)),
 		SessionToken:  result.SessionToken,
 		ParticipantId: result.ParticipantID,
 	}), nil
@@ -78,18 +117,20 @@ func (h *RoomHandler) JoinRoom(
 	ctx context.Context,
 	req *connect.Request[esteemedv1.JoinRoomRequest],
 ) (*connect.Response[esteemedv1.JoinRoomResponse], error) {
-	result, err := h.service.JoinRoom(ctx, req.Msg.RoomId, req.Msg.ParticipantName, req.Msg.SessionToken, req.Msg.IsSpectator)
+	result, err := h.service.JoinRoom(ctx, req.Msg.RoomId, req.Msg.ParticipantName, req.Msg.SessionToken, primary.JoinOptions{
+		IsSpectator: req.Msg.IsSpectator,
+		InviteToken: req.Msg.InviteToken,
+		Passcode:    req.Msg.Passcode,
+	})
 	if err != nil {
-		if err == domain.ErrRoomNotFound {
-			return nil, connect.NewError(connect.CodeNotFound, err)
-		}
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, mapDomainError(err)
 	}
 
 	return connect.NewResponse(&esteemedv1.JoinRoomResponse{
-		Room:          domainRoomToProto(result.Room),
+		Room:          domainRoomToProto(result.Room, managesWaitingRoom(result.Room, result.ParticipantID)),
 		SessionToken:  result.SessionToken,
 		ParticipantId: result.ParticipantID,
+		Pending:       result.Pending,
 	}), nil
 }
 
@@ -118,23 +159,34 @@ func (h *RoomHandler) WatchRoom(
 	req *connect.Request[esteemedv1.WatchRoomRequest],
 	stream *connect.ServerStream[esteemedv1.RoomEvent],
 ) error {
-	eventCh, err := h.service.WatchRoom(ctx, req.Msg.RoomId, req.Msg.SessionToken)
+	resume := primary.ResumePoint{Epoch: req.Msg.ResumeEpoch, Sequence: req.Msg.ResumeAfterSequence}
+	eventCh, err := h.service.WatchRoom(ctx, req.Msg.RoomId, req.Msg.SessionToken, req.Msg.Passcode, resume)
 	if err != nil {
-		if err == domain.ErrRoomNotFound {
-			return connect.NewError(connect.CodeNotFound, err)
-		}
-		return connect.NewError(connect.CodeInternal, err)
+		return mapDomainError(err)
 	}
 
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
+		case now := <-heartbeat.C:
+			// A failed heartbeat ends the stream, which disconnects the participant
+			if err := stream.Send(&esteemedv1.RoomEvent{
+				Sequence: resume.Sequence,
+				Epoch:    resume.Epoch,
+				Event:    &esteemedv1.RoomEvent_Heartbeat{Heartbeat: &esteemedv1.Heartbeat{SentAt: now.Unix()}},
+			}); err != nil {
+				return err
+			}
 		case event, ok := <-eventCh:
 			if !ok {
 				return nil
 			}
 
+			resume = primary.ResumePoint{Epoch: event.Epoch, Sequence: event.Sequence}
 			protoEvent := domainRoomEventToProto(event)
 			if err := stream.Send(protoEvent); err != nil {
 				return err
@@ -143,12 +195,109 @@ func (h *RoomHandler) WatchRoom(
 	}
 }
 
-// KickParticipant removes a participant from the room (host only)
+// GetRoom returns a snapshot of the room to anyone allowed to watch it
+func (h *RoomHandler) GetRoom(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.GetRoomRequest],
+) (*connect.Response[esteemedv1.GetRoomResponse], error) {
+	snapshot, err := h.service.GetRoom(ctx, req.Msg.RoomId, req.Msg.SessionToken, req.Msg.Passcode)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	protoSnapshot := domainSnapshotToProto(snapshot)
+	return connect.NewResponse(&esteemedv1.GetRoomResponse{
+		Room:          protoSnapshot.Room,
+		VoteStatus:    protoSnapshot.VoteStatus,
+		Summary:       protoSnapshot.Summary,
+		ParticipantId: protoSnapshot.ParticipantId,
+		IsViewer:      protoSnapshot.IsViewer,
+	}), nil
+}
+
+// WatchRoomState streams the room's room and vote events in order, starting with
+// a snapshot or resuming after a sequence number
+func (h *RoomHandler) WatchRoomState(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.WatchRoomStateRequest],
+	stream *connect.ServerStream[esteemedv1.RoomStateEvent],
+) error {
+	resume := primary.ResumePoint{Epoch: req.Msg.ResumeEpoch, Sequence: req.Msg.ResumeAfterSequence}
+	eventCh, err := h.service.WatchRoomState(ctx, req.Msg.RoomId, req.Msg.SessionToken, req.Msg.Passcode, resume)
+	if err != nil {
+		return mapDomainError(err)
+	}
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-heartbeat.C:
+			// A failed heartbeat ends the stream, which disconnects the participant
+			if err := stream.Send(&esteemedv1.RoomStateEvent{
+				Sequence: resume.Sequence,
+				Epoch:    resume.Epoch,
+				Event: &esteemedv1.RoomStateEvent_RoomEvent{RoomEvent: &esteemedv1.RoomEvent{
+					Sequence: resume.Sequence,
+					Epoch:    resume.Epoch,
+					Event:    &esteemedv1.RoomEvent_Heartbeat{Heartbeat: &esteemedv1.Heartbeat{SentAt: now.Unix()}},
+				}},
+			}); err != nil {
+				return err
+			}
+		case event, ok := <-eventCh:
+			if !ok {
+				return nil
+			}
+
+			resume = primary.ResumePoint{Epoch: event.Epoch, Sequence: event.Sequence}
+			if err := stream.Send(domainRoomStateEventToProto(event)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// CreateViewToken issues a read-only view token for shared screens (host or facilitator)
+func (h *RoomHandler) CreateViewToken(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.CreateViewTokenRequest],
+) (*connect.Response[esteemedv1.CreateViewTokenResponse], error) {
+	token, err := h.service.CreateViewToken(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.CreateViewTokenResponse{
+		ViewToken: token,
+	}), nil
+}
+
+// RevokeViewToken stops the room's view token from working (host or facilitator)
+func (h *RoomHandler) RevokeViewToken(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.RevokeViewTokenRequest],
+) (*connect.Response[esteemedv1.RevokeViewTokenResponse], error) {
+	err := h.service.RevokeViewToken(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.RevokeViewTokenResponse{}), nil
+}
+
+// KickParticipant removes a participant from the room (host or facilitator)
 func (h *RoomHandler) KickParticipant(
 	ctx context.Context,
 	req *connect.Request[esteemedv1.KickParticipantRequest],
 ) (*connect.Response[esteemedv1.KickParticipantResponse], error) {
-	err := h.service.KickParticipant(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, req.Msg.TargetParticipantId)
+	err := h.service.KickParticipant(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, req.Msg.TargetParticipantId, primary.KickOptions{
+		Ban:     req.Msg.Ban,
+		BanName: req.Msg.BanName,
+	})
 	if err != nil {
 		if err == domain.ErrRoomNotFound {
 			return nil, connect.NewError(connect.CodeNotFound, err)
@@ -156,7 +305,7 @@ func (h *RoomHandler) KickParticipant(
 		if err == domain.ErrInvalidToken {
 			return nil, connect.NewError(connect.CodePermissionDenied, err)
 		}
-		if err == domain.ErrNotHost {
+		if err == domain.ErrNotHost || err == domain.ErrNotOwner {
 			return nil, connect.NewError(connect.CodePermissionDenied, err)
 		}
 		if err == domain.ErrCannotKickSelf {
@@ -171,6 +320,43 @@ func (h *RoomHandler) KickParticipant(
 	return connect.NewResponse(&esteemedv1.KickParticipantResponse{}), nil
 }
 
+// ListBans returns who has been banned from the room (host or facilitator)
+func (h *RoomHandler) ListBans(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.ListBansRequest],
+) (*connect.Response[esteemedv1.ListBansResponse], error) {
+	bans, err := h.service.ListBans(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	protoBans := make([]*esteemedv1.Ban, 0, len(bans))
+	for _, ban := range bans {
+		protoBans = append(protoBans, &esteemedv1.Ban{
+			Id:              ban.ID,
+			ParticipantName: ban.ParticipantName,
+			NameBanned:      ban.NameBanned,
+			BannedBy:        ban.BannedBy,
+			BannedAt:        ban.BannedAt.Unix(),
+		})
+	}
+
+	return connect.NewResponse(&esteemedv1.ListBansResponse{Bans: protoBans}), nil
+}
+
+// LiftBan lets a banned participant join the room again (host or facilitator)
+func (h *RoomHandler) LiftBan(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.LiftBanRequest],
+) (*connect.Response[esteemedv1.LiftBanResponse], error) {
+	err := h.service.LiftBan(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, req.Msg.BanId)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.LiftBanResponse{}), nil
+}
+
 // TransferOwnership transfers host privileges to another participant
 func (h *RoomHandler) TransferOwnership(
 	ctx context.Context,
@@ -184,7 +370,7 @@ func (h *RoomHandler) TransferOwnership(
 		if err == domain.ErrInvalidToken {
 			return nil, connect.NewError(connect.CodePermissionDenied, err)
 		}
-		if err == domain.ErrNotHost {
+		if err == domain.ErrNotHost || err == domain.ErrNotOwner {
 			return nil, connect.NewError(connect.CodePermissionDenied, err)
 		}
 		if err == domain.ErrCannotTransferToSpectator {
@@ -199,28 +385,432 @@ func (h *RoomHandler) TransferOwnership(
 	return connect.NewResponse(&esteemedv1.TransferOwnershipResponse{}), nil
 }
 
+// GrantRole makes a participant a facilitator, voter or spectator
+func (h *RoomHandler) GrantRole(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.GrantRoleRequest],
+) (*connect.Response[esteemedv1.GrantRoleResponse], error) {
+	role, err := protoRoleToDomain(req.Msg.Role)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	err = h.service.GrantRole(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, req.Msg.TargetParticipantId, role)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.GrantRoleResponse{}), nil
+}
+
+// RevokeRole returns a participant to the voter role
+func (h *RoomHandler) RevokeRole(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.RevokeRoleRequest],
+) (*connect.Response[esteemedv1.RevokeRoleResponse], error) {
+	err := h.service.RevokeRole(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, req.Msg.TargetParticipantId)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.RevokeRoleResponse{}), nil
+}
+
+// SetPasscode sets or, when empty, removes the room's passcode (owner only)
+func (h *RoomHandler) SetPasscode(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.SetPasscodeRequest],
+) (*connect.Response[esteemedv1.SetPasscodeResponse], error) {
+	err := h.service.SetPasscode(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, req.Msg.Passcode)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.SetPasscodeResponse{}), nil
+}
+
+// SetRoomLocked locks or unlocks the room to new participants (host or facilitator)
+func (h *RoomHandler) SetRoomLocked(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.SetRoomLockedRequest],
+) (*connect.Response[esteemedv1.SetRoomLockedResponse], error) {
+	err := h.service.SetLocked(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, req.Msg.Locked)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.SetRoomLockedResponse{}), nil
+}
+
+// SetWaitingRoom turns the waiting room on or off (owner only)
+func (h *RoomHandler) SetWaitingRoom(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.SetWaitingRoomRequest],
+) (*connect.Response[esteemedv1.SetWaitingRoomResponse], error) {
+	err := h.service.SetWaitingRoom(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, req.Msg.Enabled)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.SetWaitingRoomResponse{}), nil
+}
+
+// AdmitParticipant lets a participant in from the waiting room (host or facilitator)
+func (h *RoomHandler) AdmitParticipant(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.AdmitParticipantRequest],
+) (*connect.Response[esteemedv1.AdmitParticipantResponse], error) {
+	err := h.service.AdmitParticipant(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, req.Msg.PendingParticipantId)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.AdmitParticipantResponse{}), nil
+}
+
+// RejectParticipant turns away a participant in the waiting room (host or facilitator)
+func (h *RoomHandler) RejectParticipant(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.RejectParticipantRequest],
+) (*connect.Response[esteemedv1.RejectParticipantResponse], error) {
+	err := h.service.RejectParticipant(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, req.Msg.PendingParticipantId)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.RejectParticipantResponse{}), nil
+}
+
+// SetParticipantLimits caps how many voters and spectators the room takes (owner only)
+func (h *RoomHandler) SetParticipantLimits(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.SetParticipantLimitsRequest],
+) (*connect.Response[esteemedv1.SetParticipantLimitsResponse], error) {
+	err := h.service.SetParticipantLimits(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, int(req.Msg.MaxVoters), int(req.Msg.MaxSpectators))
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.SetParticipantLimitsResponse{}), nil
+}
+
+// SetVisibility changes who can find and join the room (owner only)
+func (h *RoomHandler) SetVisibility(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.SetVisibilityRequest],
+) (*connect.Response[esteemedv1.SetVisibilityResponse], error) {
+	err := h.service.SetVisibility(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, protoVisibilityToDomain(req.Msg.Visibility))
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.SetVisibilityResponse{}), nil
+}
+
+// CreateInvite creates a signed invite link (host or facilitator)
+func (h *RoomHandler) CreateInvite(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.CreateInviteRequest],
+) (*connect.Response[esteemedv1.CreateInviteResponse], error) {
+	role := domain.RoleVoter
+	if req.Msg.Role != esteemedv1.ParticipantRole_PARTICIPANT_ROLE_UNSPECIFIED {
+		var err error
+		if role, err = protoRoleToDomain(req.Msg.Role); err != nil {
+			return nil, mapDomainError(err)
+		}
+	}
+	ttl := time.Duration(req.Msg.TtlMinutes) * time.Minute
+
+	result, err := h.service.CreateInvite(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, role, ttl)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.CreateInviteResponse{
+		InviteId:    result.Invite.ID,
+		InviteToken: result.Token,
+		ExpiresAt:   result.Invite.ExpiresAt.Unix(),
+	}), nil
+}
+
+// RevokeInvite stops an invite link from being used (host or facilitator)
+func (h *RoomHandler) RevokeInvite(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.RevokeInviteRequest],
+) (*connect.Response[esteemedv1.RevokeInviteResponse], error) {
+	err := h.service.RevokeInvite(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, req.Msg.InviteId)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.RevokeInviteResponse{}), nil
+}
+
+// UpdateParticipant changes the caller's name, avatar seed or color
+func (h *RoomHandler) UpdateParticipant(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.UpdateParticipantRequest],
+) (*connect.Response[esteemedv1.UpdateParticipantResponse], error) {
+	participant, err := h.service.UpdateParticipant(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, domain.ParticipantProfile{
+		Name:       req.Msg.Name,
+		AvatarSeed: req.Msg.AvatarSeed,
+		Color:      req.Msg.Color,
+	})
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.UpdateParticipantResponse{
+		Participant: domainParticipantToProto(participant),
+	}), nil
+}
+
+// SetSpectator switches a participant between voting and spectating
+func (h *RoomHandler) SetSpectator(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.SetSpectatorRequest],
+) (*connect.Response[esteemedv1.SetSpectatorResponse], error) {
+	err := h.service.SetSpectator(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, req.Msg.TargetParticipantId, req.Msg.Spectator)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.SetSpectatorResponse{}), nil
+}
+
+// SetFacilitatorRotation turns rotating the facilitator role per story on or off
+func (h *RoomHandler) SetFacilitatorRotation(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.SetFacilitatorRotationRequest],
+) (*connect.Response[esteemedv1.SetFacilitatorRotationResponse], error) {
+	err := h.service.SetFacilitatorRotation(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, req.Msg.Enabled)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.SetFacilitatorRotationResponse{}), nil
+}
+
+// SetHostSuccession sets who takes over when the host disconnects
+func (h *RoomHandler) SetHostSuccession(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.SetHostSuccessionRequest],
+) (*connect.Response[esteemedv1.SetHostSuccessionResponse], error) {
+	policy := protoHostSuccessionToDomain(req.Msg.HostSuccession)
+	err := h.service.SetHostSuccession(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, policy, req.Msg.DeputyId)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.SetHostSuccessionResponse{}), nil
+}
+
+// ClaimHost makes the caller host of a room that has none
+func (h *RoomHandler) ClaimHost(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.ClaimHostRequest],
+) (*connect.Response[esteemedv1.ClaimHostResponse], error) {
+	err := h.service.ClaimHost(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.ClaimHostResponse{}), nil
+}
+
+// KeepAlive resets the room's inactivity timer
+func (h *RoomHandler) KeepAlive(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.KeepAliveRequest],
+) (*connect.Response[esteemedv1.KeepAliveResponse], error) {
+	expiresAt, err := h.service.KeepAlive(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.KeepAliveResponse{
+		ExpiresAt: unixOrZero(expiresAt),
+	}), nil
+}
+
+// CreateBreakout creates a child room for a sub-team (host or facilitator)
+func (h *RoomHandler) CreateBreakout(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.CreateBreakoutRequest],
+) (*connect.Response[esteemedv1.CreateBreakoutResponse], error) {
+	breakout, err := h.service.CreateBreakout(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.CreateBreakoutResponse{
+		Room: domainRoomToProto(breakout, false),
+	}), nil
+}
+
+// MoveToBreakout moves a participant into a breakout (host or facilitator)
+func (h *RoomHandler) MoveToBreakout(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.MoveToBreakoutRequest],
+) (*connect.Response[esteemedv1.MoveToBreakoutResponse], error) {
+	err := h.service.MoveToBreakout(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, req.Msg.TargetParticipantId, req.Msg.BreakoutRoomId)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.MoveToBreakoutResponse{}), nil
+}
+
+// CloseBreakout closes a breakout and merges its results into the parent (host or facilitator)
+func (h *RoomHandler) CloseBreakout(
+	ctx context.Context,
+	req *connect.Request[esteemedv1.CloseBreakoutRequest],
+) (*connect.Response[esteemedv1.CloseBreakoutResponse], error) {
+	merged, err := h.service.CloseBreakout(ctx, req.Msg.RoomId, req.Msg.ParticipantId, req.Msg.SessionToken, req.Msg.BreakoutRoomId)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return connect.NewResponse(&esteemedv1.CloseBreakoutResponse{
+		RoundsMerged: int32(merged),
+	}), nil
+}
+
 // Helper functions to convert domain types to proto types
 
-func domainRoomToProto(room *domain.Room) *esteemedv1.Room {
+// managesWaitingRoom returns whether a participant may see the room's waiting room
+func managesWaitingRoom(room *domain.Room, participantID string) bool {
+	return room.Authorize(participantID, domain.ActionManageParticipants) == nil
+}
+
+// domainRoomToProto converts a room, listing who is waiting to be admitted
+// only for callers who manage the waiting room
+func domainRoomToProto(room *domain.Room, canManage bool) *esteemedv1.Room {
 	participants := make([]*esteemedv1.Participant, 0, len(room.Participants))
 	for _, p := range room.GetParticipants() {
-		participants = append(participants, &esteemedv1.Participant{
-			Id:          p.ID,
-			Name:        p.Name,
-			IsHost:      p.IsHost,
-			IsConnected: p.IsConnected,
-			JoinedAt:    p.JoinedAt.Unix(),
-			IsSpectator: p.IsSpectator,
-		})
+		participants = append(participants, domainParticipantToProto(p))
+	}
+	var pending []*esteemedv1.Participant
+	if canManage {
+		for _, p := range room.GetPending() {
+			pending = append(pending, domainParticipantToProto(p))
+		}
 	}
 
+	policy, deputyID := room.GetHostSuccession()
+	maxVoters, maxSpectators := room.GetParticipantLimits()
+
 	return &esteemedv1.Room{
-		Id:           room.ID,
-		Name:         room.Name,
-		Participants: participants,
-		State:        domainStateToProto(room.GetState()),
-		CreatedAt:    room.CreatedAt.Unix(),
-		CardConfig:   domainCardConfigToProto(room.CardConfig),
+		Id:              room.ID,
+		Name:            room.Name,
+		Participants:    participants,
+		State:           domainStateToProto(room.GetState()),
+		CreatedAt:       room.CreatedAt.Unix(),
+		CardConfig:      domainCardConfigToProto(room.CardConfig),
+		Mode:            domainModeToProto(room.Mode),
+		DotVoteConfig:   domainDotVoteConfigToProto(room.DotConfig),
+		ParentRoomId:    room.ParentID,
+		BreakoutRoomIds: room.GetBreakouts(),
+		Async:           room.IsAsync(),
+		VotingDeadline:  unixOrZero(room.GetDeadline()),
+		Persistent:      room.IsPersistent(),
+
+		InactivityTimeoutMinutes: int32(room.GetInactivityTimeout() / time.Minute),
+		HostSuccession:           domainHostSuccessionToProto(policy),
+		DeputyId:                 deputyID,
+		RotateFacilitator:        room.RotatesFacilitator(),
+		KeepDisconnected:         room.KeepsDisconnected(),
+		Visibility:               domainVisibilityToProto(room.GetVisibility()),
+		HasPasscode:              room.HasPasscode(),
+		Locked:                   room.IsLocked(),
+		WaitingRoom:              room.HasWaitingRoom(),
+		PendingParticipants:      pending,
+		MaxVoters:                int32(maxVoters),
+		MaxSpectators:            int32(maxSpectators),
+		HasViewToken:             room.HasViewToken(),
+	}
+}
+
+func domainSnapshotToProto(snapshot *primary.RoomSnapshot) *esteemedv1.RoomSnapshot {
+	room := domainRoomToProto(snapshot.Room, snapshot.CanManage)
+
+	voteStatus := make([]*esteemedv1.Vote, 0, len(snapshot.VoteStatus))
+	for _, vote := range snapshot.VoteStatus {
+		voteStatus = append(voteStatus, domainVoteToProto(vote))
+	}
+
+	var summary *esteemedv1.VoteSummary
+	if snapshot.Summary != nil {
+		summary = domainSummaryToProto(snapshot.Summary)
+	}
+
+	return &esteemedv1.RoomSnapshot{
+		Room:          room,
+		VoteStatus:    voteStatus,
+		Summary:       summary,
+		ParticipantId: snapshot.ParticipantID,
+		IsViewer:      snapshot.IsViewer,
+	}
+}
+
+func domainRoomStateEventToProto(event primary.RoomStateEvent) *esteemedv1.RoomStateEvent {
+	protoEvent := &esteemedv1.RoomStateEvent{Sequence: event.Sequence, Epoch: event.Epoch, Resync: event.Resync}
+
+	switch {
+	case event.Snapshot != nil:
+		protoEvent.Event = &esteemedv1.RoomStateEvent_Snapshot{Snapshot: domainSnapshotToProto(event.Snapshot)}
+	case event.Room != nil:
+		protoEvent.Event = &esteemedv1.RoomStateEvent_RoomEvent{RoomEvent: domainRoomEventToProto(*event.Room)}
+	case event.Vote != nil:
+		protoEvent.Event = &esteemedv1.RoomStateEvent_VoteEvent{VoteEvent: domainVoteEventToProto(*event.Vote)}
+	}
+
+	return protoEvent
+}
+
+func domainParticipantToProto(p *domain.Participant) *esteemedv1.Participant {
+	return &esteemedv1.Participant{
+		Id:             p.ID,
+		Name:           p.Name,
+		IsHost:         p.IsHost,
+		IsConnected:    p.IsConnected,
+		JoinedAt:       p.JoinedAt.Unix(),
+		IsSpectator:    p.IsSpectator,
+		BreakoutRoomId: p.BreakoutID,
+		IsPlaceholder:  p.IsPlaceholder,
+		Role:           domainRoleToProto(p.Role()),
+		AvatarSeed:     p.AvatarSeed,
+		Color:          p.Color,
+	}
+}
+
+func protoDotVoteConfigToDomain(config *esteemedv1.DotVoteConfig) (*domain.DotVoteConfig, error) {
+	if config == nil {
+		return nil, domain.ErrTooFewDotOptions
+	}
+
+	return domain.NewDotVoteConfig(config.Options, int(config.DotsPerParticipant))
+}
+
+func domainDotVoteConfigToProto(config *domain.DotVoteConfig) *esteemedv1.DotVoteConfig {
+	if config == nil {
+		return nil
+	}
+
+	return &esteemedv1.DotVoteConfig{
+		Options:            config.Options,
+		DotsPerParticipant: int32(config.DotsPerParticipant),
+	}
+}
+
+func domainModeToProto(mode domain.RoomMode) esteemedv1.RoomMode {
+	switch mode {
+	case domain.RoomModeEstimation:
+		return esteemedv1.RoomMode_ROOM_MODE_ESTIMATION
+	case domain.RoomModeDotVoting:
+		return esteemedv1.RoomMode_ROOM_MODE_DOT_VOTING
+	default:
+		return esteemedv1.RoomMode_ROOM_MODE_UNSPECIFIED
 	}
 }
 
@@ -308,21 +898,143 @@ func domainStateToProto(state domain.RoomState) esteemedv1.RoomState {
 	}
 }
 
+// unixOrZero converts a time to a Unix timestamp, keeping the zero time as 0
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+func domainTransitionToProto(transition domain.Transition) esteemedv1.RoomTransition {
+	switch transition {
+	case domain.TransitionStart:
+		return esteemedv1.RoomTransition_ROOM_TRANSITION_START
+	case domain.TransitionReveal:
+		return esteemedv1.RoomTransition_ROOM_TRANSITION_REVEAL
+	case domain.TransitionReset:
+		return esteemedv1.RoomTransition_ROOM_TRANSITION_RESET
+	default:
+		return esteemedv1.RoomTransition_ROOM_TRANSITION_UNSPECIFIED
+	}
+}
+
+func protoRoleToDomain(role esteemedv1.ParticipantRole) (domain.Role, error) {
+	switch role {
+	case esteemedv1.ParticipantRole_PARTICIPANT_ROLE_FACILITATOR:
+		return domain.RoleFacilitator, nil
+	case esteemedv1.ParticipantRole_PARTICIPANT_ROLE_VOTER:
+		return domain.RoleVoter, nil
+	case esteemedv1.ParticipantRole_PARTICIPANT_ROLE_SPECTATOR:
+		return domain.RoleSpectator, nil
+	default:
+		return 0, domain.ErrInvalidRole
+	}
+}
+
+func domainRoleToProto(role domain.Role) esteemedv1.ParticipantRole {
+	switch role {
+	case domain.RoleOwner:
+		return esteemedv1.ParticipantRole_PARTICIPANT_ROLE_OWNER
+	case domain.RoleFacilitator:
+		return esteemedv1.ParticipantRole_PARTICIPANT_ROLE_FACILITATOR
+	case domain.RoleVoter:
+		return esteemedv1.ParticipantRole_PARTICIPANT_ROLE_VOTER
+	case domain.RoleSpectator:
+		return esteemedv1.ParticipantRole_PARTICIPANT_ROLE_SPECTATOR
+	default:
+		return esteemedv1.ParticipantRole_PARTICIPANT_ROLE_UNSPECIFIED
+	}
+}
+
+func protoVisibilityToDomain(visibility esteemedv1.RoomVisibility) domain.Visibility {
+	switch visibility {
+	case esteemedv1.RoomVisibility_ROOM_VISIBILITY_UNLISTED:
+		return domain.VisibilityUnlisted
+	case esteemedv1.RoomVisibility_ROOM_VISIBILITY_PRIVATE:
+		return domain.VisibilityPrivate
+	default:
+		return domain.VisibilityPublic
+	}
+}
+
+func domainVisibilityToProto(visibility domain.Visibility) esteemedv1.RoomVisibility {
+	switch visibility {
+	case domain.VisibilityUnlisted:
+		return esteemedv1.RoomVisibility_ROOM_VISIBILITY_UNLISTED
+	case domain.VisibilityPrivate:
+		return esteemedv1.RoomVisibility_ROOM_VISIBILITY_PRIVATE
+	default:
+		return esteemedv1.RoomVisibility_ROOM_VISIBILITY_PUBLIC
+	}
+}
+
+func protoHostSuccessionToDomain(policy esteemedv1.HostSuccession) domain.HostSuccession {
+	switch policy {
+	case esteemedv1.HostSuccession_HOST_SUCCESSION_RESTORE_ORIGINAL:
+		return domain.HostSuccessionRestoreOriginal
+	case esteemedv1.HostSuccession_HOST_SUCCESSION_MANUAL_CLAIM:
+		return domain.HostSuccessionManualClaim
+	case esteemedv1.HostSuccession_HOST_SUCCESSION_DEPUTY:
+		return domain.HostSuccessionDeputy
+	default:
+		return domain.HostSuccessionEarliestJoiner
+	}
+}
+
+func domainHostSuccessionToProto(policy domain.HostSuccession) esteemedv1.HostSuccession {
+	switch policy {
+	case domain.HostSuccessionRestoreOriginal:
+		return esteemedv1.HostSuccession_HOST_SUCCESSION_RESTORE_ORIGINAL
+	case domain.HostSuccessionManualClaim:
+		return esteemedv1.HostSuccession_HOST_SUCCESSION_MANUAL_CLAIM
+	case domain.HostSuccessionDeputy:
+		return esteemedv1.HostSuccession_HOST_SUCCESSION_DEPUTY
+	default:
+		return esteemedv1.HostSuccession_HOST_SUCCESSION_EARLIEST_JOINER
+	}
+}
+
+func domainHostChangeReasonToProto(reason domain.HostChangeReason) esteemedv1.HostChangeReason {
+	switch reason {
+	case domain.HostChangeTransferred:
+		return esteemedv1.HostChangeReason_HOST_CHANGE_REASON_TRANSFERRED
+	case domain.HostChangeSucceeded:
+		return esteemedv1.HostChangeReason_HOST_CHANGE_REASON_SUCCEEDED
+	case domain.HostChangeDeputy:
+		return esteemedv1.HostChangeReason_HOST_CHANGE_REASON_DEPUTY
+	case domain.HostChangeRestored:
+		return esteemedv1.HostChangeReason_HOST_CHANGE_REASON_RESTORED
+	case domain.HostChangeVacated:
+		return esteemedv1.HostChangeReason_HOST_CHANGE_REASON_VACATED
+	case domain.HostChangeClaimed:
+		return esteemedv1.HostChangeReason_HOST_CHANGE_REASON_CLAIMED
+	default:
+		return esteemedv1.HostChangeReason_HOST_CHANGE_REASON_UNSPECIFIED
+	}
+}
+
+func domainCloseReasonToProto(reason domain.CloseReason) esteemedv1.CloseReason {
+	switch reason {
+	case domain.CloseReasonAllLeft:
+		return esteemedv1.CloseReason_CLOSE_REASON_ALL_LEFT
+	case domain.CloseReasonInactivity:
+		return esteemedv1.CloseReason_CLOSE_REASON_INACTIVITY
+	case domain.CloseReasonBreakoutClosed:
+		return esteemedv1.CloseReason_CLOSE_REASON_BREAKOUT_CLOSED
+	default:
+		return esteemedv1.CloseReason_CLOSE_REASON_UNSPECIFIED
+	}
+}
+
 func domainRoomEventToProto(event primary.RoomEvent) *esteemedv1.RoomEvent {
-	protoEvent := &esteemedv1.RoomEvent{}
+	protoEvent := &esteemedv1.RoomEvent{Sequence: event.Sequence, Epoch: event.Epoch}
 
 	switch event.Type {
 	case primary.RoomEventParticipantJoined:
 		protoEvent.Event = &esteemedv1.RoomEvent_ParticipantJoined{
 			ParticipantJoined: &esteemedv1.ParticipantJoined{
-				Participant: &esteemedv1.Participant{
-					Id:          event.Participant.ID,
-					Name:        event.Participant.Name,
-					IsHost:      event.Participant.IsHost,
-					IsConnected: event.Participant.IsConnected,
-					JoinedAt:    event.Participant.JoinedAt.Unix(),
-					IsSpectator: event.Participant.IsSpectator,
-				},
+				Participant: domainParticipantToProto(event.Participant),
 			},
 		}
 	case primary.RoomEventParticipantLeft:
@@ -340,13 +1052,146 @@ func domainRoomEventToProto(event primary.RoomEvent) *esteemedv1.RoomEvent {
 	case primary.RoomEventClosed:
 		protoEvent.Event = &esteemedv1.RoomEvent_RoomClosed{
 			RoomClosed: &esteemedv1.RoomClosed{
-				Reason: event.Reason,
+				Reason:      event.Reason,
+				CloseReason: domainCloseReasonToProto(event.CloseReason),
 			},
 		}
 	case primary.RoomEventHostChanged:
+		hostChanged := &esteemedv1.HostChanged{
+			NewHostId: event.NewHostID,
+		}
+		if change := event.HostChange; change != nil {
+			hostChanged.PreviousHostId = change.PreviousHostID
+			hostChanged.Reason = domainHostChangeReasonToProto(change.Reason)
+			hostChanged.ReturnDeadline = unixOrZero(change.ReturnDeadline)
+		}
 		protoEvent.Event = &esteemedv1.RoomEvent_HostChanged{
-			HostChanged: &esteemedv1.HostChanged{
-				NewHostId: event.NewHostID,
+			HostChanged: hostChanged,
+		}
+	case primary.RoomEventParticipantUpdated:
+		protoEvent.Event = &esteemedv1.RoomEvent_ParticipantUpdated{
+			ParticipantUpdated: &esteemedv1.ParticipantUpdated{
+				Participant: domainParticipantToProto(event.Participant),
+			},
+		}
+	case primary.RoomEventFacilitatorRotationChanged:
+		protoEvent.Event = &esteemedv1.RoomEvent_FacilitatorRotationChanged{
+			FacilitatorRotationChanged: &esteemedv1.FacilitatorRotationChanged{
+				Enabled: event.Enabled,
+			},
+		}
+	case primary.RoomEventVisibilityChanged:
+		protoEvent.Event = &esteemedv1.RoomEvent_VisibilityChanged{
+			VisibilityChanged: &esteemedv1.VisibilityChanged{
+				Visibility: domainVisibilityToProto(event.Visibility),
+			},
+		}
+	case primary.RoomEventPasscodeChanged:
+		protoEvent.Event = &esteemedv1.RoomEvent_PasscodeChanged{
+			PasscodeChanged: &esteemedv1.PasscodeChanged{
+				HasPasscode: event.Enabled,
+			},
+		}
+	case primary.RoomEventParticipantRemoved:
+		protoEvent.Event = &esteemedv1.RoomEvent_ParticipantRemoved{
+			ParticipantRemoved: &esteemedv1.ParticipantRemoved{
+				ParticipantId: event.ParticipantID,
+				Banned:        event.Banned,
+			},
+		}
+	case primary.RoomEventParticipantLimitsChanged:
+		protoEvent.Event = &esteemedv1.RoomEvent_ParticipantLimitsChanged{
+			ParticipantLimitsChanged: &esteemedv1.ParticipantLimitsChanged{
+				MaxVoters:     int32(event.MaxVoters),
+				MaxSpectators: int32(event.MaxSpectators),
+			},
+		}
+	case primary.RoomEventViewTokenRevoked:
+		protoEvent.Event = &esteemedv1.RoomEvent_ViewTokenRevoked{
+			ViewTokenRevoked: &esteemedv1.ViewTokenRevoked{},
+		}
+	case primary.RoomEventResync:
+		protoEvent.Event = &esteemedv1.RoomEvent_Resync{
+			Resync: &esteemedv1.Resync{
+				Snapshot: domainSnapshotToProto(event.Snapshot),
+			},
+		}
+	case primary.RoomEventLockChanged:
+		protoEvent.Event = &esteemedv1.RoomEvent_LockChanged{
+			LockChanged: &esteemedv1.RoomLockChanged{
+				Locked: event.Enabled,
+			},
+		}
+	case primary.RoomEventWaitingRoomChanged:
+		protoEvent.Event = &esteemedv1.RoomEvent_WaitingRoomChanged{
+			WaitingRoomChanged: &esteemedv1.WaitingRoomChanged{
+				Enabled: event.Enabled,
+			},
+		}
+	case primary.RoomEventJoinRequested:
+		protoEvent.Event = &esteemedv1.RoomEvent_JoinRequested{
+			JoinRequested: &esteemedv1.JoinRequested{
+				Participant: domainParticipantToProto(event.Participant),
+			},
+		}
+	case primary.RoomEventJoinAdmitted:
+		protoEvent.Event = &esteemedv1.RoomEvent_JoinAdmitted{
+			JoinAdmitted: &esteemedv1.JoinAdmitted{
+				Participant: domainParticipantToProto(event.Participant),
+			},
+		}
+	case primary.RoomEventJoinRejected:
+		protoEvent.Event = &esteemedv1.RoomEvent_JoinRejected{
+			JoinRejected: &esteemedv1.JoinRejected{
+				ParticipantId: event.ParticipantID,
+			},
+		}
+	case primary.RoomEventHostSuccessionChanged:
+		protoEvent.Event = &esteemedv1.RoomEvent_HostSuccessionChanged{
+			HostSuccessionChanged: &esteemedv1.HostSuccessionChanged{
+				HostSuccession: domainHostSuccessionToProto(event.HostSuccession),
+				DeputyId:       event.DeputyID,
+			},
+		}
+	case primary.RoomEventParticipantMoved:
+		protoEvent.Event = &esteemedv1.RoomEvent_ParticipantMoved{
+			ParticipantMoved: &esteemedv1.ParticipantMoved{
+				ParticipantId: event.ParticipantID,
+				RoomId:        event.TargetRoomID,
+				RoomName:      event.TargetRoomName,
+			},
+		}
+	case primary.RoomEventBreakoutClosed:
+		protoEvent.Event = &esteemedv1.RoomEvent_BreakoutClosed{
+			BreakoutClosed: &esteemedv1.BreakoutClosed{
+				BreakoutRoomId: event.TargetRoomID,
+				RoundsMerged:   int32(event.RoundsMerged),
+			},
+		}
+	case primary.RoomEventDeadlineChanged:
+		protoEvent.Event = &esteemedv1.RoomEvent_DeadlineChanged{
+			DeadlineChanged: &esteemedv1.DeadlineChanged{
+				VotingDeadline: unixOrZero(event.Deadline),
+			},
+		}
+	case primary.RoomEventExpiryWarning:
+		protoEvent.Event = &esteemedv1.RoomEvent_ExpiryWarning{
+			ExpiryWarning: &esteemedv1.ExpiryWarning{
+				ExpiresAt: unixOrZero(event.ExpiresAt),
+			},
+		}
+	case primary.RoomEventKeptAlive:
+		protoEvent.Event = &esteemedv1.RoomEvent_KeptAlive{
+			KeptAlive: &esteemedv1.KeptAlive{
+				ParticipantId: event.ParticipantID,
+				ExpiresAt:     unixOrZero(event.ExpiresAt),
+			},
+		}
+	case primary.RoomEventTransitionUndone:
+		protoEvent.Event = &esteemedv1.RoomEvent_TransitionUndone{
+			TransitionUndone: &esteemedv1.TransitionUndone{
+				Transition: domainTransitionToProto(event.Transition),
+				NewState:   domainStateToProto(event.NewState),
 			},
 		}
 	}
@@ -22,6 +22,20 @@ func NewRoomRepository() *RoomRepository {
 	}
 }
 
+// Create persists a new room if its name is not in use
+func (r *RoomRepository) Create(ctx context.Context, room *domain.Room) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.byName[room.Name]; exists {
+		return domain.ErrRoomNameTaken
+	}
+
+	r.rooms[room.ID] = room
+	r.byName[room.Name] = room.ID
+	return nil
+}
+
 // Save persists a room
 func (r *RoomRepository) Save(ctx context.Context, room *domain.Room) error {
 	r.mu.Lock()
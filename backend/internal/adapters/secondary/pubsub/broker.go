@@ -2,12 +2,22 @@ package pubsub
 
 import (
 	"context"
+	"math/rand/v2"
 	"sync"
 
+	"github.com/vicmanager/esteemed/backend/internal/domain"
 	"github.com/vicmanager/esteemed/backend/internal/ports/primary"
 )
 
-// Broker is a channel-based pub/sub implementation
+// replayBufferSize is how many recent events per room are kept for subscribers
+// that fell behind or reconnect
+const replayBufferSize = 256
+
+// Broker is a channel-based pub/sub implementation. Every room and vote event
+// is numbered with a per-room sequence, delivered to all subscribers in that
+// order, and kept in a bounded replay buffer. Sequences live in memory only, so
+// each gets a random epoch when it starts; a restarted server or a forgotten
+// room starts a new one.
 type Broker struct {
 	mu sync.RWMutex
 
@@ -16,29 +26,46 @@ type Broker struct {
 
 	// Vote event subscribers: roomID -> slice of channels
 	voteSubs map[string][]chan primary.VoteEvent
+
+	// Combined, sequenced subscribers: roomID -> slice of channels
+	stateSubs map[string][]chan primary.RoomStateEvent
+
+	// Last sequence number handed out per room
+	sequences map[string]uint64
+
+	// Epoch of each room's sequence
+	epochs map[string]uint64
+
+	// Most recent events per room, oldest first
+	history map[string][]primary.RoomStateEvent
 }
 
 // NewBroker creates a new pub/sub broker
 func NewBroker() *Broker {
 	return &Broker{
-		roomSubs: make(map[string][]chan primary.RoomEvent),
-		voteSubs: make(map[string][]chan primary.VoteEvent),
+		roomSubs:  make(map[string][]chan primary.RoomEvent),
+		voteSubs:  make(map[string][]chan primary.VoteEvent),
+		stateSubs: make(map[string][]chan primary.RoomStateEvent),
+		sequences: make(map[string]uint64),
+		epochs:    make(map[string]uint64),
+		history:   make(map[string][]primary.RoomStateEvent),
 	}
 }
 
 // PublishRoomEvent publishes a room event to all subscribers
 func (b *Broker) PublishRoomEvent(ctx context.Context, roomID string, event primary.RoomEvent) error {
-	b.mu.RLock()
-	subs := b.roomSubs[roomID]
-	b.mu.RUnlock()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	event.Epoch, event.Sequence = b.publishState(roomID, primary.RoomStateEvent{Room: &event})
 
-	for _, ch := range subs {
+	for _, ch := range b.roomSubs[roomID] {
 		select {
 		case ch <- event:
 		case <-ctx.Done():
 			return ctx.Err()
 		default:
-			// Channel full, skip this subscriber (they'll catch up)
+			// Channel full, skip this subscriber (the gap in sequence numbers shows it)
 		}
 	}
 	return nil
@@ -46,22 +73,140 @@ func (b *Broker) PublishRoomEvent(ctx context.Context, roomID string, event prim
 
 // PublishVoteEvent publishes a vote event to all subscribers
 func (b *Broker) PublishVoteEvent(ctx context.Context, roomID string, event primary.VoteEvent) error {
-	b.mu.RLock()
-	subs := b.voteSubs[roomID]
-	b.mu.RUnlock()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	event.Epoch, event.Sequence = b.publishState(roomID, primary.RoomStateEvent{Vote: &event})
 
-	for _, ch := range subs {
+	for _, ch := range b.voteSubs[roomID] {
 		select {
 		case ch <- event:
 		case <-ctx.Done():
 			return ctx.Err()
 		default:
-			// Channel full, skip this subscriber
+			// Channel full, skip this subscriber (the gap in sequence numbers shows it)
 		}
 	}
 	return nil
 }
 
+// publishState numbers a room or vote event, keeps it for replay and hands it
+// to the combined subscribers (caller must hold the lock). Publishing happens
+// under one lock, so every subscriber sees events in sequence order. The kept
+// event is a copy, so later changes to the room do not rewrite the buffer.
+func (b *Broker) publishState(roomID string, event primary.RoomStateEvent) (epoch, sequence uint64) {
+	b.sequences[roomID]++
+	event.Sequence = b.sequences[roomID]
+	event.Epoch = b.epoch(roomID)
+	if event.Room != nil {
+		event.Room = copyRoomEvent(*event.Room)
+		event.Room.Sequence, event.Room.Epoch = event.Sequence, event.Epoch
+	}
+	if event.Vote != nil {
+		event.Vote = copyVoteEvent(*event.Vote)
+		event.Vote.Sequence, event.Vote.Epoch = event.Sequence, event.Epoch
+	}
+
+	history := append(b.history[roomID], event)
+	if len(history) > replayBufferSize {
+		history = history[len(history)-replayBufferSize:]
+	}
+	b.history[roomID] = history
+
+	for _, ch := range b.stateSubs[roomID] {
+		select {
+		case ch <- event:
+		default:
+			// Channel full, skip this subscriber (the gap in sequence numbers shows it)
+		}
+	}
+	return event.Epoch, event.Sequence
+}
+
+// epoch returns the epoch of a room's sequence, starting one if the room has
+// none (caller must hold the lock). Zero is never used, so it can stand for
+// no epoch.
+func (b *Broker) epoch(roomID string) uint64 {
+	if epoch, ok := b.epochs[roomID]; ok {
+		return epoch
+	}
+
+	epoch := rand.Uint64()
+	for epoch == 0 {
+		epoch = rand.Uint64()
+	}
+	b.epochs[roomID] = epoch
+	return epoch
+}
+
+// ReplayRoomState returns a room's buffered events after the given sequence
+// number of an epoch, along with the last sequence number handed out. Reports
+// false when the buffer no longer holds all of them, the sequence was never
+// handed out, or it belongs to another epoch.
+func (b *Broker) ReplayRoomState(_ context.Context, roomID string, epoch, after uint64) (events []primary.RoomStateEvent, sequence uint64, ok bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	sequence = b.sequences[roomID]
+	if epoch != b.epochs[roomID] || after > sequence {
+		return nil, sequence, false
+	}
+
+	history := b.history[roomID]
+	if after == sequence {
+		return nil, sequence, true
+	}
+	if len(history) == 0 || history[0].Sequence > after+1 {
+		return nil, sequence, false
+	}
+
+	// Sequence numbers are consecutive, so the first missed event sits at a known offset
+	missed := history[after+1-history[0].Sequence:]
+	events = make([]primary.RoomStateEvent, len(missed))
+	copy(events, missed)
+	return events, sequence, true
+}
+
+// CloseSubscriptions ends all room and vote event subscriptions for a room.
+// The room's sequence numbers and replay buffer are kept, so clients can
+// resume where they left off.
+func (b *Broker) CloseSubscriptions(_ context.Context, roomID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closeSubscriptions(roomID)
+}
+
+// ForgetRoom ends a closed room's subscriptions and drops its sequence numbers,
+// epoch and replay buffer. Numbering starts over in a new epoch if the room
+// is used again.
+func (b *Broker) ForgetRoom(_ context.Context, roomID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closeSubscriptions(roomID)
+	delete(b.sequences, roomID)
+	delete(b.epochs, roomID)
+	delete(b.history, roomID)
+}
+
+// closeSubscriptions ends a room's subscriptions (caller must hold the lock)
+func (b *Broker) closeSubscriptions(roomID string) {
+	// Unsubscribe functions no longer find these channels, so they are closed only once
+	for _, ch := range b.roomSubs[roomID] {
+		close(ch)
+	}
+	for _, ch := range b.voteSubs[roomID] {
+		close(ch)
+	}
+	for _, ch := range b.stateSubs[roomID] {
+		close(ch)
+	}
+
+	delete(b.roomSubs, roomID)
+	delete(b.voteSubs, roomID)
+	delete(b.stateSubs, roomID)
+}
+
 // SubscribeRoomEvents subscribes to room events for a specific room
 func (b *Broker) SubscribeRoomEvents(_ context.Context, roomID string) (events <-chan primary.RoomEvent, unsubscribe func()) {
 	ch := make(chan primary.RoomEvent, 10) // Buffer to prevent blocking
@@ -125,3 +270,77 @@ func (b *Broker) SubscribeVoteEvents(_ context.Context, roomID string) (events <
 	events = ch
 	return
 }
+
+// SubscribeRoomState subscribes to a room's room and vote events in the order
+// they were published, numbered by sequence. Returns the sequence's epoch and
+// the last sequence number handed out before the subscription, so a snapshot
+// taken afterwards can be matched to the events that follow it.
+func (b *Broker) SubscribeRoomState(_ context.Context, roomID string) (events <-chan primary.RoomStateEvent, epoch, sequence uint64, unsubscribe func()) {
+	ch := make(chan primary.RoomStateEvent, 32) // Larger buffer: carries both room and vote events
+
+	b.mu.Lock()
+	b.stateSubs[roomID] = append(b.stateSubs[roomID], ch)
+	epoch = b.epoch(roomID)
+	sequence = b.sequences[roomID]
+	b.mu.Unlock()
+
+	// Return unsubscribe function
+	unsubscribe = func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		subs := b.stateSubs[roomID]
+		for i, sub := range subs {
+			if sub == ch {
+				b.stateSubs[roomID] = append(subs[:i], subs[i+1:]...)
+				close(ch)
+				break
+			}
+		}
+
+		// Clean up empty subscription lists
+		if len(b.stateSubs[roomID]) == 0 {
+			delete(b.stateSubs, roomID)
+		}
+	}
+
+	events = ch
+	return
+}
+
+// copyRoomEvent copies a room event along with the participant and host change it points to
+func copyRoomEvent(event primary.RoomEvent) *primary.RoomEvent {
+	if event.Participant != nil {
+		participant := *event.Participant
+		event.Participant = &participant
+	}
+	if event.HostChange != nil {
+		change := *event.HostChange
+		event.HostChange = &change
+	}
+	return &event
+}
+
+// copyVoteEvent copies a vote event along with its summary
+func copyVoteEvent(event primary.VoteEvent) *primary.VoteEvent {
+	if event.Summary != nil {
+		summary := *event.Summary
+		summary.Votes = make([]*domain.Vote, len(event.Summary.Votes))
+		for i, v := range event.Summary.Votes {
+			vote := *v
+			vote.Dots = make([]*domain.DotAllocation, len(v.Dots))
+			for j, d := range v.Dots {
+				dot := *d
+				vote.Dots[j] = &dot
+			}
+			summary.Votes[i] = &vote
+		}
+		summary.Ranking = make([]*domain.DotRanking, len(event.Summary.Ranking))
+		for i, r := range event.Summary.Ranking {
+			rank := *r
+			summary.Ranking[i] = &rank
+		}
+		event.Summary = &summary
+	}
+	return &event
+}
@@ -0,0 +1,266 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+	"github.com/vicmanager/esteemed/backend/internal/ports/secondary"
+)
+
+// ArchiveRepository implements secondary.ArchiveRepository using SQLite.
+// Listing columns are stored alongside the full session as JSON, and the
+// hashes of the participants' session tokens in a table of their own so
+// listings can be narrowed to them.
+type ArchiveRepository struct {
+	db *sql.DB
+}
+
+// NewArchiveRepository creates a new SQLite-backed archive repository
+func NewArchiveRepository(dbPath string) (*ArchiveRepository, error) {
+	db, err := sql.Open("sqlite", dbPath+"?_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, err
+	}
+
+	// SQLite performs best with a single writer
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+	db.SetConnMaxLifetime(0)
+
+	repo := &ArchiveRepository{db: db}
+
+	if err := repo.initSchema(); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return repo, nil
+}
+
+// initSchema creates the necessary tables if they don't exist
+func (r *ArchiveRepository) initSchema() error {
+	schema := `
+		CREATE TABLE IF NOT EXISTS archived_sessions (
+			id TEXT PRIMARY KEY,
+			room_name TEXT NOT NULL,
+			mode INTEGER NOT NULL,
+			created_at INTEGER NOT NULL,
+			closed_at INTEGER NOT NULL,
+			close_reason INTEGER NOT NULL,
+			participant_count INTEGER NOT NULL,
+			round_count INTEGER NOT NULL,
+			data TEXT NOT NULL
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_archived_closed ON archived_sessions(closed_at);
+
+		CREATE TABLE IF NOT EXISTS archived_session_tokens (
+			session_id TEXT NOT NULL,
+			token_hash TEXT NOT NULL,
+			PRIMARY KEY (session_id, token_hash)
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_archived_tokens_hash ON archived_session_tokens(token_hash);
+	`
+	if _, err := r.db.Exec(schema); err != nil {
+		return err
+	}
+
+	// Archives written before visibility was recorded are treated as private
+	return r.addColumn("archived_sessions", "visibility", fmt.Sprintf("INTEGER NOT NULL DEFAULT %d", domain.VisibilityPrivate))
+}
+
+// addColumn adds a column to an existing table unless it is already there
+func (r *ArchiveRepository) addColumn(table, column, definition string) error {
+	rows, err := r.db.Query(fmt.Sprintf("SELECT name FROM pragma_table_info('%s')", table))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return err
+		}
+		if name == column {
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition))
+	return err
+}
+
+// Save persists an archived session, replacing any earlier archive of the same room
+func (r *ArchiveRepository) Save(ctx context.Context, session *domain.ArchivedSession) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `INSERT OR REPLACE INTO archived_sessions
+		(id, room_name, mode, visibility, created_at, closed_at, close_reason, participant_count, round_count, data)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	if _, err := tx.ExecContext(ctx, query,
+		session.ID,
+		session.RoomName,
+		int(session.Mode),
+		int(session.Visibility),
+		session.CreatedAt.Unix(),
+		session.ClosedAt.Unix(),
+		int(session.CloseReason),
+		len(session.Participants),
+		len(session.Rounds),
+		string(data),
+	); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM archived_session_tokens WHERE session_id = ?`, session.ID); err != nil {
+		return err
+	}
+	for _, p := range session.Participants {
+		if p.TokenHash == "" {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT OR IGNORE INTO archived_session_tokens (session_id, token_hash) VALUES (?, ?)`, session.ID, p.TokenHash); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Get retrieves an archived session by its room ID
+func (r *ArchiveRepository) Get(ctx context.Context, id string) (*domain.ArchivedSession, error) {
+	var data string
+	row := r.db.QueryRowContext(ctx, `SELECT data FROM archived_sessions WHERE id = ?`, id)
+	if err := row.Scan(&data); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrSessionNotFound
+		}
+		return nil, err
+	}
+
+	session := &domain.ArchivedSession{}
+	if err := json.Unmarshal([]byte(data), session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// List returns summaries of archived sessions matching the filter, most recently closed first
+func (r *ArchiveRepository) List(ctx context.Context, filter domain.ArchiveFilter) ([]*domain.ArchivedSessionSummary, error) {
+	var conditions []string
+	var args []any
+
+	if filter.Query != "" {
+		conditions = append(conditions, `room_name LIKE ? ESCAPE '\'`)
+		args = append(args, "%"+escapeLike(filter.Query)+"%")
+	}
+	if !filter.From.IsZero() {
+		conditions = append(conditions, "closed_at >= ?")
+		args = append(args, filter.From.Unix())
+	}
+	if !filter.To.IsZero() {
+		conditions = append(conditions, "closed_at <= ?")
+		args = append(args, filter.To.Unix())
+	}
+	if !filter.IncludePrivate {
+		conditions = append(conditions, "visibility != ?")
+		args = append(args, int(domain.VisibilityPrivate))
+	}
+	if filter.TokenHashes != nil {
+		if len(filter.TokenHashes) == 0 {
+			return nil, nil
+		}
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(filter.TokenHashes)), ", ")
+		conditions = append(conditions, "id IN (SELECT session_id FROM archived_session_tokens WHERE token_hash IN ("+placeholders+"))")
+		for _, hash := range filter.TokenHashes {
+			args = append(args, hash)
+		}
+	}
+
+	query := `SELECT id, room_name, mode, created_at, closed_at, close_reason, participant_count, round_count FROM archived_sessions`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY closed_at DESC LIMIT ?"
+	args = append(args, filter.Limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []*domain.ArchivedSessionSummary
+	for rows.Next() {
+		summary := &domain.ArchivedSessionSummary{}
+		var mode, reason int
+		var createdAt, closedAt int64
+
+		if err := rows.Scan(&summary.ID, &summary.RoomName, &mode, &createdAt, &closedAt, &reason, &summary.ParticipantCount, &summary.RoundCount); err != nil {
+			return nil, err
+		}
+
+		summary.Mode = domain.RoomMode(mode)
+		summary.CloseReason = domain.CloseReason(reason)
+		summary.CreatedAt = time.Unix(createdAt, 0)
+		summary.ClosedAt = time.Unix(closedAt, 0)
+		sessions = append(sessions, summary)
+	}
+
+	return sessions, rows.Err()
+}
+
+// DeleteClosedBefore removes sessions closed before the given time
+func (r *ArchiveRepository) DeleteClosedBefore(ctx context.Context, before time.Time) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM archived_session_tokens
+		WHERE session_id IN (SELECT id FROM archived_sessions WHERE closed_at < ?)`, before.Unix()); err != nil {
+		return 0, err
+	}
+	result, err := tx.ExecContext(ctx, `DELETE FROM archived_sessions WHERE closed_at < ?`, before.Unix())
+	if err != nil {
+		return 0, err
+	}
+	removed, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return removed, tx.Commit()
+}
+
+// Close closes the database connection
+func (r *ArchiveRepository) Close() error {
+	return r.db.Close()
+}
+
+// escapeLike escapes LIKE wildcards so the query matches literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// Ensure ArchiveRepository implements the interface
+var _ secondary.ArchiveRepository = (*ArchiveRepository)(nil)
@@ -0,0 +1,112 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+)
+
+func TestArchive_SaveListGetAndPrune(t *testing.T) {
+	ctx := context.Background()
+	repo, err := NewArchiveRepository(filepath.Join(t.TempDir(), "archive.db"))
+	if err != nil {
+		t.Fatalf("failed to create repo: %v", err)
+	}
+	defer repo.Close()
+
+	now := time.Now()
+	sessions := []*domain.ArchivedSession{
+		{ID: "r1", RoomName: "tuesday-refinement", ClosedAt: now.Add(-7 * 24 * time.Hour), CloseReason: domain.CloseReasonInactivity,
+			Deck:         []string{"1", "2", "3"},
+			Participants: []*domain.ArchivedParticipant{{Name: "Alice", WasHost: true}, {Name: "Bob"}},
+			Rounds: []*domain.RoundResult{{RevealedAt: now, Summary: &domain.VoteSummary{
+				Votes:   []*domain.Vote{{ParticipantName: "Alice", Value: "3", HasVoted: true}},
+				Average: "3",
+			}}}},
+		{ID: "r2", RoomName: "daily_sync", ClosedAt: now.Add(-time.Hour)},
+		{ID: "r3", RoomName: "old-planning", ClosedAt: now.Add(-100 * 24 * time.Hour)},
+	}
+	for _, s := range sessions {
+		if err := repo.Save(ctx, s); err != nil {
+			t.Fatalf("failed to save: %v", err)
+		}
+	}
+
+	list, err := repo.List(ctx, domain.ArchiveFilter{Limit: 10})
+	if err != nil {
+		t.Fatalf("failed to list: %v", err)
+	}
+	if len(list) != 3 || list[0].ID != "r2" || list[2].ID != "r3" {
+		t.Fatalf("expected sessions most recent first, got %v", list)
+	}
+
+	// Underscore is matched literally, not as a wildcard
+	list, _ = repo.List(ctx, domain.ArchiveFilter{Query: "Y_S", Limit: 10})
+	if len(list) != 1 || list[0].ID != "r2" {
+		t.Errorf("expected only daily_sync, got %v", list)
+	}
+
+	list, _ = repo.List(ctx, domain.ArchiveFilter{From: now.Add(-8 * 24 * time.Hour), To: now.Add(-6 * 24 * time.Hour), Limit: 10})
+	if len(list) != 1 || list[0].ParticipantCount != 2 || list[0].RoundCount != 1 {
+		t.Errorf("expected last week's session with counts, got %v", list)
+	}
+
+	session, err := repo.Get(ctx, "r1")
+	if err != nil {
+		t.Fatalf("failed to get: %v", err)
+	}
+	if session.CloseReason != domain.CloseReasonInactivity || session.Rounds[0].Summary.Votes[0].Value != "3" {
+		t.Errorf("session not restored: %+v", session)
+	}
+	if _, err := repo.Get(ctx, "missing"); err != domain.ErrSessionNotFound {
+		t.Errorf("expected ErrSessionNotFound, got %v", err)
+	}
+
+	deleted, err := repo.DeleteClosedBefore(ctx, now.Add(-90*24*time.Hour))
+	if err != nil || deleted != 1 {
+		t.Errorf("expected 1 pruned session, got %d (%v)", deleted, err)
+	}
+}
+
+func TestArchive_ListByTokenHashAndVisibility(t *testing.T) {
+	ctx := context.Background()
+	repo, err := NewArchiveRepository(filepath.Join(t.TempDir(), "archive.db"))
+	if err != nil {
+		t.Fatalf("failed to create repo: %v", err)
+	}
+	defer repo.Close()
+
+	alice, bob := domain.HashSessionToken("alice"), domain.HashSessionToken("bob")
+	now := time.Now()
+	sessions := []*domain.ArchivedSession{
+		{ID: "r1", RoomName: "team-a", ClosedAt: now, Participants: []*domain.ArchivedParticipant{{Name: "Alice", TokenHash: alice}}},
+		{ID: "r2", RoomName: "team-b", ClosedAt: now, Participants: []*domain.ArchivedParticipant{{Name: "Bob", TokenHash: bob}}},
+		{ID: "r3", RoomName: "secret", ClosedAt: now, Visibility: domain.VisibilityPrivate, Participants: []*domain.ArchivedParticipant{{Name: "Alice", TokenHash: alice}}},
+	}
+	for _, s := range sessions {
+		if err := repo.Save(ctx, s); err != nil {
+			t.Fatalf("failed to save: %v", err)
+		}
+	}
+
+	if list, _ := repo.List(ctx, domain.ArchiveFilter{TokenHashes: []string{alice}, Limit: 10}); len(list) != 1 || list[0].ID != "r1" {
+		t.Errorf("expected only Alice's non-private session, got %v", list)
+	}
+	if list, _ := repo.List(ctx, domain.ArchiveFilter{TokenHashes: []string{alice, bob}, IncludePrivate: true, Limit: 10}); len(list) != 3 {
+		t.Errorf("expected all three sessions, got %v", list)
+	}
+	if list, _ := repo.List(ctx, domain.ArchiveFilter{TokenHashes: []string{}, Limit: 10}); len(list) != 0 {
+		t.Errorf("expected nothing without tokens, got %v", list)
+	}
+	if list, _ := repo.List(ctx, domain.ArchiveFilter{TokenHashes: []string{"alice"}, Limit: 10}); len(list) != 0 {
+		t.Errorf("expected a raw token to match nothing, got %v", list)
+	}
+
+	session, _ := repo.Get(ctx, "r3")
+	if session.Visibility != domain.VisibilityPrivate || !session.HasParticipant("alice") || session.HasParticipant("bob") || session.HasParticipant(alice) {
+		t.Errorf("expected visibility kept and only Alice's token to match, got %+v", session)
+	}
+}
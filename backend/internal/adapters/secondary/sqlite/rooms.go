@@ -0,0 +1,249 @@
+package sqlite
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+	"github.com/vicmanager/esteemed/backend/internal/ports/secondary"
+)
+
+// ErrNoRoomSecret is returned when the room store is opened without a secret
+var ErrNoRoomSecret = errors.New("room store secret is not set")
+
+// RoomRepository implements secondary.RoomRepository on top of a live room
+// cache. Persistent rooms are also written to SQLite when they change and
+// loaded back into the cache when the repository is opened, so they survive
+// restarts. Other rooms only live in the cache.
+//
+// Records hold session tokens, so they are stored encrypted with AES-GCM under
+// a key derived from the deployment's secret.
+type RoomRepository struct {
+	db    *sql.DB
+	aead  cipher.AEAD
+	cache secondary.RoomRepository
+
+	mu      sync.Mutex
+	written map[string][sha256.Size]byte // Digest of each room's stored record, without its activity time
+}
+
+// NewRoomRepository opens the SQLite room store and loads its persistent rooms into the cache
+func NewRoomRepository(ctx context.Context, dbPath, secret string, cache secondary.RoomRepository) (*RoomRepository, error) {
+	if secret == "" {
+		return nil, ErrNoRoomSecret
+	}
+
+	key := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open("sqlite", dbPath+"?_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, err
+	}
+
+	// SQLite performs best with a single writer
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+	db.SetConnMaxLifetime(0)
+
+	repo := &RoomRepository{
+		db:      db,
+		aead:    aead,
+		cache:   cache,
+		written: make(map[string][sha256.Size]byte),
+	}
+
+	if err := repo.initSchema(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	if err := repo.load(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return repo, nil
+}
+
+// initSchema creates the necessary tables if they don't exist
+func (r *RoomRepository) initSchema() error {
+	schema := `
+		CREATE TABLE IF NOT EXISTS persistent_rooms (
+			id TEXT PRIMARY KEY,
+			updated_at INTEGER NOT NULL,
+			data BLOB NOT NULL
+		);
+	`
+	_, err := r.db.Exec(schema)
+	return err
+}
+
+// load restores every stored persistent room into the cache
+func (r *RoomRepository) load(ctx context.Context) error {
+	rows, err := r.db.QueryContext(ctx, `SELECT id, data FROM persistent_rooms`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id string
+		var sealed []byte
+		if err := rows.Scan(&id, &sealed); err != nil {
+			return err
+		}
+
+		record, err := r.open(id, sealed)
+		if err != nil {
+			return err
+		}
+		r.written[record.ID] = digest(record)
+
+		if err := r.cache.Save(ctx, domain.RestoreRoom(record)); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// seal encrypts a record, prefixing the ciphertext with its nonce. The room ID
+// is authenticated too, so a record cannot be moved to another row.
+func (r *RoomRepository) seal(record *domain.RoomRecord) ([]byte, error) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, r.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return r.aead.Seal(nonce, nonce, data, []byte(record.ID)), nil
+}
+
+// open decrypts the record of a room sealed by seal
+func (r *RoomRepository) open(id string, sealed []byte) (*domain.RoomRecord, error) {
+	size := r.aead.NonceSize()
+	if len(sealed) < size {
+		return nil, errors.New("stored room record is truncated")
+	}
+
+	data, err := r.aead.Open(nil, sealed[:size], sealed[size:], []byte(id))
+	if err != nil {
+		return nil, err
+	}
+
+	record := &domain.RoomRecord{}
+	if err := json.Unmarshal(data, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// digest fingerprints a record without its activity time, so saves that only
+// touch activity can be told apart from real changes
+func digest(record *domain.RoomRecord) [sha256.Size]byte {
+	lastActivity := record.LastActivityAt
+	record.LastActivityAt = time.Time{}
+	data, _ := json.Marshal(record)
+	record.LastActivityAt = lastActivity
+	return sha256.Sum256(data)
+}
+
+// store writes a persistent room's record unless only its activity changed
+func (r *RoomRepository) store(ctx context.Context, room *domain.Room) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	record := room.Record()
+	sum := digest(record)
+	if written, ok := r.written[record.ID]; ok && written == sum {
+		return nil
+	}
+
+	sealed, err := r.seal(record)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT OR REPLACE INTO persistent_rooms (id, updated_at, data) VALUES (?, ?, ?)`
+	if _, err := r.db.ExecContext(ctx, query, record.ID, time.Now().Unix(), sealed); err != nil {
+		return err
+	}
+	r.written[record.ID] = sum
+	return nil
+}
+
+// Create persists a new room if its name is not in use
+func (r *RoomRepository) Create(ctx context.Context, room *domain.Room) error {
+	if err := r.cache.Create(ctx, room); err != nil {
+		return err
+	}
+	if room.IsPersistent() {
+		return r.store(ctx, room)
+	}
+	return nil
+}
+
+// Save persists a room
+func (r *RoomRepository) Save(ctx context.Context, room *domain.Room) error {
+	if err := r.cache.Save(ctx, room); err != nil {
+		return err
+	}
+	if room.IsPersistent() {
+		return r.store(ctx, room)
+	}
+	return nil
+}
+
+// FindByID retrieves a room by its ID
+func (r *RoomRepository) FindByID(ctx context.Context, id string) (*domain.Room, error) {
+	return r.cache.FindByID(ctx, id)
+}
+
+// FindByName retrieves a room by its name
+func (r *RoomRepository) FindByName(ctx context.Context, name string) (*domain.Room, error) {
+	return r.cache.FindByName(ctx, name)
+}
+
+// Delete removes a room, including its stored record if it was persistent
+func (r *RoomRepository) Delete(ctx context.Context, id string) error {
+	if err := r.cache.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.written, id)
+	_, err := r.db.ExecContext(ctx, `DELETE FROM persistent_rooms WHERE id = ?`, id)
+	return err
+}
+
+// ListAll returns all rooms
+func (r *RoomRepository) ListAll(ctx context.Context) ([]*domain.Room, error) {
+	return r.cache.ListAll(ctx)
+}
+
+// Close closes the database connection
+func (r *RoomRepository) Close() error {
+	return r.db.Close()
+}
+
+// Ensure RoomRepository implements the interface
+var _ secondary.RoomRepository = (*RoomRepository)(nil)
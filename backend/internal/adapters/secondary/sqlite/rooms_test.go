@@ -0,0 +1,120 @@
+package sqlite
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/vicmanager/esteemed/backend/internal/adapters/secondary/memory"
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+)
+
+func TestRoomRepository_PersistentRoomsSurviveRestart(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "rooms.db")
+
+	repo, err := NewRoomRepository(ctx, dbPath, "secret", memory.NewRoomRepository())
+	if err != nil {
+		t.Fatalf("failed to create repo: %v", err)
+	}
+
+	team := domain.NewRoom("r1", "platform-team", &domain.Participant{ID: "host", Name: "Alice", SessionToken: "host-token", IsConnected: true}, domain.NewCardConfig(domain.CardPresetTShirt))
+	team.MakePersistent()
+	_ = team.AddParticipant(&domain.Participant{ID: "voter", Name: "Bob", SessionToken: "voter-token", IsConnected: true})
+	team.StartVoting()
+	_ = team.CastVote("voter", "M")
+	_, _ = team.RevealVotes()
+	_ = team.SetPasscode("host", "open-sesame")
+	if err := repo.Create(ctx, team); err != nil {
+		t.Fatalf("failed to create room: %v", err)
+	}
+	_ = repo.Save(ctx, team)
+
+	adhoc := domain.NewRoom("r2", "brave-nebula", &domain.Participant{ID: "host"}, nil)
+	_ = repo.Create(ctx, adhoc)
+	_ = repo.Close()
+
+	// Reopening with another secret cannot read the stored rooms
+	if _, err := NewRoomRepository(ctx, dbPath, "other-secret", memory.NewRoomRepository()); err == nil {
+		t.Error("expected the store unreadable with the wrong secret")
+	}
+
+	// Reopening loads only the persistent room
+	repo, err = NewRoomRepository(ctx, dbPath, "secret", memory.NewRoomRepository())
+	if err != nil {
+		t.Fatalf("failed to reopen repo: %v", err)
+	}
+	defer repo.Close()
+
+	if _, err := repo.FindByID(ctx, "r2"); err != domain.ErrRoomNotFound {
+		t.Errorf("expected the ad-hoc room gone, got %v", err)
+	}
+	restored, err := repo.FindByName(ctx, "platform-team")
+	if err != nil {
+		t.Fatalf("expected the persistent room restored, got %v", err)
+	}
+	if !restored.IsPersistent() || restored.CardConfig.Preset != domain.CardPresetTShirt || len(restored.GetHistory()) != 1 {
+		t.Errorf("expected deck and history kept, got %+v", restored.Record())
+	}
+	if voter, err := restored.GetParticipantByToken("voter-token"); err != nil || voter.IsConnected {
+		t.Errorf("expected the voter kept as a disconnected member, got %+v, %v", voter, err)
+	}
+	if !restored.HasPasscode() {
+		t.Error("expected the passcode kept")
+	}
+
+	// Deleting the room removes it for good
+	if err := repo.Delete(ctx, "r1"); err != nil {
+		t.Fatalf("failed to delete: %v", err)
+	}
+	_ = repo.Close()
+	repo, _ = NewRoomRepository(ctx, dbPath, "secret", memory.NewRoomRepository())
+	if _, err := repo.FindByID(ctx, "r1"); err != domain.ErrRoomNotFound {
+		t.Errorf("expected the deleted room gone after a restart, got %v", err)
+	}
+}
+
+func TestRoomRepository_StoresEncryptedRecordsOnChange(t *testing.T) {
+	ctx := context.Background()
+	repo, err := NewRoomRepository(ctx, filepath.Join(t.TempDir(), "rooms.db"), "secret", memory.NewRoomRepository())
+	if err != nil {
+		t.Fatalf("failed to create repo: %v", err)
+	}
+	defer repo.Close()
+
+	if _, err := NewRoomRepository(ctx, filepath.Join(t.TempDir(), "rooms.db"), "", memory.NewRoomRepository()); err != ErrNoRoomSecret {
+		t.Errorf("expected ErrNoRoomSecret without a secret, got %v", err)
+	}
+
+	room := domain.NewRoom("r1", "platform-team", &domain.Participant{ID: "host", SessionToken: "host-token", IsConnected: true}, nil)
+	room.MakePersistent()
+	_ = repo.Create(ctx, room)
+
+	stored := func() []byte {
+		t.Helper()
+		var data []byte
+		if err := repo.db.QueryRowContext(ctx, `SELECT data FROM persistent_rooms WHERE id = ?`, room.ID).Scan(&data); err != nil {
+			t.Fatalf("failed to read the stored record: %v", err)
+		}
+		return data
+	}
+
+	first := stored()
+	if bytes.Contains(first, []byte("host-token")) {
+		t.Error("expected session tokens encrypted at rest")
+	}
+
+	// Touching activity alone does not rewrite the record
+	room.TouchActivity()
+	_ = repo.Save(ctx, room)
+	if !bytes.Equal(stored(), first) {
+		t.Error("expected an activity-only save to be skipped")
+	}
+
+	_ = room.AddParticipant(&domain.Participant{ID: "voter", SessionToken: "voter-token", IsConnected: true})
+	_ = repo.Save(ctx, room)
+	if bytes.Equal(stored(), first) {
+		t.Error("expected a new member to be written")
+	}
+}
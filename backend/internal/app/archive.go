@@ -0,0 +1,148 @@
+package app
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+	"github.com/vicmanager/esteemed/backend/internal/ports/primary"
+	"github.com/vicmanager/esteemed/backend/internal/ports/secondary"
+)
+
+// DefaultArchiveRetention is how long archived sessions are kept unless configured
+const DefaultArchiveRetention = 90 * 24 * time.Hour
+
+// Archive listing limits
+const (
+	defaultArchiveListLimit = 50
+	maxArchiveListLimit     = 200
+	maxArchiveListTokens    = 100 // Session tokens a single listing may present
+)
+
+// ArchiveService archives closed rooms and implements the primary.ArchiveService interface
+type ArchiveService struct {
+	repo       secondary.ArchiveRepository
+	retention  time.Duration // Zero keeps sessions forever
+	interval   time.Duration // Pruning interval
+	adminToken string        // Grants access to every session (empty disables admin access)
+}
+
+// NewArchiveService creates a new archive service that keeps sessions for the
+// given retention. The admin token may read and list every session.
+func NewArchiveService(repo secondary.ArchiveRepository, retention time.Duration, adminToken string) *ArchiveService {
+	return &ArchiveService{
+		repo:       repo,
+		retention:  retention,
+		interval:   1 * time.Hour,
+		adminToken: adminToken,
+	}
+}
+
+// isAdmin checks the caller's admin token in constant time
+func (s *ArchiveService) isAdmin(access primary.ArchiveAccess) bool {
+	return s.adminToken != "" && subtle.ConstantTimeCompare([]byte(access.AdminToken), []byte(s.adminToken)) == 1
+}
+
+// Archive stores the session of a closing room. Rooms without any revealed
+// round have nothing worth browsing and are skipped. Register it as a
+// CloseStageArchive hook.
+func (s *ArchiveService) Archive(ctx context.Context, room *domain.Room, reason domain.CloseReason) error {
+	session := room.Archive(reason, time.Now())
+	if len(session.Rounds) == 0 {
+		return nil
+	}
+
+	if err := s.repo.Save(ctx, session); err != nil {
+		return fmt.Errorf("failed to archive room: %w", err)
+	}
+	return nil
+}
+
+// ListArchivedSessions returns archived sessions matching the filter, most
+// recently closed first. Only the admin sees every session; anyone else sees
+// the sessions of their session tokens, leaving out private rooms.
+func (s *ArchiveService) ListArchivedSessions(ctx context.Context, filter domain.ArchiveFilter, access primary.ArchiveAccess) ([]*domain.ArchivedSessionSummary, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultArchiveListLimit
+	}
+	filter.Limit = min(filter.Limit, maxArchiveListLimit)
+
+	if s.isAdmin(access) {
+		filter.TokenHashes = nil
+		filter.IncludePrivate = true
+		return s.repo.List(ctx, filter)
+	}
+
+	filter.TokenHashes = make([]string, 0, len(access.SessionTokens))
+	for _, token := range access.SessionTokens {
+		if token != "" {
+			filter.TokenHashes = append(filter.TokenHashes, domain.HashSessionToken(token))
+		}
+	}
+	if len(filter.TokenHashes) == 0 || len(filter.TokenHashes) > maxArchiveListTokens {
+		return nil, domain.ErrInvalidToken
+	}
+	filter.IncludePrivate = false
+
+	return s.repo.List(ctx, filter)
+}
+
+// GetArchivedSession returns an archived session with its participants, deck
+// and rounds. Sessions are only shown to their participants and the admin;
+// anyone else is told the session does not exist.
+func (s *ArchiveService) GetArchivedSession(ctx context.Context, id string, access primary.ArchiveAccess) (*domain.ArchivedSession, error) {
+	session, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if s.isAdmin(access) {
+		return session, nil
+	}
+
+	for _, token := range access.SessionTokens {
+		if session.HasParticipant(token) {
+			return session, nil
+		}
+	}
+	return nil, domain.ErrSessionNotFound
+}
+
+// Start begins the goroutine removing sessions older than the retention
+func (s *ArchiveService) Start(ctx context.Context) {
+	if s.retention <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		s.prune(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.prune(ctx)
+			}
+		}
+	}()
+}
+
+// prune removes sessions closed longer ago than the retention
+func (s *ArchiveService) prune(ctx context.Context) {
+	deleted, err := s.repo.DeleteClosedBefore(ctx, time.Now().Add(-s.retention))
+	if err != nil {
+		log.Printf("ArchiveService: failed to prune sessions: %v", err)
+		return
+	}
+	if deleted > 0 {
+		log.Printf("ArchiveService: pruned %d archived sessions", deleted)
+	}
+}
+
+// Ensure ArchiveService implements the interface
+var _ primary.ArchiveService = (*ArchiveService)(nil)
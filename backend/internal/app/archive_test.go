@@ -0,0 +1,54 @@
+package app
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/vicmanager/esteemed/backend/internal/adapters/secondary/sqlite"
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+	"github.com/vicmanager/esteemed/backend/internal/ports/primary"
+)
+
+func TestArchiveService_RequiresParticipantOrAdmin(t *testing.T) {
+	ctx := context.Background()
+	repo, err := sqlite.NewArchiveRepository(filepath.Join(t.TempDir(), "archive.db"))
+	if err != nil {
+		t.Fatalf("failed to create repo: %v", err)
+	}
+	defer repo.Close()
+	service := NewArchiveService(repo, DefaultArchiveRetention, "admin-secret")
+
+	room := domain.NewRoom("r1", "secret-planning", &domain.Participant{ID: "host", SessionToken: "host-token", IsConnected: true}, nil)
+	_ = room.SetVisibility("host", domain.VisibilityPrivate)
+	room.StartVoting()
+	_ = room.CastVote("host", "5")
+	_, _ = room.RevealVotes()
+	if err := service.Archive(ctx, room, domain.CloseReasonAllLeft); err != nil {
+		t.Fatalf("failed to archive: %v", err)
+	}
+
+	if _, err := service.ListArchivedSessions(ctx, domain.ArchiveFilter{}, primary.ArchiveAccess{}); err != domain.ErrInvalidToken {
+		t.Errorf("expected ErrInvalidToken listing without credentials, got %v", err)
+	}
+	if list, _ := service.ListArchivedSessions(ctx, domain.ArchiveFilter{}, primary.ArchiveAccess{SessionTokens: []string{"host-token"}}); len(list) != 0 {
+		t.Errorf("expected private rooms left out of participant listings, got %v", list)
+	}
+	if list, _ := service.ListArchivedSessions(ctx, domain.ArchiveFilter{}, primary.ArchiveAccess{AdminToken: "admin-secret"}); len(list) != 1 {
+		t.Errorf("expected the admin to see every session, got %v", list)
+	}
+
+	if _, err := service.GetArchivedSession(ctx, "r1", primary.ArchiveAccess{SessionTokens: []string{"stranger"}}); err != domain.ErrSessionNotFound {
+		t.Errorf("expected ErrSessionNotFound for a stranger, got %v", err)
+	}
+	if _, err := service.GetArchivedSession(ctx, "r1", primary.ArchiveAccess{AdminToken: "wrong"}); err != domain.ErrSessionNotFound {
+		t.Errorf("expected ErrSessionNotFound for a wrong admin token, got %v", err)
+	}
+	session, err := service.GetArchivedSession(ctx, "r1", primary.ArchiveAccess{SessionTokens: []string{"host-token"}})
+	if err != nil || session.RoomName != "secret-planning" {
+		t.Fatalf("expected the participant to read the session, got %v", err)
+	}
+	if hash := session.Participants[0].TokenHash; hash == "" || hash == "host-token" {
+		t.Errorf("expected only a hash of the session token archived, got %q", hash)
+	}
+}
@@ -0,0 +1,193 @@
+package app
+
+import (
+	"context"
+
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+	"github.com/vicmanager/esteemed/backend/internal/ports/primary"
+	"github.com/vicmanager/esteemed/backend/internal/ports/secondary"
+)
+
+// CreateBreakout creates a child room for a sub-team (host or facilitator)
+func (s *RoomService) CreateBreakout(ctx context.Context, roomID, participantID, sessionToken string) (*domain.Room, error) {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return nil, err
+	}
+
+	breakout, err := room.NewBreakoutRoom(participantID, domain.GenerateID())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, breakout); err != nil {
+		room.DetachBreakout(breakout.ID)
+		return nil, err
+	}
+
+	breakout.SetActivityObserver(s.activity)
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return nil, err
+	}
+
+	// Record analytics event
+	if s.analytics != nil {
+		_ = s.analytics.RecordEvent(ctx, domain.NewAnalyticsEvent(domain.EventTypeRoomCreated, breakout.ID, ""))
+	}
+
+	return breakout, nil
+}
+
+// MoveToBreakout moves a participant into one of the room's breakouts (host or facilitator)
+func (s *RoomService) MoveToBreakout(ctx context.Context, roomID, participantID, sessionToken, targetParticipantID, breakoutRoomID string) error {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return err
+	}
+
+	breakout, err := s.repo.FindByID(ctx, breakoutRoomID)
+	if err != nil || breakout.ParentID != room.ID {
+		return domain.ErrBreakoutNotFound
+	}
+
+	moved, previousBreakoutID, err := room.AssignBreakout(participantID, targetParticipantID, breakoutRoomID)
+	if err != nil {
+		return err
+	}
+
+	// Participants keep their identity in the breakout so they can rejoin with their session token
+	if err := breakout.AddParticipant(moved); err != nil && err != domain.ErrParticipantExists {
+		return err
+	}
+	breakout.TouchActivity()
+
+	if err := s.repo.Save(ctx, breakout); err != nil {
+		return err
+	}
+
+	// Drop the participant from the breakout they were in before
+	if previousBreakoutID != "" && previousBreakoutID != breakoutRoomID {
+		if previous, err := s.repo.FindByID(ctx, previousBreakoutID); err == nil {
+			if err := previous.RemoveParticipant(targetParticipantID); err == nil {
+				_ = s.repo.Save(ctx, previous)
+				_ = s.publisher.PublishRoomEvent(ctx, previous.ID, primary.RoomEvent{
+					Type:          primary.RoomEventParticipantLeft,
+					ParticipantID: targetParticipantID,
+				})
+			}
+		}
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return err
+	}
+
+	// Tell the parent room so the moved participant's client can navigate
+	_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+		Type:           primary.RoomEventParticipantMoved,
+		ParticipantID:  targetParticipantID,
+		TargetRoomID:   breakout.ID,
+		TargetRoomName: breakout.Name,
+	})
+
+	// Announce the arrival in the breakout
+	_ = s.publisher.PublishRoomEvent(ctx, breakout.ID, primary.RoomEvent{
+		Type:        primary.RoomEventParticipantJoined,
+		Participant: moved,
+	})
+
+	return nil
+}
+
+// CloseBreakout closes a breakout and merges its round history into the parent (host or facilitator)
+func (s *RoomService) CloseBreakout(ctx context.Context, roomID, participantID, sessionToken, breakoutRoomID string) (int, error) {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return 0, err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return 0, err
+	}
+
+	// Check the caller may manage breakouts
+	if err := room.Authorize(participantID, domain.ActionManageBreakouts); err != nil {
+		return 0, err
+	}
+
+	if !room.HasBreakout(breakoutRoomID) {
+		return 0, domain.ErrBreakoutNotFound
+	}
+
+	// The breakout may already be gone (e.g. everyone left it); merge whatever is left
+	var rounds []*domain.RoundResult
+	breakout, err := s.repo.FindByID(ctx, breakoutRoomID)
+	if err == nil {
+		rounds = breakout.GetHistory()
+	}
+
+	if err := room.MergeBreakout(breakoutRoomID, rounds); err != nil {
+		return 0, err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return 0, err
+	}
+
+	if breakout != nil {
+		// Send everyone in the breakout back to the parent room
+		for _, p := range breakout.GetParticipants() {
+			_ = s.publisher.PublishRoomEvent(ctx, breakout.ID, primary.RoomEvent{
+				Type:           primary.RoomEventParticipantMoved,
+				ParticipantID:  p.ID,
+				TargetRoomID:   room.ID,
+				TargetRoomName: room.Name,
+			})
+		}
+
+		if err := s.lifecycle.Close(ctx, breakout, domain.CloseReasonBreakoutClosed); err != nil {
+			return 0, err
+		}
+	}
+
+	_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+		Type:         primary.RoomEventBreakoutClosed,
+		TargetRoomID: breakoutRoomID,
+		RoundsMerged: len(rounds),
+	})
+
+	return len(rounds), nil
+}
+
+// detachFromParent removes a deleted breakout from its parent's open breakouts
+func detachFromParent(ctx context.Context, repo secondary.RoomRepository, room *domain.Room) {
+	if room.ParentID == "" {
+		return
+	}
+
+	parent, err := repo.FindByID(ctx, room.ParentID)
+	if err != nil {
+		return
+	}
+
+	parent.DetachBreakout(room.ID)
+	_ = repo.Save(ctx, parent)
+}
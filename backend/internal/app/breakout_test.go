@@ -0,0 +1,47 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+)
+
+func TestRoomService_CloseBreakoutMergesRounds(t *testing.T) {
+	ctx := context.Background()
+	service, repo, _ := newTestRoomService(t)
+
+	room := domain.NewRoom("r1", "brave-nebula", &domain.Participant{ID: "host", SessionToken: "host-token", IsConnected: true}, nil)
+	_ = room.AddParticipant(&domain.Participant{ID: "voter", SessionToken: "voter-token", IsConnected: true})
+	_ = repo.Save(ctx, room)
+
+	breakout, err := service.CreateBreakout(ctx, room.ID, "host", "host-token")
+	if err != nil {
+		t.Fatalf("failed to create breakout: %v", err)
+	}
+	if err := service.MoveToBreakout(ctx, room.ID, "host", "host-token", "voter", breakout.ID); err != nil {
+		t.Fatalf("failed to move voter: %v", err)
+	}
+	if _, err := breakout.GetParticipant("voter"); err != nil {
+		t.Errorf("expected the voter in the breakout, got %v", err)
+	}
+
+	breakout.StartVoting()
+	_ = breakout.CastVote("voter", "8")
+	_, _ = breakout.RevealVotes()
+
+	if _, err := service.CloseBreakout(ctx, room.ID, "voter", "voter-token", breakout.ID); err != domain.ErrNotHost {
+		t.Errorf("expected ErrNotHost for a voter, got %v", err)
+	}
+	merged, err := service.CloseBreakout(ctx, room.ID, "host", "host-token", breakout.ID)
+	if err != nil || merged != 1 {
+		t.Fatalf("expected one round merged, got %d, %v", merged, err)
+	}
+
+	if _, err := repo.FindByID(ctx, breakout.ID); err != domain.ErrRoomNotFound {
+		t.Errorf("expected the breakout closed, got %v", err)
+	}
+	if history := room.GetHistory(); len(history) != 1 || history[0].SourceRoomID != breakout.ID {
+		t.Errorf("expected the breakout's round in the parent's history, got %+v", history)
+	}
+}
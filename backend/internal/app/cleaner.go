@@ -5,46 +5,97 @@ import (
 	"log"
 	"time"
 
+	"github.com/vicmanager/esteemed/backend/internal/domain"
 	"github.com/vicmanager/esteemed/backend/internal/ports/primary"
 	"github.com/vicmanager/esteemed/backend/internal/ports/secondary"
 )
 
-// RoomCleaner periodically removes inactive rooms
+// RoomCleaner removes inactive rooms and participants who stayed disconnected
+// past the grace period. Rooms are checked when their expiry or the next
+// removal is due, using a queue ordered by due time that is updated whenever a
+// room's activity is touched. A slow full sweep catches rooms that were never
+// scheduled.
 type RoomCleaner struct {
 	repo      secondary.RoomRepository
 	publisher secondary.EventPublisher
-	timeout   time.Duration
-	interval  time.Duration
+	timeouts  RoomTimeouts
+	interval  time.Duration // Full sweep interval
+	retry     time.Duration // Re-check delay for rooms kept alive by open breakouts
+	queue     *expiryQueue
+	lifecycle *RoomLifecycle
 }
 
-// NewRoomCleaner creates a new room cleaner
-func NewRoomCleaner(repo secondary.RoomRepository, publisher secondary.EventPublisher) *RoomCleaner {
+// NewRoomCleaner creates a new room cleaner that closes expired rooms through the lifecycle
+func NewRoomCleaner(repo secondary.RoomRepository, publisher secondary.EventPublisher, timeouts RoomTimeouts, lifecycle *RoomLifecycle) *RoomCleaner {
 	return &RoomCleaner{
 		repo:      repo,
 		publisher: publisher,
-		timeout:   RoomInactivityTimeout,
-		interval:  1 * time.Minute,
+		timeouts:  timeouts,
+		interval:  10 * time.Minute,
+		retry:     1 * time.Minute,
+		queue:     newExpiryQueue(),
+		lifecycle: lifecycle,
 	}
 }
 
+// ActivityTouched reschedules a room's expiry check (implements domain.ActivityObserver)
+func (c *RoomCleaner) ActivityTouched(room *domain.Room) {
+	c.queue.Schedule(room.ID, c.nextCheck(room))
+}
+
 // Start begins the cleanup goroutine
 func (c *RoomCleaner) Start(ctx context.Context) {
 	go func() {
 		ticker := time.NewTicker(c.interval)
 		defer ticker.Stop()
 
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			case <-ticker.C:
 				c.cleanupExpiredRooms(ctx)
+			case <-c.queue.wake:
+			case <-timer.C:
+				c.processDue(ctx, time.Now())
 			}
+
+			// Sleep until the earliest scheduled check
+			wait := c.interval
+			if next, ok := c.queue.Next(); ok {
+				wait = max(time.Until(next), 0)
+			}
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(wait)
 		}
 	}()
 }
 
-// cleanupExpiredRooms removes all rooms that have been inactive for longer than the timeout
+// processDue checks every room whose expiry check is due
+func (c *RoomCleaner) processDue(ctx context.Context, now time.Time) {
+	for _, roomID := range c.queue.PopDue(now) {
+		room, err := c.repo.FindByID(ctx, roomID)
+		if err != nil {
+			// Already deleted
+			continue
+		}
+
+		if next := c.checkRoom(ctx, room); !next.IsZero() {
+			c.queue.Schedule(room.ID, next)
+		}
+	}
+}
+
+// cleanupExpiredRooms scans all rooms, removing those that have been inactive
+// for longer than their timeout and scheduling the rest
 func (c *RoomCleaner) cleanupExpiredRooms(ctx context.Context) {
 	rooms, err := c.repo.ListAll(ctx)
 	if err != nil {
@@ -53,20 +104,115 @@ func (c *RoomCleaner) cleanupExpiredRooms(ctx context.Context) {
 	}
 
 	for _, room := range rooms {
-		if room.IsExpired(c.timeout) {
-			// Notify connected clients before deletion
-			if err := c.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
-				Type:   primary.RoomEventClosed,
-				Reason: "inactivity timeout",
-			}); err != nil {
-				log.Printf("RoomCleaner: failed to publish room closed event for %s: %v", room.ID, err)
-			}
+		if next := c.checkRoom(ctx, room); !next.IsZero() {
+			c.queue.Schedule(room.ID, next)
+		}
+	}
+}
 
-			if err := c.repo.Delete(ctx, room.ID); err != nil {
-				log.Printf("RoomCleaner: failed to delete room %s: %v", room.ID, err)
-			} else {
-				log.Printf("RoomCleaner: deleted inactive room %s (%s)", room.ID, room.Name)
-			}
+// checkRoom removes participants past the disconnect grace period, then warns,
+// drops connections from or deletes a room as its expiry requires. Returns when
+// the room should be checked next (zero if not needed).
+func (c *RoomCleaner) checkRoom(ctx context.Context, room *domain.Room) time.Time {
+	c.removeDisconnected(ctx, room)
+
+	// Rooms with open breakouts stay alive until their breakouts are closed or expire
+	if room.HasBreakouts() {
+		return earliest(time.Now().Add(c.retry), room.NextDisconnectRemoval(c.timeouts.Disconnect))
+	}
+
+	timeout := c.timeouts.forRoom(room)
+
+	// Persistent rooms are kept, but idle connections are dropped
+	if room.IsPersistent() {
+		if !room.IsExpired(timeout) {
+			return c.nextCheck(room)
 		}
+		if room.HasConnectedParticipants() {
+			c.dropIdleConnections(ctx, room)
+		}
+		return time.Time{}
+	}
+
+	// Give clients a chance to keep the room alive
+	if !room.IsExpired(timeout) {
+		if c.timeouts.Warning > 0 && room.ShouldWarnExpiry(timeout, c.timeouts.Warning) {
+			_ = c.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+				Type:      primary.RoomEventExpiryWarning,
+				ExpiresAt: room.ExpiresAt(timeout),
+			})
+		}
+		return c.nextCheck(room)
+	}
+
+	if err := c.lifecycle.Close(ctx, room, domain.CloseReasonInactivity); err != nil {
+		log.Printf("RoomCleaner: failed to delete room %s: %v", room.ID, err)
+	} else {
+		log.Printf("RoomCleaner: deleted inactive room %s (%s)", room.ID, room.Name)
 	}
+
+	return time.Time{}
+}
+
+// nextCheck returns when a room is next due for an expiry warning, for expiry
+// or for removing a disconnected participant
+func (c *RoomCleaner) nextCheck(room *domain.Room) time.Time {
+	next := room.ExpiresAt(c.timeouts.forRoom(room))
+
+	if !room.IsPersistent() && c.timeouts.Warning > 0 {
+		if warnAt := next.Add(-c.timeouts.Warning); time.Now().Before(warnAt) {
+			next = warnAt
+		}
+	}
+
+	return earliest(next, room.NextDisconnectRemoval(c.timeouts.Disconnect))
+}
+
+// removeDisconnected removes participants who stayed disconnected past the
+// grace period and tells the room, as a kick would
+func (c *RoomCleaner) removeDisconnected(ctx context.Context, room *domain.Room) {
+	removed, hostChange := room.RemoveDisconnected(c.timeouts.Disconnect, time.Now())
+	if len(removed) == 0 {
+		return
+	}
+
+	if err := c.repo.Save(ctx, room); err != nil {
+		log.Printf("RoomCleaner: failed to save room %s: %v", room.ID, err)
+		return
+	}
+
+	for _, id := range removed {
+		_ = c.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+			Type:          primary.RoomEventParticipantLeft,
+			ParticipantID: id,
+		})
+	}
+	if hostChange != nil {
+		_ = c.publisher.PublishRoomEvent(ctx, room.ID, hostChangedEvent(hostChange))
+	}
+}
+
+// earliest returns the earlier of two times, ignoring zero times
+func earliest(a, b time.Time) time.Time {
+	if a.IsZero() || (!b.IsZero() && b.Before(a)) {
+		return b
+	}
+	return a
+}
+
+// dropIdleConnections disconnects everyone from an idle persistent room and ends their streams
+func (c *RoomCleaner) dropIdleConnections(ctx context.Context, room *domain.Room) {
+	for _, id := range room.DisconnectAll() {
+		_ = c.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+			Type:          primary.RoomEventParticipantLeft,
+			ParticipantID: id,
+		})
+	}
+
+	if err := c.repo.Save(ctx, room); err != nil {
+		log.Printf("RoomCleaner: failed to save room %s: %v", room.ID, err)
+	}
+
+	c.publisher.CloseSubscriptions(ctx, room.ID)
+	log.Printf("RoomCleaner: dropped idle connections from persistent room %s (%s)", room.ID, room.Name)
 }
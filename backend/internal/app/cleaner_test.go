@@ -0,0 +1,255 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/vicmanager/esteemed/backend/internal/adapters/secondary/memory"
+	"github.com/vicmanager/esteemed/backend/internal/adapters/secondary/pubsub"
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+	"github.com/vicmanager/esteemed/backend/internal/ports/primary"
+)
+
+func TestExpiryQueue_PopDueInOrder(t *testing.T) {
+	q := newExpiryQueue()
+	now := time.Now()
+
+	q.Schedule("a", now.Add(3*time.Minute))
+	q.Schedule("b", now.Add(5*time.Minute))
+	q.Schedule("c", now.Add(1*time.Minute))
+	q.Schedule("b", now.Add(2*time.Minute)) // Rescheduled earlier
+
+	if next, ok := q.Next(); !ok || !next.Equal(now.Add(1*time.Minute)) {
+		t.Errorf("expected next check in 1 minute, got %v", next)
+	}
+
+	due := q.PopDue(now.Add(3 * time.Minute))
+	if fmt.Sprint(due) != "[c b a]" {
+		t.Errorf("expected [c b a], got %v", due)
+	}
+
+	if _, ok := q.Next(); ok {
+		t.Error("expected empty queue")
+	}
+}
+
+func TestRoomCleaner_ClosesRoomWhenDue(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewRoomRepository()
+	broker := pubsub.NewBroker()
+	lifecycle := NewRoomLifecycle(repo, broker, nil, nil)
+	cleaner := NewRoomCleaner(repo, broker, RoomTimeouts{Inactivity: time.Minute}, lifecycle)
+
+	room := domain.NewRoom("r1", "brave-nebula", &domain.Participant{ID: "host", IsConnected: true}, nil)
+	_ = repo.Create(ctx, room)
+	room.SetActivityObserver(cleaner)
+
+	events, unsubscribe := broker.SubscribeRoomEvents(ctx, room.ID)
+	defer unsubscribe()
+
+	cleaner.processDue(ctx, time.Now())
+	if _, err := repo.FindByID(ctx, room.ID); err != nil {
+		t.Fatal("expected room to survive before its expiry")
+	}
+
+	cleaner.processDue(ctx, time.Now().Add(time.Minute))
+	// The check was due, but the room's activity is still recent, so it is rescheduled
+	if _, ok := cleaner.queue.Next(); !ok {
+		t.Fatal("expected room to be rescheduled")
+	}
+
+	room.LastActivityAt = time.Now().Add(-2 * time.Minute)
+	cleaner.processDue(ctx, time.Now().Add(time.Minute))
+
+	if _, err := repo.FindByID(ctx, room.ID); err != domain.ErrRoomNotFound {
+		t.Errorf("expected room to be deleted, got %v", err)
+	}
+
+	select {
+	case event := <-events:
+		if event.Type != primary.RoomEventClosed || event.CloseReason != domain.CloseReasonInactivity {
+			t.Errorf("expected inactivity close event, got %v/%v", event.Type, event.CloseReason)
+		}
+	default:
+		t.Error("expected a closed event")
+	}
+}
+
+func TestRoomTimeouts_ForRoom(t *testing.T) {
+	timeouts := RoomTimeouts{Inactivity: 15 * time.Minute}
+
+	custom := domain.NewRoom("r1", "custom", &domain.Participant{ID: "host"}, nil)
+	custom.SetInactivityTimeout(time.Hour)
+	async := domain.NewRoom("r2", "async", &domain.Participant{ID: "host"}, nil)
+	async.SetInactivityTimeout(time.Hour)
+	async.EnableAsync(time.Time{})
+
+	tests := []struct {
+		name string
+		room *domain.Room
+		want time.Duration
+	}{
+		{"deployment default", domain.NewRoom("r3", "default", &domain.Participant{ID: "host"}, nil), 15 * time.Minute},
+		{"custom timeout", custom, time.Hour},
+		{"async room", async, AsyncRoomInactivityTimeout},
+	}
+
+	for _, tt := range tests {
+		if got := timeouts.forRoom(tt.room); got != tt.want {
+			t.Errorf("%s: forRoom() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRoomCleaner_WarnsBeforeClosing(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewRoomRepository()
+	broker := pubsub.NewBroker()
+	lifecycle := NewRoomLifecycle(repo, broker, nil, nil)
+	cleaner := NewRoomCleaner(repo, broker, RoomTimeouts{Inactivity: 10 * time.Minute, Warning: 2 * time.Minute}, lifecycle)
+
+	room := domain.NewRoom("r1", "brave-nebula", &domain.Participant{ID: "host", IsConnected: true}, nil)
+	room.SetInactivityTimeout(5 * time.Minute)
+	_ = repo.Create(ctx, room)
+	room.SetActivityObserver(cleaner)
+
+	// The first check is due when the warning period of the room's own timeout starts
+	if next, _ := cleaner.queue.Next(); next.After(room.LastActivityAt.Add(3*time.Minute + time.Second)) {
+		t.Fatalf("expected a check at the start of the warning period, got %v", next)
+	}
+
+	events, unsubscribe := broker.SubscribeRoomEvents(ctx, room.ID)
+	defer unsubscribe()
+
+	room.LastActivityAt = time.Now().Add(-4 * time.Minute)
+	cleaner.processDue(ctx, time.Now().Add(3*time.Minute))
+	cleaner.cleanupExpiredRooms(ctx) // A second check does not warn again
+
+	room.LastActivityAt = time.Now().Add(-6 * time.Minute)
+	cleaner.cleanupExpiredRooms(ctx)
+
+	var got []primary.RoomEventType
+	for len(events) > 0 {
+		got = append(got, (<-events).Type)
+	}
+	want := []primary.RoomEventType{primary.RoomEventExpiryWarning, primary.RoomEventClosed}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("expected a single warning before the close, got %v", got)
+	}
+	if _, err := repo.FindByID(ctx, room.ID); err != domain.ErrRoomNotFound {
+		t.Errorf("expected room to be deleted, got %v", err)
+	}
+}
+
+func TestRoomCleaner_RemovesDisconnectedAfterGrace(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewRoomRepository()
+	broker := pubsub.NewBroker()
+	lifecycle := NewRoomLifecycle(repo, broker, nil, nil)
+	cleaner := NewRoomCleaner(repo, broker, RoomTimeouts{Inactivity: time.Hour, Disconnect: time.Minute}, lifecycle)
+
+	room := domain.NewRoom("r1", "brave-nebula", &domain.Participant{ID: "host", IsConnected: true}, nil)
+	_ = room.AddParticipant(&domain.Participant{ID: "gone", IsConnected: true})
+	_ = repo.Create(ctx, room)
+	_, _ = room.DisconnectParticipant("gone")
+	room.SetActivityObserver(cleaner)
+	room.TouchActivity()
+
+	if next, _ := cleaner.queue.Next(); next.After(time.Now().Add(time.Minute)) {
+		t.Fatalf("expected a check when the grace period ends, got %v", next)
+	}
+
+	events, unsubscribe := broker.SubscribeRoomEvents(ctx, room.ID)
+	defer unsubscribe()
+
+	gone, _ := room.GetParticipant("gone")
+	gone.DisconnectedAt = time.Now().Add(-time.Minute)
+	cleaner.processDue(ctx, time.Now().Add(time.Minute))
+
+	if _, err := room.GetParticipant("gone"); err != domain.ErrParticipantNotFound {
+		t.Errorf("expected disconnected participant to be removed, got %v", err)
+	}
+	select {
+	case event := <-events:
+		if event.Type != primary.RoomEventParticipantLeft || event.ParticipantID != "gone" {
+			t.Errorf("expected left event for the removed participant, got %v/%s", event.Type, event.ParticipantID)
+		}
+	default:
+		t.Error("expected a left event")
+	}
+}
+
+// newBenchmarkCleaner creates a cleaner with n active rooms, all scheduled
+func newBenchmarkCleaner(b *testing.B, n int) *RoomCleaner {
+	b.Helper()
+
+	ctx := context.Background()
+	repo := memory.NewRoomRepository()
+	broker := pubsub.NewBroker()
+	cleaner := NewRoomCleaner(repo, broker, DefaultRoomTimeouts(), NewRoomLifecycle(repo, broker, nil, nil))
+
+	for i := range n {
+		id := fmt.Sprintf("room-%d", i)
+		room := domain.NewRoom(id, id, &domain.Participant{ID: "host", IsConnected: true}, nil)
+		_ = repo.Create(ctx, room)
+		room.SetActivityObserver(cleaner)
+	}
+
+	return cleaner
+}
+
+// fullScan is the per-tick work of the cleaner before expiry was scheduled:
+// every room is listed and checked, although none is due
+func fullScan(ctx context.Context, c *RoomCleaner) {
+	rooms, _ := c.repo.ListAll(ctx)
+	for _, room := range rooms {
+		if room.HasBreakouts() {
+			continue
+		}
+		timeout := c.timeouts.forRoom(room)
+		if room.IsPersistent() {
+			_ = room.IsExpired(timeout) && room.HasConnectedParticipants()
+			continue
+		}
+		if !room.IsExpired(timeout) {
+			_ = c.timeouts.Warning > 0 && room.ShouldWarnExpiry(timeout, c.timeouts.Warning)
+		}
+	}
+}
+
+// BenchmarkCleanupTick compares one wake-up of the cleaner with no rooms due:
+// the former full scan over every room against the scheduler popping the queue
+func BenchmarkCleanupTick(b *testing.B) {
+	for _, n := range []int{100, 10000} {
+		b.Run(fmt.Sprintf("full-scan/rooms=%d", n), func(b *testing.B) {
+			cleaner := newBenchmarkCleaner(b, n)
+			ctx := context.Background()
+
+			for b.Loop() {
+				fullScan(ctx, cleaner)
+			}
+		})
+		b.Run(fmt.Sprintf("scheduled/rooms=%d", n), func(b *testing.B) {
+			cleaner := newBenchmarkCleaner(b, n)
+			ctx := context.Background()
+			now := time.Now()
+
+			for b.Loop() {
+				cleaner.processDue(ctx, now)
+			}
+		})
+	}
+}
+
+// BenchmarkTouchActivity measures the cost the scheduler adds to every room activity
+func BenchmarkTouchActivity(b *testing.B) {
+	cleaner := newBenchmarkCleaner(b, 10000)
+	room := domain.NewRoom("touched", "touched", &domain.Participant{ID: "host"}, nil)
+	room.SetActivityObserver(cleaner)
+
+	for b.Loop() {
+		room.TouchActivity()
+	}
+}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"time"
 
 	"github.com/vicmanager/esteemed/backend/internal/domain"
 	"github.com/vicmanager/esteemed/backend/internal/ports/primary"
@@ -14,15 +15,18 @@ type EstimationService struct {
 	publisher    secondary.EventPublisher
 	analytics    secondary.AnalyticsRepository
 	appPublisher secondary.AppEventPublisher
+	presence     *Presence
 }
 
-// NewEstimationService creates a new estimation service
-func NewEstimationService(repo secondary.RoomRepository, publisher secondary.EventPublisher, analytics secondary.AnalyticsRepository, appPublisher secondary.AppEventPublisher) *EstimationService {
+// NewEstimationService creates a new estimation service. Vote streams count
+// towards the watching participant's presence.
+func NewEstimationService(repo secondary.RoomRepository, publisher secondary.EventPublisher, analytics secondary.AnalyticsRepository, appPublisher secondary.AppEventPublisher, presence *Presence) *EstimationService {
 	return &EstimationService{
 		repo:         repo,
 		publisher:    publisher,
 		analytics:    analytics,
 		appPublisher: appPublisher,
+		presence:     presence,
 	}
 }
 
@@ -73,10 +77,132 @@ func (s *EstimationService) CastVote(ctx context.Context, roomID, participantID,
 		ParticipantName: participant.Name,
 	})
 
+	s.revealIfComplete(ctx, room)
+
+	return nil
+}
+
+// CastDots places dots on options in a dot-voting room
+func (s *EstimationService) CastDots(ctx context.Context, roomID, participantID, sessionToken string, dots []*domain.DotAllocation) error {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return err
+	}
+
+	// Get participant for name
+	participant, err := room.GetParticipant(participantID)
+	if err != nil {
+		return err
+	}
+
+	// Place the dots
+	if err := room.CastDots(participantID, dots); err != nil {
+		return err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return err
+	}
+
+	// Record analytics event
+	if s.analytics != nil {
+		_ = s.analytics.RecordEvent(ctx, domain.NewAnalyticsEvent(domain.EventTypeVoteCast, room.ID, ""))
+	}
+
+	// Emit app event
+	if s.appPublisher != nil {
+		votesInRound := len(room.GetVotes())
+		_ = s.appPublisher.Publish(ctx, domain.NewVoteCastEvent(room.ID, room.Name, participant.Name, votesInRound))
+	}
+
+	// Publish vote event (without the placement - hidden until reveal)
+	_ = s.publisher.PublishVoteEvent(ctx, room.ID, primary.VoteEvent{
+		Type:            primary.VoteEventCast,
+		ParticipantID:   participantID,
+		ParticipantName: participant.Name,
+	})
+
+	s.revealIfComplete(ctx, room)
+
 	return nil
 }
 
-// RevealVotes reveals all votes (host only)
+// CastProxyVote records a vote on behalf of an absent participant (host or facilitator)
+func (s *EstimationService) CastProxyVote(ctx context.Context, roomID, participantID, sessionToken, targetParticipantID, targetName, value string) (*domain.Participant, error) {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return nil, err
+	}
+
+	// Check the caller may run rounds
+	if err := room.Authorize(participantID, domain.ActionManageRound); err != nil {
+		return nil, err
+	}
+
+	// Find the absent participant, adding a placeholder if nobody matches the name
+	target, created, err := room.ResolveProxyTarget(participantID, targetParticipantID, targetName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := room.CastProxyVote(participantID, target.ID, value); err != nil {
+		if created {
+			_ = room.RemoveParticipant(target.ID)
+		}
+		return nil, err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return nil, err
+	}
+
+	// Record analytics event
+	if s.analytics != nil {
+		_ = s.analytics.RecordEvent(ctx, domain.NewAnalyticsEvent(domain.EventTypeVoteCast, room.ID, ""))
+	}
+
+	// Emit app event
+	if s.appPublisher != nil {
+		votesInRound := len(room.GetVotes())
+		_ = s.appPublisher.Publish(ctx, domain.NewVoteCastEvent(room.ID, room.Name, target.Name, votesInRound))
+	}
+
+	// Announce the placeholder so it shows up in the participant list
+	if created {
+		_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+			Type:        primary.RoomEventParticipantJoined,
+			Participant: target,
+		})
+	}
+
+	// Publish vote event (without the value - hidden until reveal)
+	_ = s.publisher.PublishVoteEvent(ctx, room.ID, primary.VoteEvent{
+		Type:            primary.VoteEventCast,
+		ParticipantID:   target.ID,
+		ParticipantName: target.Name,
+		IsProxy:         true,
+	})
+
+	s.revealIfComplete(ctx, room)
+
+	return target, nil
+}
+
+// RevealVotes reveals all votes (host or facilitator)
 func (s *EstimationService) RevealVotes(ctx context.Context, roomID, participantID, sessionToken string) (*domain.VoteSummary, error) {
 	room, err := s.repo.FindByID(ctx, roomID)
 	if err != nil {
@@ -88,12 +214,16 @@ func (s *EstimationService) RevealVotes(ctx context.Context, roomID, participant
 		return nil, err
 	}
 
-	// Check if host
-	if !room.IsHost(participantID) {
-		return nil, domain.ErrNotHost
+	// Check the caller may run rounds
+	if err := room.Authorize(participantID, domain.ActionManageRound); err != nil {
+		return nil, err
 	}
 
-	// Reveal votes
+	return s.reveal(ctx, room)
+}
+
+// reveal reveals the room's votes and notifies watchers
+func (s *EstimationService) reveal(ctx context.Context, room *domain.Room) (*domain.VoteSummary, error) {
 	summary, err := room.RevealVotes()
 	if err != nil {
 		return nil, err
@@ -136,6 +266,14 @@ func (s *EstimationService) RevealVotes(ctx context.Context, roomID, participant
 	return summary, nil
 }
 
+// revealIfComplete reveals an async round as soon as every voter has voted
+func (s *EstimationService) revealIfComplete(ctx context.Context, room *domain.Room) {
+	if room.IsAsync() && room.AllVoted() {
+		// A concurrent vote may have revealed the round already
+		_, _ = s.reveal(ctx, room)
+	}
+}
+
 // ResetRound clears all votes and starts a new round
 func (s *EstimationService) ResetRound(ctx context.Context, roomID, participantID, sessionToken string) error {
 	room, err := s.repo.FindByID(ctx, roomID)
@@ -148,9 +286,9 @@ func (s *EstimationService) ResetRound(ctx context.Context, roomID, participantI
 		return err
 	}
 
-	// Check if host
-	if !room.IsHost(participantID) {
-		return domain.ErrNotHost
+	// Check the caller may run rounds
+	if err := room.Authorize(participantID, domain.ActionManageRound); err != nil {
+		return err
 	}
 
 	// Reset round
@@ -176,7 +314,7 @@ func (s *EstimationService) ResetRound(ctx context.Context, roomID, participantI
 	return nil
 }
 
-// StartRound begins a new voting round (host only)
+// StartRound begins a new voting round (host or facilitator)
 func (s *EstimationService) StartRound(ctx context.Context, roomID, participantID, sessionToken string) error {
 	room, err := s.repo.FindByID(ctx, roomID)
 	if err != nil {
@@ -188,9 +326,9 @@ func (s *EstimationService) StartRound(ctx context.Context, roomID, participantI
 		return err
 	}
 
-	// Check if host
-	if !room.IsHost(participantID) {
-		return domain.ErrNotHost
+	// Check the caller may run rounds
+	if err := room.Authorize(participantID, domain.ActionManageRound); err != nil {
+		return err
 	}
 
 	// Start voting if in waiting state
@@ -200,6 +338,9 @@ func (s *EstimationService) StartRound(ctx context.Context, roomID, participantI
 
 	room.StartVoting()
 
+	// A new story may come with a new facilitator
+	rotated := room.RotateFacilitatorRole()
+
 	room.TouchActivity()
 
 	if err := s.repo.Save(ctx, room); err != nil {
@@ -212,81 +353,222 @@ func (s *EstimationService) StartRound(ctx context.Context, roomID, participantI
 		NewState: domain.RoomStateVoting,
 	})
 
+	for _, p := range rotated {
+		_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+			Type:        primary.RoomEventParticipantUpdated,
+			Participant: p,
+		})
+	}
+
 	return nil
 }
 
-// WatchVotes returns a channel for vote events
-func (s *EstimationService) WatchVotes(ctx context.Context, roomID, sessionToken string) (<-chan primary.VoteEvent, error) {
-	// Verify room exists
+// UndoLastTransition restores the round from before the last start, reveal or reset (host or facilitator)
+func (s *EstimationService) UndoLastTransition(ctx context.Context, roomID, participantID, sessionToken string) error {
 	room, err := s.repo.FindByID(ctx, roomID)
 	if err != nil {
-		room, err = s.repo.FindByName(ctx, roomID)
-		if err != nil {
-			return nil, err
+		return err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return err
+	}
+
+	transition, err := room.UndoLastTransition(participantID)
+	if err != nil {
+		return err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return err
+	}
+
+	state := room.GetState()
+
+	_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+		Type:       primary.RoomEventTransitionUndone,
+		NewState:   state,
+		Transition: transition,
+	})
+
+	// Replay the restored round so vote watchers resync
+	_ = s.publisher.PublishVoteEvent(ctx, room.ID, primary.VoteEvent{
+		Type: primary.VoteEventReset,
+	})
+
+	for _, vote := range room.GetVoteStatus() {
+		if vote.HasVoted {
+			_ = s.publisher.PublishVoteEvent(ctx, room.ID, primary.VoteEvent{
+				Type:            primary.VoteEventCast,
+				ParticipantID:   vote.ParticipantID,
+				ParticipantName: vote.ParticipantName,
+				IsProxy:         vote.IsProxy,
+			})
+		}
+	}
+
+	if state == domain.RoomStateRevealed {
+		if summary, err := room.GetVoteSummary(); err == nil {
+			_ = s.publisher.PublishVoteEvent(ctx, room.ID, primary.VoteEvent{
+				Type:    primary.VoteEventRevealed,
+				Summary: summary,
+			})
 		}
 	}
 
-	// Capture initial state before subscribing to avoid race conditions
-	initialVoteStatus := room.GetVoteStatus()
-	roomState := room.GetState()
-	var initialSummary *domain.VoteSummary
-	if roomState == domain.RoomStateRevealed {
-		initialSummary, _ = room.GetVoteSummary()
+	return nil
+}
+
+// SetVotingDeadline sets when the current async round is revealed automatically (host or facilitator)
+func (s *EstimationService) SetVotingDeadline(ctx context.Context, roomID, participantID, sessionToken string, deadline time.Time) error {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return err
 	}
 
-	// Subscribe to events
-	eventCh, unsubscribe := s.publisher.SubscribeVoteEvents(ctx, room.ID)
+	previousState := room.GetState()
+
+	if err := room.SetDeadline(participantID, deadline); err != nil {
+		return err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return err
+	}
+
+	_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+		Type:     primary.RoomEventDeadlineChanged,
+		Deadline: deadline,
+	})
+
+	// Setting a deadline opens voting in a waiting room
+	if previousState != domain.RoomStateVoting {
+		_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+			Type:     primary.RoomEventStateChanged,
+			NewState: domain.RoomStateVoting,
+		})
+	}
+
+	return nil
+}
+
+// GetRoundHistory returns the revealed rounds of a room, oldest first
+func (s *EstimationService) GetRoundHistory(ctx context.Context, roomID, sessionToken string) ([]*domain.RoundResult, error) {
+	room, err := findRoom(ctx, s.repo, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only participants of the room may read its history
+	if _, err := room.GetParticipantByToken(sessionToken); err != nil {
+		return nil, domain.ErrInvalidToken
+	}
+
+	return room.GetHistory(), nil
+}
+
+// WatchVotes returns a channel for vote events, starting with the current vote
+// state or resuming after a sequence number
+func (s *EstimationService) WatchVotes(ctx context.Context, roomID, sessionToken, passcode string, resume primary.ResumePoint) (<-chan primary.VoteEvent, error) {
+	// Verify room exists
+	room, err := findRoom(ctx, s.repo, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only participants may watch private rooms; others need the passcode if set
+	if err := room.AuthorizeWatcher(sessionToken, passcode, time.Now()); err != nil {
+		return nil, err
+	}
+
+	// Streams opened with the view token end when it is revoked
+	viewing := viewingCheck(room, sessionToken)
+
+	// Subscribe before the vote state is captured to avoid race conditions
+	follower := followRoomState(ctx, s.publisher, room.ID)
+
+	// The watching participant is connected for as long as the stream is open
+	release := s.presence.Watch(ctx, room, sessionToken)
+	watcherID := ""
+	if participant, err := room.GetParticipantByToken(sessionToken); err == nil {
+		watcherID = participant.ID
+	}
 
 	// Create output channel that handles context cancellation
 	outputCh := make(chan primary.VoteEvent, 10)
 
 	go func() {
 		defer close(outputCh)
-		defer unsubscribe()
+		defer release()
 
-		// Send initial state: vote cast events for participants who have already voted
-		for _, vote := range initialVoteStatus {
-			if vote.HasVoted {
-				select {
-				case outputCh <- primary.VoteEvent{
-					Type:            primary.VoteEventCast,
-					ParticipantID:   vote.ParticipantID,
-					ParticipantName: vote.ParticipantName,
-				}:
-				case <-ctx.Done():
-					return
-				}
+		send := func(event primary.VoteEvent) bool {
+			select {
+			case outputCh <- event:
+				return true
+			case <-ctx.Done():
+				return false
 			}
 		}
 
-		// If room is revealed, send the summary
-		if initialSummary != nil {
-			select {
-			case outputCh <- primary.VoteEvent{
-				Type:    primary.VoteEventRevealed,
-				Summary: initialSummary,
-			}:
-			case <-ctx.Done():
-				return
+		emit := func(event primary.RoomStateEvent) bool {
+			if !viewing() {
+				return false
+			}
+			// Room events belong to WatchRoom, but a removed participant's stream ends
+			if event.Vote == nil {
+				removed := event.Room != nil && event.Room.Type == primary.RoomEventParticipantRemoved && event.Room.ParticipantID == watcherID
+				return !removed
 			}
+			return send(*event.Vote)
 		}
 
-		// Continue with live events
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case event, ok := <-eventCh:
-				if !ok {
-					return
+		// resync sends the current vote state, after telling a resuming client
+		// to discard what it has
+		resync := func(sequence uint64, initial bool) bool {
+			if !viewing() {
+				return false
+			}
+			if !initial && !send(primary.VoteEvent{Epoch: follower.epoch, Sequence: sequence, Type: primary.VoteEventResync}) {
+				return false
+			}
+
+			// Vote cast events for participants who have already voted
+			for _, vote := range room.GetVoteStatus() {
+				if !vote.HasVoted {
+					continue
+				}
+				if !send(primary.VoteEvent{
+					Epoch:           follower.epoch,
+					Sequence:        sequence,
+					Type:            primary.VoteEventCast,
+					ParticipantID:   vote.ParticipantID,
+					ParticipantName: vote.ParticipantName,
+					IsProxy:         vote.IsProxy,
+				}) {
+					return false
 				}
-				select {
-				case outputCh <- event:
-				case <-ctx.Done():
-					return
+			}
+
+			// If room is revealed, send the summary
+			if room.GetState() == domain.RoomStateRevealed {
+				if summary, err := room.GetVoteSummary(); err == nil {
+					return send(primary.VoteEvent{Epoch: follower.epoch, Sequence: sequence, Type: primary.VoteEventRevealed, Summary: summary})
 				}
 			}
+			return true
 		}
+
+		follower.run(ctx, resume, emit, resync)
 	}()
 
 	return outputCh, nil
@@ -0,0 +1,71 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+	"github.com/vicmanager/esteemed/backend/internal/ports/primary"
+)
+
+func TestEstimationService_CastProxyVote(t *testing.T) {
+	ctx := context.Background()
+	rooms, repo, broker := newTestRoomService(t)
+	service := NewEstimationService(repo, broker, nil, nil, nil)
+
+	room := domain.NewRoom("r1", "brave-nebula", &domain.Participant{ID: "host", Name: "Host", SessionToken: "host-token", IsConnected: true}, nil)
+	_ = room.AddParticipant(&domain.Participant{ID: "voter", Name: "Pat", SessionToken: "voter-token", IsConnected: true})
+	room.StartVoting()
+	_ = repo.Save(ctx, room)
+
+	if _, err := service.CastProxyVote(ctx, room.ID, "voter", "voter-token", "", "Sam", "5"); err != domain.ErrNotHost {
+		t.Errorf("expected ErrNotHost for a voter, got %v", err)
+	}
+	target, err := service.CastProxyVote(ctx, room.ID, "host", "host-token", "", "Sam", "5")
+	if err != nil {
+		t.Fatalf("failed to cast proxy vote: %v", err)
+	}
+
+	// Nobody can act as the placeholder without its token, which is never handed out
+	if err := service.CastVote(ctx, room.ID, target.ID, "", "8"); err != domain.ErrInvalidToken {
+		t.Errorf("expected ErrInvalidToken voting as the placeholder, got %v", err)
+	}
+	if _, err := service.GetRoundHistory(ctx, room.ID, ""); err != domain.ErrInvalidToken {
+		t.Errorf("expected ErrInvalidToken reading history without a token, got %v", err)
+	}
+	if snapshot, err := rooms.GetRoom(ctx, room.ID, "", ""); err != nil || snapshot.ParticipantID != "" {
+		t.Errorf("expected an outsider's snapshot without a token, got %+v, %v", snapshot, err)
+	}
+}
+
+func TestEstimationService_WatchVotesEndsForBannedParticipant(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	rooms, repo, broker := newTestRoomService(t)
+	service := NewEstimationService(repo, broker, nil, nil, rooms.presence)
+
+	room := domain.NewRoom("r1", "brave-nebula", &domain.Participant{ID: "host", SessionToken: "host-token", IsConnected: true}, nil)
+	_ = room.AddParticipant(&domain.Participant{ID: "voter", SessionToken: "voter-token", IsConnected: true})
+	_ = repo.Create(ctx, room)
+
+	events, err := service.WatchVotes(ctx, room.ID, "voter-token", "", primary.ResumePoint{})
+	if err != nil {
+		t.Fatalf("failed to watch votes: %v", err)
+	}
+	if err := rooms.KickParticipant(ctx, room.ID, "host", "host-token", "voter", primary.KickOptions{Ban: true}); err != nil {
+		t.Fatalf("failed to ban: %v", err)
+	}
+
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case _, open := <-events:
+			if !open {
+				return
+			}
+		case <-timeout:
+			t.Fatal("expected the banned participant's vote stream to end")
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package app
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+)
+
+// expiryEntry is a room's next scheduled check
+type expiryEntry struct {
+	roomID string
+	at     time.Time
+	index  int
+}
+
+// expiryHeap is a min-heap of expiry entries ordered by check time
+type expiryHeap []*expiryEntry
+
+func (h expiryHeap) Len() int           { return len(h) }
+func (h expiryHeap) Less(i, j int) bool { return h[i].at.Before(h[j].at) }
+
+func (h expiryHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *expiryHeap) Push(x any) {
+	entry := x.(*expiryEntry)
+	entry.index = len(*h)
+	*h = append(*h, entry)
+}
+
+func (h *expiryHeap) Pop() any {
+	old := *h
+	n := len(old)
+	entry := old[n-1]
+	old[n-1] = nil
+	entry.index = -1
+	*h = old[:n-1]
+	return entry
+}
+
+// expiryQueue schedules one timed check per room (an expiry or a deadline), earliest first
+type expiryQueue struct {
+	mu      sync.Mutex
+	entries expiryHeap
+	byRoom  map[string]*expiryEntry
+	wake    chan struct{} // Signalled when the earliest check moves forward
+}
+
+// newExpiryQueue creates an empty expiry queue
+func newExpiryQueue() *expiryQueue {
+	return &expiryQueue{
+		byRoom: make(map[string]*expiryEntry),
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+// Schedule sets when a room is checked next, replacing any earlier schedule
+func (q *expiryQueue) Schedule(roomID string, at time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if entry, exists := q.byRoom[roomID]; exists {
+		entry.at = at
+		heap.Fix(&q.entries, entry.index)
+	} else {
+		entry := &expiryEntry{roomID: roomID, at: at}
+		heap.Push(&q.entries, entry)
+		q.byRoom[roomID] = entry
+	}
+
+	// Wake the scheduler if this is now the earliest check
+	if q.entries[0].roomID == roomID {
+		select {
+		case q.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Next returns the time of the earliest check, or false if nothing is scheduled
+func (q *expiryQueue) Next() (time.Time, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.entries) == 0 {
+		return time.Time{}, false
+	}
+	return q.entries[0].at, true
+}
+
+// PopDue removes and returns the rooms whose check is due at the given time
+func (q *expiryQueue) PopDue(now time.Time) []string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var due []string
+	for len(q.entries) > 0 && !q.entries[0].at.After(now) {
+		entry := heap.Pop(&q.entries).(*expiryEntry)
+		delete(q.byRoom, entry.roomID)
+		due = append(due, entry.roomID)
+	}
+	return due
+}
@@ -0,0 +1,34 @@
+package app
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+)
+
+func TestInviteSigner_RoundTrip(t *testing.T) {
+	signer := NewInviteSigner("secret")
+	now := time.Now()
+	token := signer.Sign("r1", &domain.Invite{ID: "i1", ExpiresAt: now.Add(time.Hour)})
+
+	roomID, inviteID, err := signer.Verify(token, now)
+	if err != nil || roomID != "r1" || inviteID != "i1" {
+		t.Fatalf("expected r1/i1, got %s/%s, %v", roomID, inviteID, err)
+	}
+
+	if _, _, err := signer.Verify(token, now.Add(2*time.Hour)); err != domain.ErrInvalidInvite {
+		t.Errorf("expected expired token rejected, got %v", err)
+	}
+	if _, _, err := NewInviteSigner("other").Verify(token, now); err != domain.ErrInvalidInvite {
+		t.Errorf("expected token from another secret rejected, got %v", err)
+	}
+
+	forged := signer.Sign("r2", &domain.Invite{ID: "i1", ExpiresAt: now.Add(time.Hour)})
+	payload, _, _ := strings.Cut(forged, ".")
+	_, signature, _ := strings.Cut(token, ".")
+	if _, _, err := signer.Verify(payload+"."+signature, now); err != domain.ErrInvalidInvite {
+		t.Errorf("expected token pointed at another room rejected, got %v", err)
+	}
+}
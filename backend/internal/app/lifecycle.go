@@ -0,0 +1,112 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sort"
+
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+	"github.com/vicmanager/esteemed/backend/internal/ports/primary"
+	"github.com/vicmanager/esteemed/backend/internal/ports/secondary"
+)
+
+// CloseStage orders the hooks that run when a room is closed
+type CloseStage int
+
+// CloseStage constants in the order their hooks run.
+const (
+	CloseStageAnalytics CloseStage = iota
+	CloseStageAppEvents
+	CloseStageRoomEvents
+	CloseStageArchive
+	CloseStageDelete
+	CloseStageRelease
+)
+
+// CloseHook runs one step of closing a room
+type CloseHook func(ctx context.Context, room *domain.Room, reason domain.CloseReason) error
+
+// stagedHook is a close hook with its stage
+type stagedHook struct {
+	stage CloseStage
+	hook  CloseHook
+}
+
+// RoomLifecycle owns closing rooms. Every close runs the same ordered hooks:
+// analytics, app events, room events, archiving, delete, then releasing the
+// room's event state.
+type RoomLifecycle struct {
+	hooks []stagedHook
+}
+
+// NewRoomLifecycle creates a room lifecycle with the standard close hooks
+func NewRoomLifecycle(repo secondary.RoomRepository, publisher secondary.EventPublisher, analytics secondary.AnalyticsRepository, appPublisher secondary.AppEventPublisher) *RoomLifecycle {
+	l := &RoomLifecycle{}
+
+	if analytics != nil {
+		l.OnClose(CloseStageAnalytics, func(ctx context.Context, room *domain.Room, reason domain.CloseReason) error {
+			return analytics.RecordEvent(ctx, domain.NewAnalyticsEvent(domain.EventTypeRoomClosed, room.ID, reason.String()))
+		})
+	}
+
+	if appPublisher != nil {
+		l.OnClose(CloseStageAppEvents, func(ctx context.Context, room *domain.Room, reason domain.CloseReason) error {
+			return appPublisher.Publish(ctx, domain.NewRoomClosedEvent(room.ID, room.Name, reason))
+		})
+	}
+
+	// Notify connected clients before deletion
+	l.OnClose(CloseStageRoomEvents, func(ctx context.Context, room *domain.Room, reason domain.CloseReason) error {
+		return publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+			Type:        primary.RoomEventClosed,
+			Reason:      reason.String(),
+			CloseReason: reason,
+		})
+	})
+
+	l.OnClose(CloseStageDelete, func(ctx context.Context, room *domain.Room, _ domain.CloseReason) error {
+		detachFromParent(ctx, repo, room)
+
+		// The room may already be gone if two closes race
+		if err := repo.Delete(ctx, room.ID); err != nil && err != domain.ErrRoomNotFound {
+			return err
+		}
+		return nil
+	})
+
+	// End the remaining streams and free the room's sequence numbers and replay buffer
+	l.OnClose(CloseStageRelease, func(ctx context.Context, room *domain.Room, _ domain.CloseReason) error {
+		publisher.ForgetRoom(ctx, room.ID)
+		return nil
+	})
+
+	return l
+}
+
+// OnClose registers a hook to run at the given stage of every close. Hooks of
+// the same stage run in registration order.
+func (l *RoomLifecycle) OnClose(stage CloseStage, hook CloseHook) {
+	l.hooks = append(l.hooks, stagedHook{stage: stage, hook: hook})
+	sort.SliceStable(l.hooks, func(i, j int) bool {
+		return l.hooks[i].stage < l.hooks[j].stage
+	})
+}
+
+// Close runs every close hook for the room. A failing hook does not stop later
+// ones. Notification and archive failures are logged; delete failures are returned.
+func (l *RoomLifecycle) Close(ctx context.Context, room *domain.Room, reason domain.CloseReason) error {
+	var errs []error
+	for _, h := range l.hooks {
+		err := h.hook(ctx, room, reason)
+		if err == nil {
+			continue
+		}
+		if h.stage == CloseStageDelete {
+			errs = append(errs, err)
+		} else {
+			log.Printf("RoomLifecycle: close hook failed for room %s: %v", room.ID, err)
+		}
+	}
+	return errors.Join(errs...)
+}
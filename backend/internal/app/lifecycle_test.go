@@ -0,0 +1,43 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/vicmanager/esteemed/backend/internal/adapters/secondary/memory"
+	"github.com/vicmanager/esteemed/backend/internal/adapters/secondary/pubsub"
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+)
+
+func TestRoomLifecycle_RunsHooksInStageOrder(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewRoomRepository()
+	lifecycle := NewRoomLifecycle(repo, pubsub.NewBroker(), nil, nil)
+
+	room := domain.NewRoom("r1", "brave-nebula", &domain.Participant{ID: "host"}, nil)
+	_ = repo.Create(ctx, room)
+
+	var ran []string
+	record := func(name string) CloseHook {
+		return func(ctx context.Context, room *domain.Room, reason domain.CloseReason) error {
+			// Hooks before delete still see the room
+			_, err := repo.FindByID(ctx, room.ID)
+			ran = append(ran, fmt.Sprintf("%s:%v:%v", name, reason, err == nil))
+			return nil
+		}
+	}
+	lifecycle.OnClose(CloseStageArchive, record("archive"))
+	lifecycle.OnClose(CloseStageAnalytics, record("analytics"))
+
+	if err := lifecycle.Close(ctx, room, domain.CloseReasonInactivity); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if fmt.Sprint(ran) != "[analytics:inactivity timeout:true archive:inactivity timeout:true]" {
+		t.Errorf("unexpected hook order: %v", ran)
+	}
+	if _, err := repo.FindByID(ctx, room.ID); err != domain.ErrRoomNotFound {
+		t.Errorf("expected room to be deleted, got %v", err)
+	}
+}
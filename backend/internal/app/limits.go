@@ -0,0 +1,36 @@
+package app
+
+// DefaultMaxVoters is the most voters a room takes unless a deployment configures otherwise
+const DefaultMaxVoters = 50
+
+// DefaultMaxSpectators is the most spectators a room takes unless a deployment configures otherwise
+const DefaultMaxSpectators = 100
+
+// RoomLimits configures the most participants any room of a deployment takes.
+// Rooms may set lower limits of their own.
+type RoomLimits struct {
+	MaxVoters     int // Ceiling on voters per room (0 for no ceiling)
+	MaxSpectators int // Ceiling on spectators per room (0 for no ceiling)
+}
+
+// DefaultRoomLimits returns the limits used when a deployment does not configure any
+func DefaultRoomLimits() RoomLimits {
+	return RoomLimits{
+		MaxVoters:     DefaultMaxVoters,
+		MaxSpectators: DefaultMaxSpectators,
+	}
+}
+
+// clamp caps a room's requested limits at the deployment's ceilings. A room
+// without a limit of its own gets the ceiling.
+func (l RoomLimits) clamp(maxVoters, maxSpectators int) (int, int) {
+	return clampLimit(maxVoters, l.MaxVoters), clampLimit(maxSpectators, l.MaxSpectators)
+}
+
+// clampLimit caps a single limit at a ceiling (zero means none for both)
+func clampLimit(limit, ceiling int) int {
+	if ceiling > 0 && (limit == 0 || limit > ceiling) {
+		return ceiling
+	}
+	return limit
+}
@@ -0,0 +1,209 @@
+package app
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+	"github.com/vicmanager/esteemed/backend/internal/ports/primary"
+	"github.com/vicmanager/esteemed/backend/internal/ports/secondary"
+)
+
+// presenceKey identifies a participant across rooms
+type presenceKey struct {
+	roomID        string
+	participantID string
+}
+
+// Presence ties whether a participant is connected to the event streams they
+// have open. A participant is connected while any of their streams is open and
+// is disconnected once the last one has been closed for the presence timeout.
+type Presence struct {
+	repo      secondary.RoomRepository
+	publisher secondary.EventPublisher
+	lifecycle *RoomLifecycle
+	timeout   time.Duration
+
+	mu      sync.Mutex
+	streams map[presenceKey]int
+	pending map[presenceKey]*time.Timer
+}
+
+// NewPresence creates a presence tracker that disconnects participants once
+// they have had no open stream for the timeout
+func NewPresence(repo secondary.RoomRepository, publisher secondary.EventPublisher, lifecycle *RoomLifecycle, timeout time.Duration) *Presence {
+	return &Presence{
+		repo:      repo,
+		publisher: publisher,
+		lifecycle: lifecycle,
+		timeout:   timeout,
+		streams:   make(map[presenceKey]int),
+		pending:   make(map[presenceKey]*time.Timer),
+	}
+}
+
+// Attach records an open stream for a participant, reconnecting them if they
+// had been disconnected. The returned function must be called when the stream ends.
+func (p *Presence) Attach(ctx context.Context, room *domain.Room, participantID string) func() {
+	key := presenceKey{roomID: room.ID, participantID: participantID}
+
+	p.mu.Lock()
+	p.streams[key]++
+	if timer, ok := p.pending[key]; ok {
+		timer.Stop()
+		delete(p.pending, key)
+	}
+	p.mu.Unlock()
+
+	if participant, err := room.GetParticipant(participantID); err == nil && !participant.IsConnected {
+		p.reconnect(ctx, room, participantID)
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { p.release(key) })
+	}
+}
+
+// Watch attaches a stream opened with a session token to the participant it
+// belongs to. Streams without a participant's token do not count towards presence.
+func (p *Presence) Watch(ctx context.Context, room *domain.Room, sessionToken string) func() {
+	if sessionToken == "" {
+		return func() {}
+	}
+	participant, err := room.GetParticipantByToken(sessionToken)
+	if err != nil {
+		return func() {}
+	}
+	return p.Attach(ctx, room, participant.ID)
+}
+
+// Expect starts the presence timeout for a participant who just joined, so
+// they are disconnected if they never open a stream
+func (p *Presence) Expect(roomID, participantID string) {
+	key := presenceKey{roomID: roomID, participantID: participantID}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.streams[key] == 0 {
+		p.schedule(key)
+	}
+}
+
+// release records a closed stream, scheduling the participant's disconnect
+// once they have none left
+func (p *Presence) release(key presenceKey) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.streams[key]--
+	if p.streams[key] > 0 {
+		return
+	}
+	delete(p.streams, key)
+	p.schedule(key)
+}
+
+// schedule disconnects a participant after the presence timeout unless a
+// stream is attached first (caller must hold the lock)
+func (p *Presence) schedule(key presenceKey) {
+	if timer, ok := p.pending[key]; ok {
+		timer.Stop()
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(p.timeout, func() {
+		p.mu.Lock()
+		// A stream was attached or the timeout restarted in the meantime
+		if p.pending[key] != timer || p.streams[key] > 0 {
+			p.mu.Unlock()
+			return
+		}
+		delete(p.pending, key)
+		p.mu.Unlock()
+
+		p.expire(context.Background(), key)
+	})
+	p.pending[key] = timer
+}
+
+// expire disconnects a participant whose streams all went away
+func (p *Presence) expire(ctx context.Context, key presenceKey) {
+	room, err := p.repo.FindByID(ctx, key.roomID)
+	if err != nil {
+		// Room already closed
+		return
+	}
+
+	participant, err := room.GetParticipant(key.participantID)
+	if err != nil || !participant.IsConnected {
+		// Left or was removed in the meantime
+		return
+	}
+
+	if err := p.disconnect(ctx, room, key.participantID); err != nil {
+		log.Printf("Presence: failed to disconnect %s from room %s: %v", key.participantID, key.roomID, err)
+	}
+}
+
+// disconnect marks a participant as disconnected and tells the room, closing
+// it once everyone has gone. Used both for explicit leaves and lost streams.
+func (p *Presence) disconnect(ctx context.Context, room *domain.Room, participantID string) error {
+	// Mark participant as disconnected (not removed, so they can reconnect)
+	hostChange, err := room.DisconnectParticipant(participantID)
+	if err != nil {
+		return err
+	}
+
+	// Check if all participants are disconnected (async and persistent rooms live on without anyone connected)
+	if !room.HasConnectedParticipants() && !room.IsAsync() && !room.IsPersistent() {
+		// Delete room when everyone has disconnected
+		return p.lifecycle.Close(ctx, room, domain.CloseReasonAllLeft)
+	}
+
+	room.TouchActivity()
+
+	if err := p.repo.Save(ctx, room); err != nil {
+		return err
+	}
+
+	// Notify other participants that this person left
+	_ = p.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+		Type:          primary.RoomEventParticipantLeft,
+		ParticipantID: participantID,
+	})
+
+	// If the host left, publish how the room was handed over
+	if hostChange != nil {
+		_ = p.publisher.PublishRoomEvent(ctx, room.ID, hostChangedEvent(hostChange))
+	}
+
+	return nil
+}
+
+// reconnect marks a participant whose stream came back as connected again
+func (p *Presence) reconnect(ctx context.Context, room *domain.Room, participantID string) {
+	hostChange, err := room.Reconnect(participantID)
+	if err != nil {
+		return
+	}
+	room.TouchActivity()
+
+	if err := p.repo.Save(ctx, room); err != nil {
+		log.Printf("Presence: failed to save room %s: %v", room.ID, err)
+		return
+	}
+
+	if participant, err := room.GetParticipant(participantID); err == nil {
+		_ = p.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+			Type:        primary.RoomEventParticipantJoined,
+			Participant: participant,
+		})
+	}
+	if hostChange != nil {
+		_ = p.publisher.PublishRoomEvent(ctx, room.ID, hostChangedEvent(hostChange))
+	}
+}
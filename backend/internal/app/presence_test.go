@@ -0,0 +1,53 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vicmanager/esteemed/backend/internal/adapters/secondary/memory"
+	"github.com/vicmanager/esteemed/backend/internal/adapters/secondary/pubsub"
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+	"github.com/vicmanager/esteemed/backend/internal/ports/primary"
+)
+
+func TestPresence_DisconnectsAfterLastStreamCloses(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewRoomRepository()
+	broker := pubsub.NewBroker()
+	presence := NewPresence(repo, broker, NewRoomLifecycle(repo, broker, nil, nil), 20*time.Millisecond)
+
+	room := domain.NewRoom("r1", "brave-nebula", &domain.Participant{ID: "host", IsConnected: true}, nil)
+	_ = room.AddParticipant(&domain.Participant{ID: "guest", SessionToken: "guest-token", IsConnected: true})
+	_ = repo.Create(ctx, room)
+
+	events, unsubscribe := broker.SubscribeRoomEvents(ctx, room.ID)
+	defer unsubscribe()
+
+	// A reload closes one stream and opens another within the timeout
+	releaseRoom := presence.Watch(ctx, room, "guest-token")
+	releaseVotes := presence.Watch(ctx, room, "guest-token")
+	releaseRoom()
+	releaseVotes()
+	releaseRoom = presence.Watch(ctx, room, "guest-token")
+	time.Sleep(40 * time.Millisecond)
+
+	if guest, _ := room.GetParticipant("guest"); !guest.IsConnected {
+		t.Fatal("expected guest to stay connected while a stream is open")
+	}
+
+	releaseRoom()
+
+	select {
+	case event := <-events:
+		if event.Type != primary.RoomEventParticipantLeft || event.ParticipantID != "guest" {
+			t.Errorf("expected left event for the guest, got %v/%s", event.Type, event.ParticipantID)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected a left event")
+	}
+
+	if guest, _ := room.GetParticipant("guest"); guest.IsConnected {
+		t.Error("expected guest to be disconnected")
+	}
+}
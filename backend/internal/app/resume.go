@@ -0,0 +1,100 @@
+package app
+
+import (
+	"context"
+
+	"github.com/vicmanager/esteemed/backend/internal/ports/primary"
+	"github.com/vicmanager/esteemed/backend/internal/ports/secondary"
+)
+
+// roomStateFollower follows a room's sequenced events for one stream, filling
+// gaps from the publisher's replay buffer
+type roomStateFollower struct {
+	publisher   secondary.EventPublisher
+	roomID      string
+	events      <-chan primary.RoomStateEvent
+	epoch       uint64 // Epoch of the room's sequence; events and resyncs are stamped with it
+	sequence    uint64 // Last sequence number handed out before subscribing
+	unsubscribe func()
+}
+
+// followRoomState subscribes to a room's sequenced events right away, so the
+// stream misses nothing published after it was opened
+func followRoomState(ctx context.Context, publisher secondary.EventPublisher, roomID string) *roomStateFollower {
+	events, epoch, sequence, unsubscribe := publisher.SubscribeRoomState(ctx, roomID)
+	return &roomStateFollower{
+		publisher:   publisher,
+		roomID:      roomID,
+		events:      events,
+		epoch:       epoch,
+		sequence:    sequence,
+		unsubscribe: unsubscribe,
+	}
+}
+
+// run delivers the events to emit in order, without gaps or repeats, starting
+// after the given resume point. Whenever missed events cannot be recovered
+// from the replay buffer, including a resume point that is too old or from
+// another epoch, resync is called with the sequence number the stream's state
+// should reflect and delivery carries on after it. A stream that does not
+// resume starts with an initial resync at the subscription's sequence number.
+// Returns, ending the subscription, when ctx ends, the room's events end, or a
+// callback returns false.
+func (f *roomStateFollower) run(ctx context.Context, resume primary.ResumePoint, emit func(primary.RoomStateEvent) bool, resync func(sequence uint64, initial bool) bool) {
+	defer f.unsubscribe()
+
+	// catchUp moves the stream from a sequence number to the newest event
+	catchUp := func(from uint64) (uint64, bool) {
+		missed, current, ok := f.publisher.ReplayRoomState(ctx, f.roomID, f.epoch, from)
+		if !ok {
+			return current, resync(current, false)
+		}
+		for _, event := range missed {
+			if !emit(event) {
+				return from, false
+			}
+			from = event.Sequence
+		}
+		return from, true
+	}
+
+	last, ok := f.sequence, true
+	switch {
+	case resume.Sequence == 0:
+		ok = resync(f.sequence, true)
+	case resume.Epoch != f.epoch:
+		// The resume point is from an earlier run of the room's numbering
+		ok = resync(f.sequence, false)
+	default:
+		last, ok = catchUp(resume.Sequence)
+	}
+	if !ok {
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, open := <-f.events:
+			if !open {
+				return
+			}
+			switch {
+			case event.Sequence <= last:
+				// Already delivered by a replay or covered by a resync
+				continue
+			case event.Sequence > last+1:
+				// Events were dropped while the stream was behind; the replay includes this one
+				if last, ok = catchUp(last); !ok {
+					return
+				}
+				continue
+			}
+			if !emit(event) {
+				return
+			}
+			last = event.Sequence
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vicmanager/esteemed/backend/internal/adapters/secondary/pubsub"
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+	"github.com/vicmanager/esteemed/backend/internal/ports/primary"
+)
+
+// resumeAt returns a resume point in the room's current epoch.
+func resumeAt(broker *pubsub.Broker, roomID string, sequence uint64) primary.ResumePoint {
+	_, epoch, _, unsubscribe := broker.SubscribeRoomState(context.Background(), roomID)
+	unsubscribe()
+	return primary.ResumePoint{Epoch: epoch, Sequence: sequence}
+}
+
+func TestRoomService_WatchRoomResume(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	service, repo, broker := newTestRoomService(t)
+
+	room := domain.NewRoom("r1", "brave-nebula", &domain.Participant{ID: "host", SessionToken: "host-token", IsConnected: true}, nil)
+	_ = repo.Create(ctx, room)
+
+	// Sequences 1 to 3; the vote event is skipped by room streams
+	_ = broker.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{Type: primary.RoomEventKeptAlive})
+	_ = broker.PublishVoteEvent(ctx, room.ID, primary.VoteEvent{Type: primary.VoteEventReset})
+	_ = broker.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{Type: primary.RoomEventStateChanged})
+
+	next := func(events <-chan primary.RoomEvent) primary.RoomEvent {
+		t.Helper()
+		select {
+		case event := <-events:
+			return event
+		case <-time.After(time.Second):
+			t.Fatal("expected an event")
+			return primary.RoomEvent{}
+		}
+	}
+
+	streamCtx, stop := context.WithCancel(ctx)
+	events, err := service.WatchRoom(streamCtx, room.ID, "host-token", "", resumeAt(broker, room.ID, 1))
+	if err != nil {
+		t.Fatalf("failed to watch: %v", err)
+	}
+	if event := next(events); event.Type != primary.RoomEventStateChanged || event.Sequence != 3 {
+		t.Errorf("expected the missed state change replayed as sequence 3, got %+v", event)
+	}
+	_ = broker.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{Type: primary.RoomEventKeptAlive})
+	if event := next(events); event.Type != primary.RoomEventKeptAlive || event.Sequence != 4 {
+		t.Errorf("expected live events to follow as sequence 4, got %+v", event)
+	}
+	stop()
+
+	// Push the resume point out of the replay buffer
+	for i := 0; i < 300; i++ {
+		_ = broker.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{Type: primary.RoomEventKeptAlive})
+	}
+
+	events, err = service.WatchRoom(ctx, room.ID, "host-token", "", resumeAt(broker, room.ID, 4))
+	if err != nil {
+		t.Fatalf("failed to watch: %v", err)
+	}
+	event := next(events)
+	if event.Type != primary.RoomEventResync || event.Sequence != 304 || event.Snapshot == nil || event.Snapshot.ParticipantID != "host" {
+		t.Fatalf("expected a resync snapshot as of sequence 304, got %+v", event)
+	}
+	_ = broker.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{Type: primary.RoomEventStateChanged})
+	if event := next(events); event.Type != primary.RoomEventStateChanged || event.Sequence != 305 {
+		t.Errorf("expected live events after the resync, got %+v", event)
+	}
+}
+
+func TestRoomService_WatchRoomResumeAcrossIdleDrop(t *testing.T) {
+	ctx := context.Background()
+	service, repo, broker := newTestRoomService(t)
+	cleaner := NewRoomCleaner(repo, broker, DefaultRoomTimeouts(), service.lifecycle)
+
+	host := &domain.Participant{ID: "host", SessionToken: "host-token", IsConnected: true}
+	room := domain.NewRoom("r1", "brave-nebula", host, nil)
+	room.Persistent = true
+	_ = repo.Create(ctx, room)
+
+	_ = broker.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{Type: primary.RoomEventKeptAlive})
+	_ = broker.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{Type: primary.RoomEventParticipantJoined, Participant: host})
+	// The replay buffer keeps its own copy of the participant
+	host.Name = "renamed"
+
+	events, err := service.WatchRoom(ctx, room.ID, "host-token", "", resumeAt(broker, room.ID, 2))
+	if err != nil {
+		t.Fatalf("failed to watch: %v", err)
+	}
+	cleaner.dropIdleConnections(ctx, room)
+	for range events {
+	}
+
+	// Numbering carries on after the drop, so the client picks up the leave it missed
+	events, err = service.WatchRoom(ctx, room.ID, "host-token", "", resumeAt(broker, room.ID, 1))
+	if err != nil {
+		t.Fatalf("failed to watch: %v", err)
+	}
+	var replayed []primary.RoomEvent
+	for len(replayed) < 2 {
+		select {
+		case event := <-events:
+			replayed = append(replayed, event)
+		case <-time.After(time.Second):
+			t.Fatalf("expected the missed events, got %+v", replayed)
+		}
+	}
+	if replayed[0].Sequence != 2 || replayed[0].Participant == nil || replayed[0].Participant.Name == "renamed" {
+		t.Errorf("expected the join replayed unchanged as sequence 2, got %+v", replayed[0])
+	}
+	if replayed[1].Type != primary.RoomEventParticipantLeft || replayed[1].Sequence != 3 {
+		t.Errorf("expected the leave replayed as sequence 3, got %+v", replayed[1])
+	}
+
+	// Closing the room releases its event state
+	if err := service.lifecycle.Close(ctx, room, domain.CloseReasonAllLeft); err != nil {
+		t.Fatalf("failed to close: %v", err)
+	}
+	if _, seq, _ := broker.ReplayRoomState(ctx, room.ID, 0, 0); seq != 0 {
+		t.Errorf("expected the room's sequence to be dropped, got %d", seq)
+	}
+}
+
+func TestRoomService_WatchRoomResumeFromAnotherEpoch(t *testing.T) {
+	ctx := context.Background()
+	service, repo, broker := newTestRoomService(t)
+
+	room := domain.NewRoom("r1", "brave-nebula", &domain.Participant{ID: "host", SessionToken: "host-token", IsConnected: true}, nil)
+	room.Persistent = true
+	_ = repo.Create(ctx, room)
+
+	_ = broker.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{Type: primary.RoomEventKeptAlive})
+	stale := resumeAt(broker, room.ID, 1)
+
+	// Numbering starts over, as it does when the server restarts
+	broker.ForgetRoom(ctx, room.ID)
+	_ = broker.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{Type: primary.RoomEventKeptAlive})
+	_ = broker.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{Type: primary.RoomEventStateChanged})
+
+	events, err := service.WatchRoom(ctx, room.ID, "host-token", "", stale)
+	if err != nil {
+		t.Fatalf("failed to watch: %v", err)
+	}
+	select {
+	case event := <-events:
+		if event.Type != primary.RoomEventResync || event.Sequence != 2 || event.Snapshot == nil {
+			t.Fatalf("expected a resync snapshot as of sequence 2, got %+v", event)
+		}
+		if event.Epoch == 0 || event.Epoch == stale.Epoch {
+			t.Errorf("expected the resync in the new epoch, got %d (stale %d)", event.Epoch, stale.Epoch)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected a resync")
+	}
+}
@@ -0,0 +1,130 @@
+package app
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+	"github.com/vicmanager/esteemed/backend/internal/ports/secondary"
+)
+
+// DeadlineRevealer reveals async rounds whose deadline has passed. Rooms are
+// revealed when their deadline is due, using a queue ordered by deadline that
+// is updated whenever a room's activity is touched. A slow full sweep catches
+// rooms that were never scheduled.
+type DeadlineRevealer struct {
+	repo       secondary.RoomRepository
+	estimation *EstimationService
+	interval   time.Duration // Full sweep interval
+	queue      *expiryQueue
+}
+
+// NewDeadlineRevealer creates a new deadline revealer
+func NewDeadlineRevealer(repo secondary.RoomRepository, estimation *EstimationService) *DeadlineRevealer {
+	return &DeadlineRevealer{
+		repo:       repo,
+		estimation: estimation,
+		interval:   10 * time.Minute,
+		queue:      newExpiryQueue(),
+	}
+}
+
+// ActivityTouched schedules the reveal of a room's open async round
+// (implements domain.ActivityObserver). Every change to a deadline touches
+// the room's activity.
+func (r *DeadlineRevealer) ActivityTouched(room *domain.Room) {
+	if deadline := room.GetDeadline(); !deadline.IsZero() {
+		r.queue.Schedule(room.ID, deadline)
+	}
+}
+
+// Start begins the reveal goroutine
+func (r *DeadlineRevealer) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.revealAllDue(ctx)
+			case <-r.queue.wake:
+			case <-timer.C:
+				r.processDue(ctx, time.Now())
+			}
+
+			// Sleep until the earliest deadline
+			wait := r.interval
+			if next, ok := r.queue.Next(); ok {
+				wait = max(time.Until(next), 0)
+			}
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(wait)
+		}
+	}()
+}
+
+// processDue reveals every room whose deadline is due
+func (r *DeadlineRevealer) processDue(ctx context.Context, now time.Time) {
+	for _, roomID := range r.queue.PopDue(now) {
+		room, err := r.repo.FindByID(ctx, roomID)
+		if err != nil {
+			// Already deleted
+			continue
+		}
+		r.checkRoom(ctx, room, now)
+	}
+}
+
+// revealAllDue scans all rooms, revealing those past their deadline and
+// scheduling the rest
+func (r *DeadlineRevealer) revealAllDue(ctx context.Context) {
+	rooms, err := r.repo.ListAll(ctx)
+	if err != nil {
+		log.Printf("DeadlineRevealer: failed to list rooms: %v", err)
+		return
+	}
+
+	now := time.Now()
+	for _, room := range rooms {
+		r.checkRoom(ctx, room, now)
+	}
+}
+
+// checkRoom reveals a room's round if its deadline has passed, or schedules it
+// if the deadline is still ahead. Deadlines cleared since scheduling are ignored.
+func (r *DeadlineRevealer) checkRoom(ctx context.Context, room *domain.Room, now time.Time) {
+	if !room.DeadlinePassed(now) {
+		if deadline := room.GetDeadline(); deadline.After(now) {
+			r.queue.Schedule(room.ID, deadline)
+		}
+		return
+	}
+
+	if _, err := r.estimation.reveal(ctx, room); err != nil {
+		log.Printf("DeadlineRevealer: failed to reveal room %s: %v", room.ID, err)
+	} else {
+		log.Printf("DeadlineRevealer: revealed room %s (%s) at its deadline", room.ID, room.Name)
+	}
+}
+
+// ActivityObservers notifies several observers of a room's activity in turn
+type ActivityObservers []domain.ActivityObserver
+
+// ActivityTouched notifies every observer (implements domain.ActivityObserver)
+func (o ActivityObservers) ActivityTouched(room *domain.Room) {
+	for _, observer := range o {
+		observer.ActivityTouched(room)
+	}
+}
@@ -0,0 +1,45 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vicmanager/esteemed/backend/internal/adapters/secondary/memory"
+	"github.com/vicmanager/esteemed/backend/internal/adapters/secondary/pubsub"
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+	"github.com/vicmanager/esteemed/backend/internal/ports/primary"
+)
+
+func TestDeadlineRevealer_RevealsWhenDue(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	repo := memory.NewRoomRepository()
+	broker := pubsub.NewBroker()
+	revealer := NewDeadlineRevealer(repo, NewEstimationService(repo, broker, nil, nil, nil))
+
+	room := domain.NewRoom("r1", "brave-nebula", &domain.Participant{ID: "host", IsConnected: true}, nil)
+	room.EnableAsync(time.Now().Add(time.Hour))
+	_ = repo.Save(ctx, room)
+	room.SetActivityObserver(revealer)
+
+	events, unsubscribe := broker.SubscribeVoteEvents(ctx, room.ID)
+	defer unsubscribe()
+
+	// Moving the deadline reschedules the reveal; the sweep interval is never reached
+	revealer.Start(ctx)
+	room.Deadline = time.Now().Add(50 * time.Millisecond)
+	room.TouchActivity()
+
+	select {
+	case event := <-events:
+		if event.Type != primary.VoteEventRevealed {
+			t.Errorf("expected the round revealed, got %v", event.Type)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the round revealed at its deadline")
+	}
+	if room.GetState() != domain.RoomStateRevealed {
+		t.Errorf("expected the room revealed, got %v", room.GetState())
+	}
+}
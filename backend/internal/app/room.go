@@ -9,8 +9,8 @@ import (
 	"github.com/vicmanager/esteemed/backend/internal/ports/secondary"
 )
 
-// RoomInactivityTimeout is the duration after which inactive rooms are cleaned up
-const RoomInactivityTimeout = 15 * time.Minute
+// maxNameAttempts bounds how often a colliding generated room name is regenerated
+const maxNameAttempts = 5
 
 // RoomService implements the primary.RoomService interface
 type RoomService struct {
@@ -18,19 +18,35 @@ type RoomService struct {
 	publisher    secondary.EventPublisher
 	analytics    secondary.AnalyticsRepository
 	appPublisher secondary.AppEventPublisher
+	timeouts     RoomTimeouts
+	limits       RoomLimits
+	activity     domain.ActivityObserver
+	lifecycle    *RoomLifecycle
+	presence     *Presence
+	invites      *InviteSigner
 }
 
-// NewRoomService creates a new room service
-func NewRoomService(repo secondary.RoomRepository, publisher secondary.EventPublisher, analytics secondary.AnalyticsRepository, appPublisher secondary.AppEventPublisher) *RoomService {
+// NewRoomService creates a new room service. The activity observer (optional) is
+// attached to every room the service creates; rooms are closed through the
+// lifecycle, presence tracks who is connected through their event streams, and
+// invites to private rooms are signed by the invite signer. Room participant
+// limits are capped at the deployment's limits.
+func NewRoomService(repo secondary.RoomRepository, publisher secondary.EventPublisher, analytics secondary.AnalyticsRepository, appPublisher secondary.AppEventPublisher, timeouts RoomTimeouts, limits RoomLimits, activity domain.ActivityObserver, lifecycle *RoomLifecycle, presence *Presence, invites *InviteSigner) *RoomService {
 	return &RoomService{
 		repo:         repo,
 		publisher:    publisher,
 		analytics:    analytics,
 		appPublisher: appPublisher,
+		timeouts:     timeouts,
+		limits:       limits,
+		activity:     activity,
+		lifecycle:    lifecycle,
+		presence:     presence,
+		invites:      invites,
 	}
 }
 
-// ListRooms returns all active rooms
+// ListRooms returns all active public rooms
 func (s *RoomService) ListRooms(ctx context.Context) ([]*primary.RoomSummary, error) {
 	rooms, err := s.repo.ListAll(ctx)
 	if err != nil {
@@ -39,25 +55,48 @@ func (s *RoomService) ListRooms(ctx context.Context) ([]*primary.RoomSummary, er
 
 	summaries := make([]*primary.RoomSummary, 0, len(rooms))
 	for _, room := range rooms {
+		// Unlisted and private rooms are only reachable by link
+		if !room.IsListed() {
+			continue
+		}
+
+		// Persistent rooms never expire
+		var expiresAt int64
+		if !room.IsPersistent() {
+			expiresAt = room.ExpiresAt(s.timeouts.forRoom(room)).Unix()
+		}
+
 		summaries = append(summaries, &primary.RoomSummary{
 			ID:               room.ID,
 			Name:             room.Name,
 			ParticipantCount: room.ConnectedParticipantCount(),
 			State:            room.GetState(),
 			CreatedAt:        room.CreatedAt.Unix(),
-			ExpiresAt:        room.ExpiresAt(RoomInactivityTimeout).Unix(),
+			ExpiresAt:        expiresAt,
+			Persistent:       room.IsPersistent(),
 		})
 	}
 
 	return summaries, nil
 }
 
-// CreateRoom creates a new room with a generated name and optional card config
-func (s *RoomService) CreateRoom(ctx context.Context, hostName, sessionToken string, cardConfig *domain.CardConfig) (*primary.CreateRoomResult, error) {
+// CreateRoom creates a new room with a generated name and optional settings
+func (s *RoomService) CreateRoom(ctx context.Context, hostName, sessionToken string, opts primary.RoomOptions) (*primary.CreateRoomResult, error) {
 	roomID := domain.GenerateID()
-	roomName := domain.GenerateRoomName()
 	participantID := domain.GenerateID()
 
+	// Persistent rooms are reached through a stable, team-chosen name
+	roomName := domain.GenerateRoomName()
+	if opts.Slug != "" {
+		slug, err := domain.NormalizeSlug(opts.Slug)
+		if err != nil {
+			return nil, err
+		}
+		roomName = slug
+	} else if opts.Persistent {
+		return nil, domain.ErrInvalidSlug
+	}
+
 	// Use client-provided session token if available, otherwise generate one
 	if sessionToken == "" {
 		sessionToken = domain.GenerateSessionToken()
@@ -72,12 +111,63 @@ func (s *RoomService) CreateRoom(ctx context.Context, hostName, sessionToken str
 		JoinedAt:     time.Now(),
 	}
 
-	room := domain.NewRoom(roomID, roomName, host, cardConfig)
+	room := domain.NewRoom(roomID, roomName, host, opts.CardConfig)
+	if opts.DotConfig != nil {
+		room.EnableDotVoting(opts.DotConfig)
+	}
+	if opts.Async {
+		room.EnableAsync(opts.Deadline)
+	}
+	if opts.Persistent {
+		room.MakePersistent()
+	}
+	if err := room.SetHostSuccession(participantID, opts.HostSuccession, ""); err != nil {
+		return nil, err
+	}
+	if opts.RotateFacilitator {
+		if _, err := room.SetFacilitatorRotation(participantID, true); err != nil {
+			return nil, err
+		}
+	}
+	if opts.KeepDisconnected {
+		room.SetKeepDisconnected(true)
+	}
+	if err := room.SetVisibility(participantID, opts.Visibility); err != nil {
+		return nil, err
+	}
+	if opts.Passcode != "" {
+		if err := room.SetPasscode(participantID, opts.Passcode); err != nil {
+			return nil, err
+		}
+	}
+	maxVoters, maxSpectators := s.limits.clamp(opts.MaxVoters, opts.MaxSpectators)
+	if err := room.SetParticipantLimits(participantID, maxVoters, maxSpectators); err != nil {
+		return nil, err
+	}
+	if opts.WaitingRoom {
+		if err := room.SetWaitingRoom(participantID, true); err != nil {
+			return nil, err
+		}
+	}
+	if opts.InactivityTimeout != 0 {
+		if err := domain.ValidateInactivityTimeout(opts.InactivityTimeout); err != nil {
+			return nil, err
+		}
+		room.SetInactivityTimeout(opts.InactivityTimeout)
+	}
 
-	if err := s.repo.Save(ctx, room); err != nil {
+	// Generated names may collide with a room that already exists; custom slugs must not
+	err := s.repo.Create(ctx, room)
+	for attempt := 0; err == domain.ErrRoomNameTaken && opts.Slug == "" && attempt < maxNameAttempts; attempt++ {
+		room.Name = domain.GenerateRoomName()
+		err = s.repo.Create(ctx, room)
+	}
+	if err != nil {
 		return nil, err
 	}
 
+	room.SetActivityObserver(s.activity)
+
 	// Record analytics event
 	if s.analytics != nil {
 		_ = s.analytics.RecordEvent(ctx, domain.NewAnalyticsEvent(domain.EventTypeRoomCreated, roomID, ""))
@@ -88,6 +178,9 @@ func (s *RoomService) CreateRoom(ctx context.Context, hostName, sessionToken str
 		_ = s.appPublisher.Publish(ctx, domain.NewRoomCreatedEvent(roomID, roomName, hostName))
 	}
 
+	// The host stays connected while they watch the room
+	s.presence.Expect(roomID, participantID)
+
 	return &primary.CreateRoomResult{
 		Room:          room,
 		SessionToken:  sessionToken,
@@ -95,26 +188,47 @@ func (s *RoomService) CreateRoom(ctx context.Context, hostName, sessionToken str
 	}, nil
 }
 
+// findRoom looks a room up by its ID, or by its name if it may be found by name
+func findRoom(ctx context.Context, repo secondary.RoomRepository, idOrName string) (*domain.Room, error) {
+	if room, err := repo.FindByID(ctx, idOrName); err == nil {
+		return room, nil
+	}
+
+	room, err := repo.FindByName(ctx, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	if !room.IsFindableByName() {
+		return nil, domain.ErrRoomNotFound
+	}
+	return room, nil
+}
+
 // JoinRoom adds a participant to an existing room
-func (s *RoomService) JoinRoom(ctx context.Context, roomID, participantName, sessionToken string, isSpectator bool) (*primary.JoinRoomResult, error) {
-	room, err := s.repo.FindByID(ctx, roomID)
+func (s *RoomService) JoinRoom(ctx context.Context, roomID, participantName, sessionToken string, opts primary.JoinOptions) (*primary.JoinRoomResult, error) {
+	room, err := findRoom(ctx, s.repo, roomID)
 	if err != nil {
-		// Try finding by name if ID lookup fails
-		room, err = s.repo.FindByName(ctx, roomID)
-		if err != nil {
-			return nil, err
-		}
+		return nil, err
+	}
+
+	// A view token only ever watches
+	if room.IsViewToken(sessionToken) {
+		return nil, domain.ErrViewOnly
 	}
 
 	// Check for reconnection via session token
 	if sessionToken != "" {
 		existing, err := room.GetParticipantByToken(sessionToken)
 		if err == nil {
-			// Reconnecting - update connection status and name if provided
-			existing.IsConnected = true
-			if participantName != "" {
-				existing.Name = participantName
+			// Reconnecting - update name if provided and connection status
+			if _, err := room.UpdateParticipant(existing.ID, domain.ParticipantProfile{Name: participantName}); err != nil {
+				return nil, err
+			}
+			hostChange, err := room.Reconnect(existing.ID)
+			if err != nil {
+				return nil, err
 			}
+			room.TouchActivity()
 			if err := s.repo.Save(ctx, room); err != nil {
 				return nil, err
 			}
@@ -125,12 +239,53 @@ func (s *RoomService) JoinRoom(ctx context.Context, roomID, participantName, ses
 				Participant: existing,
 			})
 
+			if hostChange != nil {
+				_ = s.publisher.PublishRoomEvent(ctx, room.ID, hostChangedEvent(hostChange))
+			}
+
+			s.presence.Expect(room.ID, existing.ID)
+
 			return &primary.JoinRoomResult{
 				Room:          room,
 				SessionToken:  sessionToken,
 				ParticipantID: existing.ID,
 			}, nil
 		}
+
+		// Still in the waiting room - nothing changes until the host decides
+		if pending, err := room.GetPendingByToken(sessionToken); err == nil {
+			return &primary.JoinRoomResult{
+				Room:          room,
+				SessionToken:  sessionToken,
+				ParticipantID: pending.ID,
+				Pending:       true,
+			}, nil
+		}
+	}
+
+	// Banned session tokens and names stay out
+	if err := room.CheckBanned(sessionToken, participantName); err != nil {
+		return nil, err
+	}
+
+	// A locked room turns away everyone who is not already a participant
+	if room.IsLocked() {
+		return nil, domain.ErrRoomLocked
+	}
+
+	// Private rooms take new participants only with an invite, which also sets
+	// their role. Invitees skip the passcode; everyone else needs it if set.
+	// Invitees were let in by the host already, so they skip the waiting room.
+	isSpectator := opts.IsSpectator
+	invited := opts.InviteToken != "" || room.GetVisibility() == domain.VisibilityPrivate
+	if invited {
+		invite, err := s.redeemInvite(room, opts.InviteToken)
+		if err != nil {
+			return nil, err
+		}
+		isSpectator = invite.Role == domain.RoleSpectator
+	} else if err := room.CheckPasscode(sessionToken, opts.Passcode, time.Now()); err != nil {
+		return nil, err
 	}
 
 	// New participant - use client-provided token if available
@@ -150,6 +305,10 @@ func (s *RoomService) JoinRoom(ctx context.Context, roomID, participantName, ses
 		JoinedAt:     time.Now(),
 	}
 
+	if !invited && room.HasWaitingRoom() {
+		return s.joinWaitingRoom(ctx, room, participant)
+	}
+
 	if err := room.AddParticipant(participant); err != nil {
 		return nil, err
 	}
@@ -166,6 +325,9 @@ func (s *RoomService) JoinRoom(ctx context.Context, roomID, participantName, ses
 		Participant: participant,
 	})
 
+	// The participant stays connected while they watch the room
+	s.presence.Expect(room.ID, participantID)
+
 	return &primary.JoinRoomResult{
 		Room:          room,
 		SessionToken:  participantToken,
@@ -173,6 +335,39 @@ func (s *RoomService) JoinRoom(ctx context.Context, roomID, participantName, ses
 	}, nil
 }
 
+// joinWaitingRoom puts a new participant in the waiting room and asks the host
+// to decide. Requests that waited too long are turned away first.
+func (s *RoomService) joinWaitingRoom(ctx context.Context, room *domain.Room, participant *domain.Participant) (*primary.JoinRoomResult, error) {
+	for _, expired := range room.ExpirePending(participant.JoinedAt) {
+		_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+			Type:          primary.RoomEventJoinRejected,
+			ParticipantID: expired.ID,
+		})
+	}
+
+	if err := room.AddPending(participant); err != nil {
+		return nil, err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return nil, err
+	}
+
+	_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+		Type:        primary.RoomEventJoinRequested,
+		Participant: participant,
+	})
+
+	return &primary.JoinRoomResult{
+		Room:          room,
+		SessionToken:  participant.SessionToken,
+		ParticipantID: participant.ID,
+		Pending:       true,
+	}, nil
+}
+
 // LeaveRoom marks a participant as disconnected (allows reconnection)
 func (s *RoomService) LeaveRoom(ctx context.Context, roomID, participantID, sessionToken string) error {
 	room, err := s.repo.FindByID(ctx, roomID)
@@ -185,126 +380,293 @@ func (s *RoomService) LeaveRoom(ctx context.Context, roomID, participantID, sess
 		return err
 	}
 
-	// Mark participant as disconnected (not removed, so they can reconnect)
-	hostTransferred, newHostID, err := room.DisconnectParticipant(participantID)
+	return s.presence.disconnect(ctx, room, participantID)
+}
+
+// WatchRoom returns a channel for room events
+func (s *RoomService) WatchRoom(ctx context.Context, roomID, sessionToken, passcode string, resume primary.ResumePoint) (<-chan primary.RoomEvent, error) {
+	// Verify room exists
+	room, err := findRoom(ctx, s.repo, roomID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	// Participants in the waiting room watch for the host's decision. Otherwise
+	// only participants may watch private rooms; others need the passcode if set.
+	pendingID := ""
+	if pending, err := room.GetPendingByToken(sessionToken); err == nil {
+		pendingID = pending.ID
+	} else if err := room.AuthorizeWatcher(sessionToken, passcode, time.Now()); err != nil {
+		return nil, err
+	}
+
+	// Streams opened with the view token end when it is revoked
+	viewing := viewingCheck(room, sessionToken)
+
+	// Subscribe to events
+	follower := followRoomState(ctx, s.publisher, room.ID)
+
+	// The watching participant is connected for as long as the stream is open
+	release := s.presence.Watch(ctx, room, sessionToken)
+	watcherID := pendingID
+	if participant, err := room.GetParticipantByToken(sessionToken); err == nil {
+		watcherID = participant.ID
 	}
 
-	// Check if all participants are disconnected
-	if !room.HasConnectedParticipants() {
-		// Record analytics event
-		if s.analytics != nil {
-			_ = s.analytics.RecordEvent(ctx, domain.NewAnalyticsEvent(domain.EventTypeRoomClosed, room.ID, ""))
+	// Create output channel that handles context cancellation
+	outputCh := make(chan primary.RoomEvent, 10)
+
+	go func() {
+		defer close(outputCh)
+		// release is replaced once a waiting participant is admitted
+		defer func() { release() }()
+
+		// send delivers an event, reporting whether the stream goes on
+		send := func(event primary.RoomEvent) bool {
+			if pendingID != "" {
+				// Until admitted, a waiting participant only hears about their own request
+				if !concernsPending(event, pendingID) {
+					return true
+				}
+				if event.Type == primary.RoomEventJoinAdmitted {
+					// Once admitted, the stream counts towards their presence
+					pendingID = ""
+					release = s.presence.Attach(ctx, room, event.Participant.ID)
+				}
+			}
+			if forManagers(event) && room.Authorize(watcherID, domain.ActionManageParticipants) != nil {
+				return true
+			}
+			select {
+			case outputCh <- event:
+			case <-ctx.Done():
+				return false
+			}
+			// A removed participant's stream ends with the news
+			return event.Type != primary.RoomEventParticipantRemoved || event.ParticipantID != watcherID
+		}
+
+		emit := func(event primary.RoomStateEvent) bool {
+			if !viewing() {
+				return false
+			}
+			// Vote events belong to WatchVotes
+			if event.Room == nil {
+				return true
+			}
+			return send(*event.Room)
 		}
-		// Emit app event
-		if s.appPublisher != nil {
-			_ = s.appPublisher.Publish(ctx, domain.NewRoomClosedEvent(room.ID, room.Name, "all participants left"))
+
+		resync := func(sequence uint64, initial bool) bool {
+			if !viewing() {
+				return false
+			}
+			// The stream starts without initial state
+			if initial {
+				return true
+			}
+			if pendingID == "" {
+				return send(primary.RoomEvent{
+					Epoch:    follower.epoch,
+					Sequence: sequence,
+					Type:     primary.RoomEventResync,
+					Snapshot: snapshotFor(room, sessionToken),
+				})
+			}
+			// A waiting participant only needs the decision they may have missed
+			if room.IsPending(pendingID) {
+				return true
+			}
+			if admitted, err := room.GetParticipant(pendingID); err == nil {
+				return send(primary.RoomEvent{Epoch: follower.epoch, Sequence: sequence, Type: primary.RoomEventJoinAdmitted, Participant: admitted})
+			}
+			return send(primary.RoomEvent{Epoch: follower.epoch, Sequence: sequence, Type: primary.RoomEventJoinRejected, ParticipantID: pendingID})
 		}
-		// Delete room when everyone has disconnected
-		_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
-			Type:   primary.RoomEventClosed,
-			Reason: "all participants left",
-		})
-		return s.repo.Delete(ctx, room.ID)
+
+		follower.run(ctx, resume, emit, resync)
+	}()
+
+	return outputCh, nil
+}
+
+// concernsPending returns whether a room event is meant for a participant in the waiting room
+func concernsPending(event primary.RoomEvent, pendingID string) bool {
+	switch event.Type {
+	case primary.RoomEventJoinAdmitted:
+		return event.Participant != nil && event.Participant.ID == pendingID
+	case primary.RoomEventJoinRejected:
+		return event.ParticipantID == pendingID
+	case primary.RoomEventClosed:
+		return true
+	default:
+		return false
 	}
+}
 
-	room.TouchActivity()
+// forManagers returns whether a room event is only for those who manage the waiting room
+func forManagers(event primary.RoomEvent) bool {
+	return event.Type == primary.RoomEventJoinRequested
+}
 
-	if err := s.repo.Save(ctx, room); err != nil {
-		return err
+// GetRoom returns a snapshot of the room for anyone allowed to watch it. The
+// caller's token decides what is visible: voters see their own vote, and only
+// the host and facilitators see the waiting room.
+func (s *RoomService) GetRoom(ctx context.Context, roomID, sessionToken, passcode string) (*primary.RoomSnapshot, error) {
+	room, err := findRoom(ctx, s.repo, roomID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Notify other participants that this person left
-	_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
-		Type:          primary.RoomEventParticipantLeft,
-		ParticipantID: participantID,
-	})
+	// Same access as watching the room
+	if err := room.AuthorizeWatcher(sessionToken, passcode, time.Now()); err != nil {
+		return nil, err
+	}
 
-	// If host was transferred, publish host changed event
-	if hostTransferred && newHostID != "" {
-		_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
-			Type:      primary.RoomEventHostChanged,
-			NewHostID: newHostID,
-		})
+	return snapshotFor(room, sessionToken), nil
+}
+
+// snapshotFor captures the room as the holder of a session token may see it
+func snapshotFor(room *domain.Room, sessionToken string) *primary.RoomSnapshot {
+	snapshot := &primary.RoomSnapshot{
+		Room:       room,
+		VoteStatus: room.GetVoteStatus(),
+		IsViewer:   room.IsViewToken(sessionToken),
+	}
+	if summary, err := room.GetVoteSummary(); err == nil {
+		snapshot.Summary = summary
 	}
 
-	return nil
+	if caller, err := room.GetParticipantByToken(sessionToken); err == nil {
+		snapshot.ParticipantID = caller.ID
+		snapshot.CanManage = room.Authorize(caller.ID, domain.ActionManageParticipants) == nil
+		if own := room.GetOwnVote(caller.ID); own != nil {
+			for i, vote := range snapshot.VoteStatus {
+				if vote.ParticipantID == caller.ID {
+					snapshot.VoteStatus[i] = own
+				}
+			}
+		}
+	}
+
+	return snapshot
 }
 
-// WatchRoom returns a channel for room events
-func (s *RoomService) WatchRoom(ctx context.Context, roomID, sessionToken string) (<-chan primary.RoomEvent, error) {
-	// Verify room exists
-	room, err := s.repo.FindByID(ctx, roomID)
+// WatchRoomState returns one ordered stream of room and vote events that
+// starts with a snapshot, or resumes after a sequence number
+func (s *RoomService) WatchRoomState(ctx context.Context, roomID, sessionToken, passcode string, resume primary.ResumePoint) (<-chan primary.RoomStateEvent, error) {
+	room, err := findRoom(ctx, s.repo, roomID)
 	if err != nil {
-		room, err = s.repo.FindByName(ctx, roomID)
-		if err != nil {
-			return nil, err
-		}
+		return nil, err
 	}
 
-	// Subscribe to events
-	eventCh, unsubscribe := s.publisher.SubscribeRoomEvents(ctx, room.ID)
+	// Same access as watching the room
+	if err := room.AuthorizeWatcher(sessionToken, passcode, time.Now()); err != nil {
+		return nil, err
+	}
 
-	// Create output channel that handles context cancellation
-	outputCh := make(chan primary.RoomEvent, 10)
+	// Streams opened with the view token end when it is revoked
+	viewing := viewingCheck(room, sessionToken)
+
+	// Subscribe before any snapshot is taken so no event falls between the two.
+	// Events may repeat changes a snapshot already shows; applying them again
+	// leaves the same state.
+	follower := followRoomState(ctx, s.publisher, room.ID)
+
+	// The watching participant is connected for as long as the stream is open
+	release := s.presence.Watch(ctx, room, sessionToken)
+	watcherID := ""
+	if participant, err := room.GetParticipantByToken(sessionToken); err == nil {
+		watcherID = participant.ID
+	}
+
+	outputCh := make(chan primary.RoomStateEvent, 32)
 
 	go func() {
 		defer close(outputCh)
-		defer unsubscribe()
+		defer release()
 
-		for {
+		// send delivers an event, reporting whether the stream goes on
+		send := func(event primary.RoomStateEvent) bool {
+			if !viewing() {
+				return false
+			}
+			if event.Room != nil && forManagers(*event.Room) && room.Authorize(watcherID, domain.ActionManageParticipants) != nil {
+				return true
+			}
 			select {
+			case outputCh <- event:
 			case <-ctx.Done():
-				return
-			case event, ok := <-eventCh:
-				if !ok {
-					return
-				}
-				select {
-				case outputCh <- event:
-				case <-ctx.Done():
-					return
-				}
+				return false
 			}
+			// A removed participant's stream ends with the news
+			removed := event.Room != nil && event.Room.Type == primary.RoomEventParticipantRemoved && event.Room.ParticipantID == watcherID
+			return !removed
+		}
+
+		resync := func(sequence uint64, initial bool) bool {
+			return send(primary.RoomStateEvent{
+				Epoch:    follower.epoch,
+				Sequence: sequence,
+				Snapshot: snapshotFor(room, sessionToken),
+				Resync:   !initial,
+			})
 		}
+
+		follower.run(ctx, resume, send, resync)
 	}()
 
 	return outputCh, nil
 }
 
-// KickParticipant removes a target participant from the room (host only)
-func (s *RoomService) KickParticipant(ctx context.Context, roomID, participantID, sessionToken, targetParticipantID string) error {
+// CreateViewToken issues a read-only view token for shared screens, replacing
+// any earlier one (host or facilitator)
+func (s *RoomService) CreateViewToken(ctx context.Context, roomID, participantID, sessionToken string) (string, error) {
 	room, err := s.repo.FindByID(ctx, roomID)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	// Validate token
 	if err := room.ValidateToken(participantID, sessionToken); err != nil {
-		return err
+		return "", err
 	}
 
-	// Kick the participant
-	if err := room.KickParticipant(participantID, targetParticipantID); err != nil {
-		return err
+	token, replaced, err := room.CreateViewToken(participantID)
+	if err != nil {
+		return "", err
 	}
 
-	// Check if room is empty
-	if room.IsEmpty() {
-		// Record analytics event
-		if s.analytics != nil {
-			_ = s.analytics.RecordEvent(ctx, domain.NewAnalyticsEvent(domain.EventTypeRoomClosed, room.ID, ""))
-		}
-		// Emit app event
-		if s.appPublisher != nil {
-			_ = s.appPublisher.Publish(ctx, domain.NewRoomClosedEvent(room.ID, room.Name, "all participants left"))
-		}
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return "", err
+	}
+
+	// Screens showing the old token lose access
+	if replaced {
 		_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
-			Type:   primary.RoomEventClosed,
-			Reason: "all participants left",
+			Type: primary.RoomEventViewTokenRevoked,
 		})
-		return s.repo.Delete(ctx, room.ID)
+	}
+
+	return token, nil
+}
+
+// RevokeViewToken stops the room's view token from working (host or facilitator)
+func (s *RoomService) RevokeViewToken(ctx context.Context, roomID, participantID, sessionToken string) error {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return err
+	}
+
+	revoked, err := room.RevokeViewToken(participantID)
+	if err != nil || !revoked {
+		return err
 	}
 
 	room.TouchActivity()
@@ -313,17 +675,49 @@ func (s *RoomService) KickParticipant(ctx context.Context, roomID, participantID
 		return err
 	}
 
-	// Publish leave event for the kicked participant
 	_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
-		Type:          primary.RoomEventParticipantLeft,
-		ParticipantID: targetParticipantID,
+		Type: primary.RoomEventViewTokenRevoked,
 	})
 
 	return nil
 }
 
-// TransferOwnership transfers host privileges to another participant
-func (s *RoomService) TransferOwnership(ctx context.Context, roomID, participantID, sessionToken, newHostID string) error {
+// KeepAlive resets a room's inactivity timer so it is not cleaned up
+func (s *RoomService) KeepAlive(ctx context.Context, roomID, participantID, sessionToken string) (time.Time, error) {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return time.Time{}, err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return time.Time{}, err
+	}
+
+	if room.IsPersistent() {
+		return time.Time{}, nil
+	}
+
+	expiresAt := room.ExpiresAt(s.timeouts.forRoom(room))
+
+	// Let other clients dismiss their expiry warning
+	_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+		Type:          primary.RoomEventKeptAlive,
+		ParticipantID: participantID,
+		ExpiresAt:     expiresAt,
+	})
+
+	return expiresAt, nil
+}
+
+// KickParticipant removes a target participant from the room (host or facilitator)
+func (s *RoomService) KickParticipant(ctx context.Context, roomID, participantID, sessionToken, targetParticipantID string, opts primary.KickOptions) error {
 	room, err := s.repo.FindByID(ctx, roomID)
 	if err != nil {
 		return err
@@ -334,22 +728,620 @@ func (s *RoomService) TransferOwnership(ctx context.Context, roomID, participant
 		return err
 	}
 
-	// Transfer ownership
-	if err := room.TransferOwnership(participantID, newHostID); err != nil {
+	// Kick the participant, banning them from rejoining if asked
+	if opts.Ban || opts.BanName {
+		if _, err := room.BanParticipant(participantID, targetParticipantID, opts.BanName, time.Now()); err != nil {
+			return err
+		}
+	} else if err := room.KickParticipant(participantID, targetParticipantID); err != nil {
 		return err
 	}
 
+	// Check if room is empty
+	if room.IsEmpty() && !room.IsPersistent() {
+		return s.lifecycle.Close(ctx, room, domain.CloseReasonAllLeft)
+	}
+
 	room.TouchActivity()
 
 	if err := s.repo.Save(ctx, room); err != nil {
 		return err
 	}
 
-	// Publish host changed event
+	// Tell the room, and the kicked participant's own streams, that they were removed
 	_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
-		Type:      primary.RoomEventHostChanged,
-		NewHostID: newHostID,
+		Type:          primary.RoomEventParticipantRemoved,
+		ParticipantID: targetParticipantID,
+		Banned:        opts.Ban || opts.BanName,
 	})
 
 	return nil
 }
+
+// ListBans returns who has been banned from the room (host or facilitator)
+func (s *RoomService) ListBans(ctx context.Context, roomID, participantID, sessionToken string) ([]*domain.Ban, error) {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return nil, err
+	}
+
+	return room.ListBans(participantID)
+}
+
+// LiftBan lets a banned participant join the room again (host or facilitator)
+func (s *RoomService) LiftBan(ctx context.Context, roomID, participantID, sessionToken, banID string) error {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return err
+	}
+
+	if err := room.LiftBan(participantID, banID); err != nil {
+		return err
+	}
+
+	room.TouchActivity()
+
+	return s.repo.Save(ctx, room)
+}
+
+// TransferOwnership transfers host privileges to another participant
+func (s *RoomService) TransferOwnership(ctx context.Context, roomID, participantID, sessionToken, newHostID string) error {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return err
+	}
+
+	// Transfer ownership
+	if err := room.TransferOwnership(participantID, newHostID); err != nil {
+		return err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return err
+	}
+
+	// Publish host changed event
+	_ = s.publisher.PublishRoomEvent(ctx, room.ID, hostChangedEvent(&domain.HostChange{
+		NewHostID:      newHostID,
+		PreviousHostID: participantID,
+		Reason:         domain.HostChangeTransferred,
+	}))
+
+	return nil
+}
+
+// GrantRole makes a participant a facilitator, voter or spectator (owner only)
+func (s *RoomService) GrantRole(ctx context.Context, roomID, participantID, sessionToken, targetParticipantID string, role domain.Role) error {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return err
+	}
+
+	retracted, err := room.SetRole(participantID, targetParticipantID, role)
+	if err != nil {
+		return err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return err
+	}
+
+	if target, err := room.GetParticipant(targetParticipantID); err == nil {
+		_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+			Type:        primary.RoomEventParticipantUpdated,
+			Participant: target,
+		})
+	}
+	if retracted {
+		_ = s.publisher.PublishVoteEvent(ctx, room.ID, primary.VoteEvent{
+			Type:          primary.VoteEventRetracted,
+			ParticipantID: targetParticipantID,
+		})
+	}
+
+	return nil
+}
+
+// RevokeRole returns a participant to the voter role (owner only)
+func (s *RoomService) RevokeRole(ctx context.Context, roomID, participantID, sessionToken, targetParticipantID string) error {
+	return s.GrantRole(ctx, roomID, participantID, sessionToken, targetParticipantID, domain.RoleVoter)
+}
+
+// UpdateParticipant changes the caller's name, avatar seed or color
+func (s *RoomService) UpdateParticipant(ctx context.Context, roomID, participantID, sessionToken string, profile domain.ParticipantProfile) (*domain.Participant, error) {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return nil, err
+	}
+
+	participant, err := room.UpdateParticipant(participantID, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return nil, err
+	}
+
+	_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+		Type:        primary.RoomEventParticipantUpdated,
+		Participant: participant,
+	})
+
+	return participant, nil
+}
+
+// SetVisibility changes who can find and join the room (owner only)
+func (s *RoomService) SetVisibility(ctx context.Context, roomID, participantID, sessionToken string, visibility domain.Visibility) error {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return err
+	}
+
+	if err := room.SetVisibility(participantID, visibility); err != nil {
+		return err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return err
+	}
+
+	_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+		Type:       primary.RoomEventVisibilityChanged,
+		Visibility: visibility,
+	})
+
+	return nil
+}
+
+// CreateInvite creates a signed invite link that joins the room with the given role (host or facilitator)
+func (s *RoomService) CreateInvite(ctx context.Context, roomID, participantID, sessionToken string, role domain.Role, ttl time.Duration) (*primary.CreateInviteResult, error) {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return nil, err
+	}
+
+	if ttl == 0 {
+		ttl = domain.DefaultInviteTTL
+	}
+	invite, err := room.CreateInvite(participantID, domain.GenerateID(), role, ttl, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return nil, err
+	}
+
+	return &primary.CreateInviteResult{
+		Invite: invite,
+		Token:  s.invites.Sign(room.ID, invite),
+	}, nil
+}
+
+// RevokeInvite stops an invite link from being used (host or facilitator)
+func (s *RoomService) RevokeInvite(ctx context.Context, roomID, participantID, sessionToken, inviteID string) error {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return err
+	}
+
+	if err := room.RevokeInvite(participantID, inviteID); err != nil {
+		return err
+	}
+
+	room.TouchActivity()
+
+	return s.repo.Save(ctx, room)
+}
+
+// redeemInvite checks an invite token against the room it is used to join
+func (s *RoomService) redeemInvite(room *domain.Room, inviteToken string) (*domain.Invite, error) {
+	if inviteToken == "" {
+		return nil, domain.ErrInviteRequired
+	}
+
+	now := time.Now()
+	roomID, inviteID, err := s.invites.Verify(inviteToken, now)
+	if err != nil {
+		return nil, err
+	}
+	if roomID != room.ID {
+		return nil, domain.ErrInvalidInvite
+	}
+
+	return room.RedeemInvite(inviteID, now)
+}
+
+// SetPasscode sets or, when empty, removes the room's passcode (owner only)
+func (s *RoomService) SetPasscode(ctx context.Context, roomID, participantID, sessionToken, passcode string) error {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return err
+	}
+
+	if err := room.SetPasscode(participantID, passcode); err != nil {
+		return err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return err
+	}
+
+	_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+		Type:    primary.RoomEventPasscodeChanged,
+		Enabled: passcode != "",
+	})
+
+	return nil
+}
+
+// SetLocked locks or unlocks the room to new participants (host or facilitator)
+func (s *RoomService) SetLocked(ctx context.Context, roomID, participantID, sessionToken string, locked bool) error {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return err
+	}
+
+	if err := room.SetLocked(participantID, locked); err != nil {
+		return err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return err
+	}
+
+	_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+		Type:    primary.RoomEventLockChanged,
+		Enabled: locked,
+	})
+
+	return nil
+}
+
+// SetWaitingRoom turns the waiting room on or off (owner only)
+func (s *RoomService) SetWaitingRoom(ctx context.Context, roomID, participantID, sessionToken string, enabled bool) error {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return err
+	}
+
+	if err := room.SetWaitingRoom(participantID, enabled); err != nil {
+		return err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return err
+	}
+
+	_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+		Type:    primary.RoomEventWaitingRoomChanged,
+		Enabled: enabled,
+	})
+
+	return nil
+}
+
+// AdmitParticipant lets a participant in from the waiting room (host or facilitator)
+func (s *RoomService) AdmitParticipant(ctx context.Context, roomID, participantID, sessionToken, pendingParticipantID string) error {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return err
+	}
+
+	admitted, err := room.Admit(participantID, pendingParticipantID)
+	if err != nil {
+		return err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return err
+	}
+
+	// The decision goes to the admitted participant's stream as well as the room
+	_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+		Type:        primary.RoomEventJoinAdmitted,
+		Participant: admitted,
+	})
+	_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+		Type:        primary.RoomEventParticipantJoined,
+		Participant: admitted,
+	})
+
+	// The participant stays connected while they watch the room
+	s.presence.Expect(room.ID, admitted.ID)
+
+	return nil
+}
+
+// RejectParticipant turns away a participant in the waiting room (host or facilitator)
+func (s *RoomService) RejectParticipant(ctx context.Context, roomID, participantID, sessionToken, pendingParticipantID string) error {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return err
+	}
+
+	if err := room.Reject(participantID, pendingParticipantID); err != nil {
+		return err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return err
+	}
+
+	_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+		Type:          primary.RoomEventJoinRejected,
+		ParticipantID: pendingParticipantID,
+	})
+
+	return nil
+}
+
+// SetParticipantLimits caps how many voters and spectators the room takes,
+// within the deployment's limits (owner only)
+func (s *RoomService) SetParticipantLimits(ctx context.Context, roomID, participantID, sessionToken string, maxVoters, maxSpectators int) error {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return err
+	}
+
+	if maxVoters < 0 || maxSpectators < 0 {
+		return domain.ErrInvalidLimit
+	}
+	maxVoters, maxSpectators = s.limits.clamp(maxVoters, maxSpectators)
+	if err := room.SetParticipantLimits(participantID, maxVoters, maxSpectators); err != nil {
+		return err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return err
+	}
+
+	_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+		Type:          primary.RoomEventParticipantLimitsChanged,
+		MaxVoters:     maxVoters,
+		MaxSpectators: maxSpectators,
+	})
+
+	return nil
+}
+
+// SetSpectator switches a participant between voting and spectating
+func (s *RoomService) SetSpectator(ctx context.Context, roomID, participantID, sessionToken, targetParticipantID string, spectator bool) error {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return err
+	}
+
+	if targetParticipantID == "" {
+		targetParticipantID = participantID
+	}
+	retracted, err := room.SetSpectator(participantID, targetParticipantID, spectator)
+	if err != nil {
+		return err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return err
+	}
+
+	if target, err := room.GetParticipant(targetParticipantID); err == nil {
+		_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+			Type:        primary.RoomEventParticipantUpdated,
+			Participant: target,
+		})
+	}
+	if retracted {
+		_ = s.publisher.PublishVoteEvent(ctx, room.ID, primary.VoteEvent{
+			Type:          primary.VoteEventRetracted,
+			ParticipantID: targetParticipantID,
+		})
+	}
+
+	return nil
+}
+
+// SetFacilitatorRotation turns rotating the facilitator role per story on or off (owner only)
+func (s *RoomService) SetFacilitatorRotation(ctx context.Context, roomID, participantID, sessionToken string, enabled bool) error {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return err
+	}
+
+	revoked, err := room.SetFacilitatorRotation(participantID, enabled)
+	if err != nil {
+		return err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return err
+	}
+
+	_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+		Type:    primary.RoomEventFacilitatorRotationChanged,
+		Enabled: enabled,
+	})
+
+	if revoked != nil {
+		_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+			Type:        primary.RoomEventParticipantUpdated,
+			Participant: revoked,
+		})
+	}
+
+	return nil
+}
+
+// SetHostSuccession sets who takes over when the host disconnects (owner only)
+func (s *RoomService) SetHostSuccession(ctx context.Context, roomID, participantID, sessionToken string, policy domain.HostSuccession, deputyID string) error {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return err
+	}
+
+	if err := room.SetHostSuccession(participantID, policy, deputyID); err != nil {
+		return err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return err
+	}
+
+	_ = s.publisher.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{
+		Type:           primary.RoomEventHostSuccessionChanged,
+		HostSuccession: policy,
+		DeputyID:       deputyID,
+	})
+
+	return nil
+}
+
+// ClaimHost makes the caller host of a room that has none
+func (s *RoomService) ClaimHost(ctx context.Context, roomID, participantID, sessionToken string) error {
+	room, err := s.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	// Validate token
+	if err := room.ValidateToken(participantID, sessionToken); err != nil {
+		return err
+	}
+
+	change, err := room.ClaimHost(participantID)
+	if err != nil {
+		return err
+	}
+
+	room.TouchActivity()
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return err
+	}
+
+	_ = s.publisher.PublishRoomEvent(ctx, room.ID, hostChangedEvent(change))
+
+	return nil
+}
+
+// hostChangedEvent builds the room event announcing a host change
+func hostChangedEvent(change *domain.HostChange) primary.RoomEvent {
+	return primary.RoomEvent{
+		Type:       primary.RoomEventHostChanged,
+		NewHostID:  change.NewHostID,
+		HostChange: change,
+	}
+}
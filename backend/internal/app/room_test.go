@@ -0,0 +1,275 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vicmanager/esteemed/backend/internal/adapters/secondary/memory"
+	"github.com/vicmanager/esteemed/backend/internal/adapters/secondary/pubsub"
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+	"github.com/vicmanager/esteemed/backend/internal/ports/primary"
+)
+
+// newTestRoomService builds a room service with default settings over an
+// in-memory repository and broker, which it returns for the test to seed and watch
+func newTestRoomService(t *testing.T) (*RoomService, *memory.RoomRepository, *pubsub.Broker) {
+	t.Helper()
+	repo := memory.NewRoomRepository()
+	broker := pubsub.NewBroker()
+	lifecycle := NewRoomLifecycle(repo, broker, nil, nil)
+	presence := NewPresence(repo, broker, lifecycle, time.Minute)
+	return NewRoomService(repo, broker, nil, nil, DefaultRoomTimeouts(), DefaultRoomLimits(), nil, lifecycle, presence, NewInviteSigner("secret")), repo, broker
+}
+
+func TestRoomService_GetRoom(t *testing.T) {
+	ctx := context.Background()
+	service, repo, broker := newTestRoomService(t)
+
+	room := domain.NewRoom("r1", "brave-nebula", &domain.Participant{ID: "host", SessionToken: "host-token", IsConnected: true}, nil)
+	_ = room.AddParticipant(&domain.Participant{ID: "voter", SessionToken: "voter-token", IsConnected: true})
+	_ = room.SetWaitingRoom("host", true)
+	_ = room.AddPending(&domain.Participant{ID: "waiting", SessionToken: "waiting-token"})
+	room.StartVoting()
+	_ = room.CastVote("host", "8")
+	_ = room.CastVote("voter", "5")
+	_ = repo.Create(ctx, room)
+
+	events, unsubscribe := broker.SubscribeRoomEvents(ctx, room.ID)
+	defer unsubscribe()
+
+	snapshot, err := service.GetRoom(ctx, room.ID, "voter-token", "")
+	if err != nil {
+		t.Fatalf("failed to get room: %v", err)
+	}
+	if snapshot.ParticipantID != "voter" || snapshot.CanManage || snapshot.Summary != nil {
+		t.Errorf("unexpected snapshot for a voter: %+v", snapshot)
+	}
+	for _, vote := range snapshot.VoteStatus {
+		switch vote.ParticipantID {
+		case "voter":
+			if vote.Value != "5" {
+				t.Errorf("expected the voter to see their own vote, got %q", vote.Value)
+			}
+		default:
+			if vote.Value != "" {
+				t.Errorf("expected other votes hidden, got %q for %s", vote.Value, vote.ParticipantID)
+			}
+		}
+	}
+
+	if snapshot, _ := service.GetRoom(ctx, room.ID, "host-token", ""); !snapshot.CanManage {
+		t.Error("expected the host to see the waiting room")
+	}
+
+	_, _ = room.RevealVotes()
+	if snapshot, _ := service.GetRoom(ctx, room.ID, "", ""); snapshot.Summary == nil || len(snapshot.Summary.Votes) != 2 {
+		t.Errorf("expected the revealed summary, got %+v", snapshot.Summary)
+	}
+
+	// Reading the room publishes nothing
+	select {
+	case event := <-events:
+		t.Errorf("expected no events, got %v", event.Type)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestRoomService_WatchRoomState(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	service, repo, broker := newTestRoomService(t)
+
+	room := domain.NewRoom("r1", "brave-nebula", &domain.Participant{ID: "host", SessionToken: "host-token", IsConnected: true}, nil)
+	_ = repo.Create(ctx, room)
+
+	// Events published before the stream opens are part of the snapshot
+	_ = broker.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{Type: primary.RoomEventKeptAlive})
+
+	events, err := service.WatchRoomState(ctx, room.ID, "host-token", "", primary.ResumePoint{})
+	if err != nil {
+		t.Fatalf("failed to watch: %v", err)
+	}
+
+	_ = broker.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{Type: primary.RoomEventStateChanged})
+	_ = broker.PublishVoteEvent(ctx, room.ID, primary.VoteEvent{Type: primary.VoteEventRevealed})
+
+	next := func() primary.RoomStateEvent {
+		t.Helper()
+		select {
+		case event := <-events:
+			return event
+		case <-time.After(time.Second):
+			t.Fatal("expected an event")
+			return primary.RoomStateEvent{}
+		}
+	}
+
+	if first := next(); first.Snapshot == nil || first.Sequence != 1 || first.Snapshot.ParticipantID != "host" {
+		t.Fatalf("expected a snapshot as of sequence 1 first, got %+v", first)
+	}
+	if second := next(); second.Room == nil || second.Room.Type != primary.RoomEventStateChanged || second.Sequence != 2 {
+		t.Errorf("expected the state change as sequence 2, got %+v", second)
+	}
+	if third := next(); third.Vote == nil || third.Vote.Type != primary.VoteEventRevealed || third.Sequence != 3 {
+		t.Errorf("expected the reveal as sequence 3, got %+v", third)
+	}
+}
+
+func TestRoomService_WatchRoomStateResyncsAfterGap(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	service, repo, broker := newTestRoomService(t)
+
+	room := domain.NewRoom("r1", "brave-nebula", &domain.Participant{ID: "host", SessionToken: "host-token", IsConnected: true}, nil)
+	_ = repo.Create(ctx, room)
+
+	events, err := service.WatchRoomState(ctx, room.ID, "host-token", "", primary.ResumePoint{})
+	if err != nil {
+		t.Fatalf("failed to watch: %v", err)
+	}
+
+	// Publish more than the stream, subscription and replay buffers hold while nobody reads
+	for i := 0; i < 1000; i++ {
+		_ = broker.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{Type: primary.RoomEventKeptAlive})
+	}
+
+	// The stream notices the dropped events when the next one arrives after
+	// the buffered ones are read
+	var last uint64
+	resynced, published := false, false
+	for last < 1001 {
+		select {
+		case event := <-events:
+			if event.Snapshot != nil && event.Resync {
+				resynced = true
+			} else if event.Snapshot == nil && event.Sequence != last+1 {
+				t.Fatalf("expected no gap without a resync, got sequence %d after %d", event.Sequence, last)
+			}
+			last = event.Sequence
+		case <-time.After(100 * time.Millisecond):
+			if published {
+				t.Fatalf("expected the stream to catch up, last sequence %d", last)
+			}
+			_ = broker.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{Type: primary.RoomEventStateChanged})
+			published = true
+		}
+	}
+	if !resynced {
+		t.Error("expected a resync snapshot for the dropped events")
+	}
+}
+
+func TestRoomService_SpectatingRetractsVote(t *testing.T) {
+	ctx := context.Background()
+	service, repo, broker := newTestRoomService(t)
+
+	room := domain.NewRoom("r1", "brave-nebula", &domain.Participant{ID: "host", SessionToken: "host-token", IsConnected: true}, nil)
+	_ = room.AddParticipant(&domain.Participant{ID: "voter", SessionToken: "voter-token", IsConnected: true})
+	_ = room.AddParticipant(&domain.Participant{ID: "idle", SessionToken: "idle-token", IsConnected: true})
+	room.StartVoting()
+	_ = room.CastVote("voter", "5")
+	_ = repo.Create(ctx, room)
+
+	events, unsubscribe := broker.SubscribeVoteEvents(ctx, room.ID)
+	defer unsubscribe()
+
+	expectRetracted := func(participantID string) {
+		t.Helper()
+		select {
+		case event := <-events:
+			if event.Type != primary.VoteEventRetracted || event.ParticipantID != participantID {
+				t.Errorf("expected %s's vote retracted, got %+v", participantID, event)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("expected %s's vote retracted", participantID)
+		}
+	}
+
+	if err := service.SetSpectator(ctx, room.ID, "voter", "voter-token", "", true); err != nil {
+		t.Fatalf("failed to spectate: %v", err)
+	}
+	expectRetracted("voter")
+
+	// A participant without a vote has nothing to retract
+	_ = service.GrantRole(ctx, room.ID, "host", "host-token", "idle", domain.RoleSpectator)
+	_ = service.SetSpectator(ctx, room.ID, "voter", "voter-token", "", false)
+	_ = room.CastVote("voter", "8")
+	if err := service.GrantRole(ctx, room.ID, "host", "host-token", "voter", domain.RoleSpectator); err != nil {
+		t.Fatalf("failed to grant the spectator role: %v", err)
+	}
+	expectRetracted("voter")
+}
+
+func TestRoomService_UnlistedRoomsNeedTheirID(t *testing.T) {
+	ctx := context.Background()
+	service, repo, _ := newTestRoomService(t)
+
+	room := domain.NewRoom("r1", "brave-nebula", &domain.Participant{ID: "host", SessionToken: "host-token", IsConnected: true}, nil)
+	_ = room.SetVisibility("host", domain.VisibilityUnlisted)
+	_ = repo.Create(ctx, room)
+
+	if _, err := service.JoinRoom(ctx, "brave-nebula", "Pat", "guest-token", primary.JoinOptions{}); err != domain.ErrRoomNotFound {
+		t.Errorf("expected ErrRoomNotFound joining an unlisted room by name, got %v", err)
+	}
+	if _, err := service.WatchRoom(ctx, "brave-nebula", "guest-token", "", primary.ResumePoint{}); err != domain.ErrRoomNotFound {
+		t.Errorf("expected ErrRoomNotFound watching an unlisted room by name, got %v", err)
+	}
+	if _, err := service.JoinRoom(ctx, room.ID, "Pat", "guest-token", primary.JoinOptions{}); err != nil {
+		t.Errorf("expected to join an unlisted room by ID, got %v", err)
+	}
+
+	// Public rooms are still found by name
+	_ = room.SetVisibility("host", domain.VisibilityPublic)
+	if _, err := service.GetRoom(ctx, "brave-nebula", "guest-token", ""); err != nil {
+		t.Errorf("expected to find a public room by name, got %v", err)
+	}
+}
+
+func TestRoomService_JoinRequestsReachOnlyManagers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	service, repo, _ := newTestRoomService(t)
+
+	room := domain.NewRoom("r1", "brave-nebula", &domain.Participant{ID: "host", SessionToken: "host-token", IsConnected: true}, nil)
+	_ = room.AddParticipant(&domain.Participant{ID: "voter", SessionToken: "voter-token", IsConnected: true})
+	_ = room.SetWaitingRoom("host", true)
+	_ = repo.Create(ctx, room)
+
+	hostEvents, err := service.WatchRoom(ctx, room.ID, "host-token", "", primary.ResumePoint{})
+	if err != nil {
+		t.Fatalf("failed to watch as host: %v", err)
+	}
+	voterEvents, err := service.WatchRoom(ctx, room.ID, "voter-token", "", primary.ResumePoint{})
+	if err != nil {
+		t.Fatalf("failed to watch as voter: %v", err)
+	}
+
+	if _, err := service.JoinRoom(ctx, room.ID, "Pat", "guest-token", primary.JoinOptions{}); err != nil {
+		t.Fatalf("failed to ask to join: %v", err)
+	}
+	// A second request under the same name is turned away
+	if _, err := service.JoinRoom(ctx, room.ID, "Pat", "other-token", primary.JoinOptions{}); err != domain.ErrAlreadyWaiting {
+		t.Errorf("expected ErrAlreadyWaiting, got %v", err)
+	}
+	_ = service.SetLocked(ctx, room.ID, "host", "host-token", true)
+
+	select {
+	case event := <-hostEvents:
+		if event.Type != primary.RoomEventJoinRequested {
+			t.Errorf("expected the host told about the request, got %v", event.Type)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the host told about the request")
+	}
+
+	// The voter's next event is the lock, not the request
+	select {
+	case event := <-voterEvents:
+		if event.Type != primary.RoomEventLockChanged {
+			t.Errorf("expected the request hidden from a voter, got %v", event.Type)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the lock change")
+	}
+}
@@ -0,0 +1,54 @@
+package app
+
+import (
+	"time"
+
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+)
+
+// DefaultRoomInactivityTimeout is the duration after which inactive rooms are cleaned up
+const DefaultRoomInactivityTimeout = 15 * time.Minute
+
+// DefaultExpiryWarning is how long before expiry participants are warned
+const DefaultExpiryWarning = 2 * time.Minute
+
+// DefaultDisconnectGrace is how long disconnected participants are kept before
+// they are removed from the room
+const DefaultDisconnectGrace = 10 * time.Minute
+
+// DefaultPresenceTimeout is how long a participant stays connected after their
+// last event stream ends, so a page reload does not count as leaving
+const DefaultPresenceTimeout = 30 * time.Second
+
+// AsyncRoomInactivityTimeout is how long async rooms are kept without any activity.
+// It outlasts domain.MaxVotingDeadline so an open round is never collected.
+const AsyncRoomInactivityTimeout = 30 * 24 * time.Hour
+
+// RoomTimeouts configures when idle rooms expire for a deployment
+type RoomTimeouts struct {
+	Inactivity time.Duration // Used for rooms without a custom timeout
+	Warning    time.Duration // How long before expiry an ExpiryWarning is sent (0 disables warnings)
+	Disconnect time.Duration // How long disconnected participants are kept (0 keeps them until the room closes)
+	Presence   time.Duration // How long a participant without an open event stream stays connected
+}
+
+// DefaultRoomTimeouts returns the timeouts used when a deployment does not configure any
+func DefaultRoomTimeouts() RoomTimeouts {
+	return RoomTimeouts{
+		Inactivity: DefaultRoomInactivityTimeout,
+		Warning:    DefaultExpiryWarning,
+		Disconnect: DefaultDisconnectGrace,
+		Presence:   DefaultPresenceTimeout,
+	}
+}
+
+// forRoom returns how long a room may sit idle before it is cleaned up
+func (t RoomTimeouts) forRoom(room *domain.Room) time.Duration {
+	if room.IsAsync() {
+		return AsyncRoomInactivityTimeout
+	}
+	if timeout := room.GetInactivityTimeout(); timeout != 0 {
+		return timeout
+	}
+	return t.Inactivity
+}
@@ -0,0 +1,14 @@
+package app
+
+import "github.com/vicmanager/esteemed/backend/internal/domain"
+
+// viewingCheck returns a check a stream runs before each event it delivers.
+// Streams opened with the room's view token stop once the token is revoked or
+// replaced; streams opened with any other token always go on. The token is
+// checked against the room itself, so a stream cannot miss the change.
+func viewingCheck(room *domain.Room, sessionToken string) func() bool {
+	if !room.IsViewToken(sessionToken) {
+		return func() bool { return true }
+	}
+	return func() bool { return room.IsViewToken(sessionToken) }
+}
@@ -0,0 +1,94 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+	"github.com/vicmanager/esteemed/backend/internal/ports/primary"
+)
+
+func TestViewToken_StreamsEndOnRevoke(t *testing.T) {
+	ctx := context.Background()
+	service, repo, _ := newTestRoomService(t)
+
+	room := domain.NewRoom("r1", "brave-nebula", &domain.Participant{ID: "host", SessionToken: "host-token", IsConnected: true}, nil)
+	_ = repo.Create(ctx, room)
+
+	token, err := service.CreateViewToken(ctx, room.ID, "host", "host-token")
+	if err != nil {
+		t.Fatalf("failed to create view token: %v", err)
+	}
+	if _, err := service.JoinRoom(ctx, room.ID, "Screen", token, primary.JoinOptions{}); err != domain.ErrViewOnly {
+		t.Errorf("expected ErrViewOnly when joining with the view token, got %v", err)
+	}
+
+	events, err := service.WatchRoom(ctx, room.ID, token, "", primary.ResumePoint{})
+	if err != nil {
+		t.Fatalf("failed to watch with the view token: %v", err)
+	}
+	if _, err := service.GetRoom(ctx, room.ID, token, ""); err != nil {
+		t.Errorf("expected view token to load the room, got %v", err)
+	}
+
+	if err := service.RevokeViewToken(ctx, room.ID, "host", "host-token"); err != nil {
+		t.Fatalf("failed to revoke: %v", err)
+	}
+
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case _, ok := <-events:
+			if !ok {
+				return
+			}
+		case <-timeout:
+			t.Fatal("expected the viewer's stream to end")
+		}
+	}
+}
+
+func TestViewToken_StreamsEndWithoutTheRevokeEvent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	service, repo, broker := newTestRoomService(t)
+	votes := NewEstimationService(repo, broker, nil, nil, service.presence)
+
+	room := domain.NewRoom("r1", "brave-nebula", &domain.Participant{ID: "host", SessionToken: "host-token", IsConnected: true}, nil)
+	_ = repo.Create(ctx, room)
+	token, _, _ := room.CreateViewToken("host")
+
+	stateEvents, err := service.WatchRoomState(ctx, room.ID, token, "", primary.ResumePoint{})
+	if err != nil {
+		t.Fatalf("failed to watch room state: %v", err)
+	}
+	voteEvents, err := votes.WatchVotes(ctx, room.ID, token, "", primary.ResumePoint{})
+	if err != nil {
+		t.Fatalf("failed to watch votes: %v", err)
+	}
+	<-stateEvents // Initial snapshot
+
+	// The token is replaced without the revoke reaching the streams; the next
+	// event they see ends them
+	_, _, _ = room.CreateViewToken("host")
+	_ = broker.PublishRoomEvent(ctx, room.ID, primary.RoomEvent{Type: primary.RoomEventKeptAlive})
+
+	timeout := time.After(time.Second)
+	for stateEvents != nil || voteEvents != nil {
+		select {
+		case event, ok := <-stateEvents:
+			if !ok {
+				stateEvents = nil
+			} else if event.Room != nil {
+				t.Errorf("expected no events for a replaced view token, got %v", event.Room.Type)
+			}
+		case _, ok := <-voteEvents:
+			if !ok {
+				voteEvents = nil
+			}
+		case <-timeout:
+			t.Fatal("expected the replaced view token's streams to end")
+		}
+	}
+}
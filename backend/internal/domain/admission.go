@@ -0,0 +1,188 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Admission errors
+var (
+	ErrRoomLocked      = errors.New("the room is locked")
+	ErrPendingNotFound = errors.New("no pending join request for that participant")
+	ErrWaitingRoomFull = errors.New("too many join requests are waiting, try again later")
+	ErrAlreadyWaiting  = errors.New("a join request with that name is already waiting")
+)
+
+// Waiting room limits
+const (
+	MaxPending     = 20               // Join requests that may wait at once
+	PendingTimeout = 10 * time.Minute // How long a join request waits before it lapses
+)
+
+// SetLocked locks or unlocks the room (host or facilitator action). A locked
+// room turns away new participants; existing ones can still reconnect.
+func (r *Room) SetLocked(callerID string, locked bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.authorize(callerID, ActionManageParticipants); err != nil {
+		return err
+	}
+
+	r.Locked = locked
+	return nil
+}
+
+// IsLocked returns whether the room turns away new participants
+func (r *Room) IsLocked() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.Locked
+}
+
+// SetWaitingRoom turns the waiting room on or off (owner action). Requests
+// already waiting stay until they are admitted or rejected.
+func (r *Room) SetWaitingRoom(ownerID string, enabled bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.authorize(ownerID, ActionManageRoom); err != nil {
+		return err
+	}
+
+	r.WaitingRoom = enabled
+	return nil
+}
+
+// HasWaitingRoom returns whether new participants wait to be admitted
+func (r *Room) HasWaitingRoom() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.WaitingRoom
+}
+
+// AddPending puts a new participant in the waiting room. A request is turned
+// away while another with the same session token or name is waiting, or when
+// MaxPending requests are.
+func (r *Room) AddPending(p *Participant) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.Locked {
+		return ErrRoomLocked
+	}
+	if _, exists := r.Participants[p.ID]; exists {
+		return ErrParticipantExists
+	}
+
+	name := strings.TrimSpace(p.Name)
+	for _, waiting := range r.Pending {
+		if waiting.SessionToken == p.SessionToken || (name != "" && strings.EqualFold(strings.TrimSpace(waiting.Name), name)) {
+			return ErrAlreadyWaiting
+		}
+	}
+	if len(r.Pending) >= MaxPending {
+		return ErrWaitingRoomFull
+	}
+
+	if r.Pending == nil {
+		r.Pending = make(map[string]*Participant)
+	}
+	r.Pending[p.ID] = p
+	return nil
+}
+
+// ExpirePending drops join requests that waited PendingTimeout or longer and
+// returns them, so they can be told
+func (r *Room) ExpirePending(now time.Time) []*Participant {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var expired []*Participant
+	for id, p := range r.Pending {
+		if now.Sub(p.JoinedAt) >= PendingTimeout {
+			delete(r.Pending, id)
+			expired = append(expired, p)
+		}
+	}
+	return expired
+}
+
+// GetPendingByToken finds a waiting participant by session token
+func (r *Room) GetPendingByToken(token string) (*Participant, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if token == "" {
+		return nil, ErrPendingNotFound
+	}
+	for _, p := range r.Pending {
+		if p.SessionToken == token {
+			return p, nil
+		}
+	}
+	return nil, ErrPendingNotFound
+}
+
+// IsPending returns whether a participant is still waiting to be admitted
+func (r *Room) IsPending(participantID string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, pending := r.Pending[participantID]
+	return pending
+}
+
+// GetPending returns the waiting participants in the order they asked to join
+func (r *Room) GetPending() []*Participant {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	pending := make([]*Participant, 0, len(r.Pending))
+	for _, p := range r.Pending {
+		pending = append(pending, p)
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].JoinedAt.Before(pending[j].JoinedAt)
+	})
+	return pending
+}
+
+// Admit lets a waiting participant into the room (host or facilitator action).
+// Admission is a host decision, so it is not blocked by the lock.
+func (r *Room) Admit(callerID, pendingID string) (*Participant, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.authorize(callerID, ActionManageParticipants); err != nil {
+		return nil, err
+	}
+	p, exists := r.Pending[pendingID]
+	if !exists {
+		return nil, ErrPendingNotFound
+	}
+	if err := r.checkCapacity(p.IsSpectator); err != nil {
+		return nil, err
+	}
+
+	delete(r.Pending, pendingID)
+	r.Participants[p.ID] = p
+	return p, nil
+}
+
+// Reject turns a waiting participant away (host or facilitator action)
+func (r *Room) Reject(callerID, pendingID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.authorize(callerID, ActionManageParticipants); err != nil {
+		return err
+	}
+	if _, exists := r.Pending[pendingID]; !exists {
+		return ErrPendingNotFound
+	}
+
+	delete(r.Pending, pendingID)
+	return nil
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestAdmission_Lock(t *testing.T) {
+	room := NewRoom("r1", "room", &Participant{ID: "host", SessionToken: "host-token"}, nil)
+	_ = room.AddParticipant(&Participant{ID: "voter"})
+
+	if err := room.SetLocked("voter", true); err != ErrNotHost {
+		t.Errorf("expected ErrNotHost for a voter, got %v", err)
+	}
+	if err := room.SetLocked("host", true); err != nil {
+		t.Fatalf("failed to lock: %v", err)
+	}
+	if err := room.AddPending(&Participant{ID: "late", SessionToken: "late-token"}); err != ErrRoomLocked {
+		t.Errorf("expected ErrRoomLocked, got %v", err)
+	}
+}
+
+func TestAdmission_WaitingRoom(t *testing.T) {
+	room := NewRoom("r1", "room", &Participant{ID: "host", SessionToken: "host-token"}, nil)
+
+	if err := room.SetWaitingRoom("host", true); err != nil {
+		t.Fatalf("failed to enable waiting room: %v", err)
+	}
+	_ = room.AddPending(&Participant{ID: "a", SessionToken: "a-token"})
+	_ = room.AddPending(&Participant{ID: "b", SessionToken: "b-token"})
+
+	if p, err := room.GetPendingByToken("a-token"); err != nil || p.ID != "a" {
+		t.Errorf("expected pending a, got %+v, %v", p, err)
+	}
+	if _, err := room.GetParticipant("a"); err != ErrParticipantNotFound {
+		t.Errorf("expected pending participant not in the room, got %v", err)
+	}
+
+	admitted, err := room.Admit("host", "a")
+	if err != nil || admitted.ID != "a" {
+		t.Fatalf("failed to admit: %+v, %v", admitted, err)
+	}
+	if _, err := room.GetParticipant("a"); err != nil || room.IsPending("a") {
+		t.Errorf("expected a admitted, got %v", err)
+	}
+
+	if err := room.Reject("host", "b"); err != nil {
+		t.Fatalf("failed to reject: %v", err)
+	}
+	if room.IsPending("b") {
+		t.Error("expected b no longer pending")
+	}
+	if err := room.Reject("host", "b"); err != ErrPendingNotFound {
+		t.Errorf("expected ErrPendingNotFound, got %v", err)
+	}
+}
+
+func TestAdmission_WaitingRoomBounds(t *testing.T) {
+	room := NewRoom("r1", "room", &Participant{ID: "host", SessionToken: "host-token"}, nil)
+	now := time.Now()
+
+	_ = room.AddPending(&Participant{ID: "a", Name: "Pat", SessionToken: "a-token", JoinedAt: now.Add(-PendingTimeout)})
+	if err := room.AddPending(&Participant{ID: "b", Name: " pat ", SessionToken: "b-token", JoinedAt: now}); err != ErrAlreadyWaiting {
+		t.Errorf("expected ErrAlreadyWaiting for the same name, got %v", err)
+	}
+	if err := room.AddPending(&Participant{ID: "b", Name: "Sam", SessionToken: "a-token", JoinedAt: now}); err != ErrAlreadyWaiting {
+		t.Errorf("expected ErrAlreadyWaiting for the same token, got %v", err)
+	}
+
+	// Requests lapse after the timeout
+	if expired := room.ExpirePending(now); len(expired) != 1 || expired[0].ID != "a" || room.IsPending("a") {
+		t.Errorf("expected a's request expired, got %+v", expired)
+	}
+
+	for i := range MaxPending {
+		id := fmt.Sprintf("p%d", i)
+		if err := room.AddPending(&Participant{ID: id, Name: id, SessionToken: id + "-token", JoinedAt: now}); err != nil {
+			t.Fatalf("failed to add pending %s: %v", id, err)
+		}
+	}
+	if err := room.AddPending(&Participant{ID: "late", Name: "Late", SessionToken: "late-token", JoinedAt: now}); err != ErrWaitingRoomFull {
+		t.Errorf("expected ErrWaitingRoomFull, got %v", err)
+	}
+}
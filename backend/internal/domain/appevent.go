@@ -35,7 +35,8 @@ type RoomCreatedPayload struct {
 
 // RoomClosedPayload contains details about a room closure event
 type RoomClosedPayload struct {
-	Reason string
+	Reason      string
+	CloseReason CloseReason
 }
 
 // VoteCastPayload contains details about a vote being cast
@@ -65,14 +66,15 @@ func NewRoomCreatedEvent(roomID, roomName, hostName string) AppEvent {
 }
 
 // NewRoomClosedEvent creates a new room closed event
-func NewRoomClosedEvent(roomID, roomName, reason string) AppEvent {
+func NewRoomClosedEvent(roomID, roomName string, reason CloseReason) AppEvent {
 	return AppEvent{
 		Type:      AppEventTypeRoomClosed,
 		Timestamp: time.Now(),
 		RoomID:    roomID,
 		RoomName:  roomName,
 		RoomClosed: &RoomClosedPayload{
-			Reason: reason,
+			Reason:      reason.String(),
+			CloseReason: reason,
 		},
 	}
 }
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"time"
+)
+
+// ErrSessionNotFound is returned when an archived session does not exist
+var ErrSessionNotFound = errors.New("archived session not found")
+
+// ArchivedSession is a closed room kept so it can be browsed and exported later
+type ArchivedSession struct {
+	ID           string // ID of the closed room
+	RoomName     string
+	Mode         RoomMode
+	ParentID     string // Set when the room was a breakout
+	Visibility   Visibility
+	CreatedAt    time.Time
+	ClosedAt     time.Time
+	CloseReason  CloseReason
+	Deck         []string // Card values, or options in dot-voting rooms
+	Participants []*ArchivedParticipant
+	Rounds       []*RoundResult // Oldest first
+}
+
+// ArchivedParticipant is a participant of an archived session
+type ArchivedParticipant struct {
+	Name          string
+	TokenHash     string // Hash of the participant's session token (see HashSessionToken); lets them find the session again
+	WasHost       bool
+	IsSpectator   bool
+	IsPlaceholder bool
+}
+
+// ArchivedSessionSummary describes an archived session in listings
+type ArchivedSessionSummary struct {
+	ID               string
+	RoomName         string
+	Mode             RoomMode
+	CreatedAt        time.Time
+	ClosedAt         time.Time
+	CloseReason      CloseReason
+	ParticipantCount int
+	RoundCount       int
+}
+
+// ArchiveFilter narrows a listing of archived sessions
+type ArchiveFilter struct {
+	Query          string    // Case-insensitive match on the room name (empty matches all)
+	From           time.Time // Earliest close time (zero for no bound)
+	To             time.Time // Latest close time (zero for no bound)
+	TokenHashes    []string  // Only sessions a holder of one of these token hashes took part in (nil for all)
+	IncludePrivate bool      // Also list sessions of private rooms
+	Limit          int
+}
+
+// Archive captures the room's session as it is closed
+func (r *Room) Archive(reason CloseReason, closedAt time.Time) *ArchivedSession {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	session := &ArchivedSession{
+		ID:          r.ID,
+		RoomName:    r.Name,
+		Mode:        r.Mode,
+		ParentID:    r.ParentID,
+		Visibility:  r.Visibility,
+		CreatedAt:   r.CreatedAt,
+		ClosedAt:    closedAt,
+		CloseReason: reason,
+		Rounds:      make([]*RoundResult, len(r.History)),
+	}
+	copy(session.Rounds, r.History)
+
+	if r.Mode == RoomModeDotVoting && r.DotConfig != nil {
+		session.Deck = append(session.Deck, r.DotConfig.Options...)
+	} else {
+		for _, card := range r.CardConfig.Cards {
+			session.Deck = append(session.Deck, card.Value)
+		}
+	}
+
+	participants := make([]*Participant, 0, len(r.Participants))
+	for _, p := range r.Participants {
+		participants = append(participants, p)
+	}
+	sort.Slice(participants, func(i, j int) bool {
+		return participants[i].JoinedAt.Before(participants[j].JoinedAt)
+	})
+
+	for _, p := range participants {
+		session.Participants = append(session.Participants, &ArchivedParticipant{
+			Name:          p.Name,
+			TokenHash:     HashSessionToken(p.SessionToken),
+			WasHost:       p.IsHost,
+			IsSpectator:   p.IsSpectator,
+			IsPlaceholder: p.IsPlaceholder,
+		})
+	}
+
+	return session
+}
+
+// HashSessionToken returns the hex SHA-256 of a session token. Archives only
+// keep these hashes, so a leaked archive does not leak working tokens.
+func HashSessionToken(sessionToken string) string {
+	if sessionToken == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(sessionToken))
+	return hex.EncodeToString(sum[:])
+}
+
+// HasParticipant reports whether a session token belonged to one of the session's participants
+func (s *ArchivedSession) HasParticipant(sessionToken string) bool {
+	if sessionToken == "" {
+		return false
+	}
+	hash := []byte(HashSessionToken(sessionToken))
+	for _, p := range s.Participants {
+		if subtle.ConstantTimeCompare([]byte(p.TokenHash), hash) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// Summary returns the listing entry for the session
+func (s *ArchivedSession) Summary() *ArchivedSessionSummary {
+	return &ArchivedSessionSummary{
+		ID:               s.ID,
+		RoomName:         s.RoomName,
+		Mode:             s.Mode,
+		CreatedAt:        s.CreatedAt,
+		ClosedAt:         s.ClosedAt,
+		CloseReason:      s.CloseReason,
+		ParticipantCount: len(s.Participants),
+		RoundCount:       len(s.Rounds),
+	}
+}
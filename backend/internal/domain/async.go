@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Errors
+var (
+	ErrNotAsync        = errors.New("room is not in async mode")
+	ErrDeadlineInPast  = errors.New("voting deadline must be in the future")
+	ErrDeadlineTooLate = errors.New("voting deadline is too far in the future")
+)
+
+// MaxVotingDeadline bounds how far ahead an async round can stay open
+const MaxVotingDeadline = 14 * 24 * time.Hour
+
+// ValidateDeadline checks that a voting deadline is in the future and within bounds
+func ValidateDeadline(deadline time.Time) error {
+	now := time.Now()
+	if !deadline.After(now) {
+		return ErrDeadlineInPast
+	}
+	if deadline.Sub(now) > MaxVotingDeadline {
+		return ErrDeadlineTooLate
+	}
+	return nil
+}
+
+// EnableAsync switches the room to async voting. With a deadline the first
+// round opens right away, since the host may not be around to start it.
+func (r *Room) EnableAsync(deadline time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.Async = true
+	if !deadline.IsZero() {
+		r.Deadline = deadline
+		r.State = RoomStateVoting
+	}
+}
+
+// IsAsync returns true if the room is in async voting mode
+func (r *Room) IsAsync() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.Async
+}
+
+// GetDeadline returns the current round's voting deadline (zero if none)
+func (r *Room) GetDeadline() time.Time {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.Deadline
+}
+
+// SetDeadline sets the voting deadline of the current round in an async room
+// (host or facilitator action). A waiting room starts voting.
+func (r *Room) SetDeadline(hostID string, deadline time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.authorize(hostID, ActionManageRound); err != nil {
+		return err
+	}
+	if !r.Async {
+		return ErrNotAsync
+	}
+	if r.State == RoomStateRevealed {
+		return ErrInvalidState
+	}
+	if err := ValidateDeadline(deadline); err != nil {
+		return err
+	}
+
+	if r.State == RoomStateWaiting {
+		r.pushSnapshot(TransitionStart)
+		r.State = RoomStateVoting
+	}
+	r.Deadline = deadline
+
+	return nil
+}
+
+// DeadlinePassed returns true if an async round is still open past its deadline
+func (r *Room) DeadlinePassed(now time.Time) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.Async && r.State == RoomStateVoting && !r.Deadline.IsZero() && !now.Before(r.Deadline)
+}
+
+// AllVoted returns true if every voter in the room, connected or not, has voted
+func (r *Room) AllVoted() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(r.Votes) == 0 {
+		return false
+	}
+
+	for _, p := range r.Participants {
+		if p.IsSpectator {
+			continue
+		}
+		if _, voted := r.Votes[p.ID]; !voted {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAsyncRound_DeadlineAndCompletion(t *testing.T) {
+	host := &Participant{ID: "host", Name: "Host", IsHost: true, IsConnected: true}
+	room := NewRoom("r1", "brave-nebula", host, nil)
+	_ = room.AddParticipant(&Participant{ID: "away", Name: "Away"})
+
+	if err := room.SetDeadline("host", time.Now().Add(time.Hour)); err != ErrNotAsync {
+		t.Fatalf("expected ErrNotAsync, got %v", err)
+	}
+
+	room.EnableAsync(time.Time{})
+	if room.GetState() != RoomStateWaiting {
+		t.Fatalf("expected waiting room without a deadline, got %v", room.GetState())
+	}
+
+	if err := room.SetDeadline("host", time.Now().Add(-time.Minute)); err != ErrDeadlineInPast {
+		t.Errorf("expected ErrDeadlineInPast, got %v", err)
+	}
+
+	deadline := time.Now().Add(time.Hour)
+	if err := room.SetDeadline("host", deadline); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if room.GetState() != RoomStateVoting {
+		t.Errorf("expected deadline to open voting, got %v", room.GetState())
+	}
+
+	if room.DeadlinePassed(time.Now()) || !room.DeadlinePassed(deadline) {
+		t.Error("expected deadline to pass exactly at the deadline")
+	}
+
+	// Disconnected participants still count as voters in async rooms
+	_ = room.CastVote("host", "5")
+	if room.AllVoted() {
+		t.Error("expected round incomplete while the absent participant has not voted")
+	}
+	_ = room.CastVote("away", "8")
+	if !room.AllVoted() {
+		t.Error("expected round complete once everyone voted")
+	}
+
+	if _, err := room.RevealVotes(); err != nil {
+		t.Fatalf("unexpected reveal error: %v", err)
+	}
+	if !room.GetDeadline().IsZero() {
+		t.Error("expected reveal to clear the deadline")
+	}
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Ban errors
+var (
+	ErrBanned      = errors.New("you have been removed from this room")
+	ErrBanNotFound = errors.New("ban not found")
+)
+
+// Ban keeps a kicked participant out of the room for the room's lifetime
+type Ban struct {
+	ID              string // ID of the banned participant
+	ParticipantName string
+	SessionToken    string // Never shown to clients
+	NameBanned      bool   // Also turns away anyone joining under the same name
+	BannedBy        string
+	BannedAt        time.Time
+}
+
+// BanParticipant kicks a participant and bans their session token, and
+// optionally their name, from rejoining (host or facilitator action)
+func (r *Room) BanParticipant(hostID, targetID string, banName bool, now time.Time) (*Ban, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	target, err := r.kick(hostID, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	ban := &Ban{
+		ID:              target.ID,
+		ParticipantName: target.Name,
+		SessionToken:    target.SessionToken,
+		NameBanned:      banName,
+		BannedBy:        hostID,
+		BannedAt:        now,
+	}
+	if r.Bans == nil {
+		r.Bans = make(map[string]*Ban)
+	}
+	r.Bans[ban.ID] = ban
+	return ban, nil
+}
+
+// CheckBanned returns ErrBanned if the session token or name has been banned
+func (r *Room) CheckBanned(sessionToken, name string) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.isBanned(sessionToken, name) {
+		return ErrBanned
+	}
+	return nil
+}
+
+// isBanned checks a session token and name against the bans (caller must hold the lock)
+func (r *Room) isBanned(sessionToken, name string) bool {
+	name = strings.TrimSpace(name)
+	for _, ban := range r.Bans {
+		if sessionToken != "" && ban.SessionToken == sessionToken {
+			return true
+		}
+		if ban.NameBanned && name != "" && strings.EqualFold(ban.ParticipantName, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// ListBans returns the room's bans, oldest first (host or facilitator action)
+func (r *Room) ListBans(callerID string) ([]*Ban, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, err := r.authorize(callerID, ActionManageParticipants); err != nil {
+		return nil, err
+	}
+
+	bans := make([]*Ban, 0, len(r.Bans))
+	for _, ban := range r.Bans {
+		bans = append(bans, ban)
+	}
+	sort.Slice(bans, func(i, j int) bool {
+		return bans[i].BannedAt.Before(bans[j].BannedAt)
+	})
+	return bans, nil
+}
+
+// LiftBan lets a banned participant join again (host or facilitator action)
+func (r *Room) LiftBan(callerID, banID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.authorize(callerID, ActionManageParticipants); err != nil {
+		return err
+	}
+	if _, exists := r.Bans[banID]; !exists {
+		return ErrBanNotFound
+	}
+
+	delete(r.Bans, banID)
+	return nil
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBans(t *testing.T) {
+	room := NewRoom("r1", "room", &Participant{ID: "host", SessionToken: "host-token"}, nil)
+	_ = room.AddParticipant(&Participant{ID: "troll", Name: "Troll", SessionToken: "troll-token"})
+	_ = room.AddParticipant(&Participant{ID: "voter", Name: "Voter", SessionToken: "voter-token"})
+	now := time.Now()
+
+	if _, err := room.BanParticipant("voter", "troll", false, now); err != ErrNotHost {
+		t.Errorf("expected ErrNotHost for a voter, got %v", err)
+	}
+	if _, err := room.BanParticipant("host", "troll", true, now); err != nil {
+		t.Fatalf("failed to ban: %v", err)
+	}
+	if _, err := room.GetParticipant("troll"); err != ErrParticipantNotFound {
+		t.Errorf("expected banned participant removed, got %v", err)
+	}
+
+	if err := room.CheckBanned("troll-token", "Someone"); err != ErrBanned {
+		t.Errorf("expected banned token rejected, got %v", err)
+	}
+	if err := room.CheckBanned("new-token", " troll "); err != ErrBanned {
+		t.Errorf("expected banned name rejected, got %v", err)
+	}
+	if err := room.AuthorizeWatcher("troll-token", "", now); err != ErrBanned {
+		t.Errorf("expected banned token unable to watch, got %v", err)
+	}
+
+	// A plain kick does not ban
+	if err := room.KickParticipant("host", "voter"); err != nil {
+		t.Fatalf("failed to kick: %v", err)
+	}
+	if err := room.CheckBanned("voter-token", "Voter"); err != nil {
+		t.Errorf("expected kicked participant free to rejoin, got %v", err)
+	}
+
+	bans, err := room.ListBans("host")
+	if err != nil || len(bans) != 1 || bans[0].ID != "troll" {
+		t.Fatalf("expected one ban, got %+v, %v", bans, err)
+	}
+	if err := room.LiftBan("host", "troll"); err != nil {
+		t.Fatalf("failed to lift ban: %v", err)
+	}
+	if err := room.CheckBanned("troll-token", "Troll"); err != nil {
+		t.Errorf("expected lifted ban to let them back, got %v", err)
+	}
+	if err := room.LiftBan("host", "troll"); err != ErrBanNotFound {
+		t.Errorf("expected ErrBanNotFound, got %v", err)
+	}
+}
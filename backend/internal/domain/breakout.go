@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Errors
+var (
+	ErrBreakoutNotFound = errors.New("breakout room not found")
+	ErrNestedBreakout   = errors.New("breakout rooms cannot have breakouts of their own")
+)
+
+// NewBreakoutRoom creates a child room that shares the parent's deck and mode.
+// The parent's host becomes host of the breakout and counts as disconnected
+// until they join it.
+func (r *Room) NewBreakoutRoom(hostID, breakoutID string) (*Room, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	host, err := r.authorize(hostID, ActionManageBreakouts)
+	if err != nil {
+		return nil, err
+	}
+	if r.ParentID != "" {
+		return nil, ErrNestedBreakout
+	}
+
+	r.breakoutCount++
+	name := fmt.Sprintf("%s-%d", r.Name, r.breakoutCount)
+
+	breakoutHost := &Participant{
+		ID:           host.ID,
+		Name:         host.Name,
+		SessionToken: host.SessionToken,
+		JoinedAt:     time.Now(),
+	}
+
+	breakout := NewRoom(breakoutID, name, breakoutHost, r.CardConfig)
+	breakout.Mode = r.Mode
+	breakout.DotConfig = r.DotConfig
+	breakout.ParentID = r.ID
+	breakout.InactivityTimeout = r.InactivityTimeout
+	breakout.Visibility = r.Visibility
+	breakout.MaxVoters = r.MaxVoters
+	breakout.MaxSpectators = r.MaxSpectators
+
+	r.Breakouts = append(r.Breakouts, breakoutID)
+
+	return breakout, nil
+}
+
+// HasBreakout checks if the given room is an open breakout of this room
+func (r *Room) HasBreakout(breakoutID string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.hasBreakout(breakoutID)
+}
+
+// HasBreakouts returns true if the room has open breakouts
+func (r *Room) HasBreakouts() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.Breakouts) > 0
+}
+
+// GetBreakouts returns a copy of the IDs of the room's open breakouts
+func (r *Room) GetBreakouts() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	breakouts := make([]string, len(r.Breakouts))
+	copy(breakouts, r.Breakouts)
+	return breakouts
+}
+
+// hasBreakout checks breakout membership (caller must hold the lock)
+func (r *Room) hasBreakout(breakoutID string) bool {
+	for _, id := range r.Breakouts {
+		if id == breakoutID {
+			return true
+		}
+	}
+	return false
+}
+
+// AssignBreakout records that a participant moved into a breakout (host or
+// facilitator action). Returns a copy of the participant for the breakout room
+// and the breakout they were previously assigned to, if any.
+func (r *Room) AssignBreakout(hostID, targetID, breakoutID string) (moved *Participant, previousBreakoutID string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.authorize(hostID, ActionManageParticipants); err != nil {
+		return nil, "", err
+	}
+	if !r.hasBreakout(breakoutID) {
+		return nil, "", ErrBreakoutNotFound
+	}
+
+	target, exists := r.Participants[targetID]
+	if !exists {
+		return nil, "", ErrParticipantNotFound
+	}
+
+	previousBreakoutID = target.BreakoutID
+	target.BreakoutID = breakoutID
+
+	moved = &Participant{
+		ID:           target.ID,
+		Name:         target.Name,
+		SessionToken: target.SessionToken,
+		IsSpectator:  target.IsSpectator,
+		JoinedAt:     time.Now(),
+	}
+
+	return moved, previousBreakoutID, nil
+}
+
+// MergeBreakout detaches a closed breakout and appends its revealed rounds to
+// this room's history. Participants assigned to it are returned to the room.
+func (r *Room) MergeBreakout(breakoutID string, rounds []*RoundResult) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.hasBreakout(breakoutID) {
+		return ErrBreakoutNotFound
+	}
+
+	r.detachBreakout(breakoutID)
+	r.appendHistory(rounds...)
+
+	return nil
+}
+
+// DetachBreakout forgets a breakout that was closed without merging (e.g. it expired)
+func (r *Room) DetachBreakout(breakoutID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.detachBreakout(breakoutID)
+}
+
+// detachBreakout removes a breakout and clears assignments (caller must hold the lock)
+func (r *Room) detachBreakout(breakoutID string) {
+	for i, id := range r.Breakouts {
+		if id == breakoutID {
+			r.Breakouts = append(r.Breakouts[:i], r.Breakouts[i+1:]...)
+			break
+		}
+	}
+
+	for _, p := range r.Participants {
+		if p.BreakoutID == breakoutID {
+			p.BreakoutID = ""
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package domain
+
+import "testing"
+
+func TestBreakout_CreateAndAssign(t *testing.T) {
+	room := NewRoom("r1", "brave-nebula", &Participant{ID: "host", Name: "Host", SessionToken: "host-token", IsConnected: true}, nil)
+	_ = room.AddParticipant(&Participant{ID: "voter", Name: "Pat", SessionToken: "voter-token", IsConnected: true})
+	room.Mode = RoomModeDotVoting
+
+	if _, err := room.NewBreakoutRoom("voter", "b1"); err != ErrNotHost {
+		t.Errorf("expected ErrNotHost for a voter, got %v", err)
+	}
+
+	breakout, err := room.NewBreakoutRoom("host", "b1")
+	if err != nil {
+		t.Fatalf("failed to create breakout: %v", err)
+	}
+	if breakout.Name != "brave-nebula-1" || breakout.ParentID != "r1" || breakout.Mode != RoomModeDotVoting {
+		t.Errorf("unexpected breakout: name %q, parent %q, mode %v", breakout.Name, breakout.ParentID, breakout.Mode)
+	}
+	if !breakout.IsHost("host") || !room.HasBreakout("b1") {
+		t.Error("expected the parent's host to host the open breakout")
+	}
+	if _, err := breakout.NewBreakoutRoom("host", "b2"); err != ErrNestedBreakout {
+		t.Errorf("expected ErrNestedBreakout, got %v", err)
+	}
+
+	if _, _, err := room.AssignBreakout("host", "voter", "missing"); err != ErrBreakoutNotFound {
+		t.Errorf("expected ErrBreakoutNotFound, got %v", err)
+	}
+	moved, previous, err := room.AssignBreakout("host", "voter", "b1")
+	if err != nil || previous != "" {
+		t.Fatalf("failed to assign: %v (previous %q)", err, previous)
+	}
+	if moved.ID != "voter" || moved.SessionToken != "voter-token" || moved.IsHost {
+		t.Errorf("expected the voter to keep their identity in the breakout, got %+v", moved)
+	}
+
+	second, _ := room.NewBreakoutRoom("host", "b2")
+	if second.Name != "brave-nebula-2" {
+		t.Errorf("expected breakouts numbered in order, got %q", second.Name)
+	}
+	if _, previous, _ := room.AssignBreakout("host", "voter", "b2"); previous != "b1" {
+		t.Errorf("expected the previous breakout reported, got %q", previous)
+	}
+}
+
+func TestBreakout_MergeAndDetach(t *testing.T) {
+	room := NewRoom("r1", "brave-nebula", &Participant{ID: "host", IsConnected: true}, nil)
+	_ = room.AddParticipant(&Participant{ID: "voter", IsConnected: true})
+
+	breakout, _ := room.NewBreakoutRoom("host", "b1")
+	_, _, _ = room.AssignBreakout("host", "voter", "b1")
+	breakout.StartVoting()
+	_ = breakout.CastVote("host", "5")
+	_, _ = breakout.RevealVotes()
+
+	if err := room.MergeBreakout("b1", breakout.GetHistory()); err != nil {
+		t.Fatalf("failed to merge: %v", err)
+	}
+	history := room.GetHistory()
+	if len(history) != 1 || history[0].SourceRoomID != "b1" || history[0].SourceRoomName != "brave-nebula-1" {
+		t.Errorf("expected the breakout's round in the parent's history, got %+v", history)
+	}
+	if room.HasBreakouts() {
+		t.Error("expected the merged breakout detached")
+	}
+	if voter, _ := room.GetParticipant("voter"); voter.BreakoutID != "" {
+		t.Errorf("expected the voter back in the room, still in %q", voter.BreakoutID)
+	}
+	if err := room.MergeBreakout("b1", nil); err != ErrBreakoutNotFound {
+		t.Errorf("expected ErrBreakoutNotFound merging twice, got %v", err)
+	}
+
+	// Breakouts that close without merging leave the history alone
+	_, _ = room.NewBreakoutRoom("host", "b2")
+	room.DetachBreakout("b2")
+	if room.HasBreakouts() || len(room.GetHistory()) != 1 {
+		t.Error("expected the breakout detached without merging rounds")
+	}
+}
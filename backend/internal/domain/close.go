@@ -0,0 +1,26 @@
+package domain
+
+// CloseReason records why a room was closed
+type CloseReason int
+
+// CloseReason constants represent the ways a room can be closed.
+const (
+	CloseReasonUnspecified CloseReason = iota
+	CloseReasonAllLeft
+	CloseReasonInactivity
+	CloseReasonBreakoutClosed
+)
+
+// String returns the human-readable reason sent to clients and the dashboard
+func (r CloseReason) String() string {
+	switch r {
+	case CloseReasonAllLeft:
+		return "all participants left"
+	case CloseReasonInactivity:
+		return "inactivity timeout"
+	case CloseReasonBreakoutClosed:
+		return "breakout closed"
+	default:
+		return "closed"
+	}
+}
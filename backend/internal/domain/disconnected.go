@@ -0,0 +1,84 @@
+package domain
+
+import "time"
+
+// SetKeepDisconnected sets whether disconnected participants stay in the room
+// instead of being removed after the grace period
+func (r *Room) SetKeepDisconnected(keep bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.KeepDisconnected = keep
+}
+
+// KeepsDisconnected returns whether disconnected participants stay in the room
+// indefinitely. Async and persistent rooms always keep their members.
+func (r *Room) KeepsDisconnected() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.keepsDisconnected()
+}
+
+// keepsDisconnected is KeepsDisconnected for callers that hold the lock
+func (r *Room) keepsDisconnected() bool {
+	return r.KeepDisconnected || r.Async || r.Persistent
+}
+
+// RemoveDisconnected removes participants who have been disconnected for
+// longer than the grace period, dropping their votes. Returns the IDs of the
+// removed participants and the host change if a host-less room got a new host.
+func (r *Room) RemoveDisconnected(grace time.Duration, now time.Time) ([]string, *HostChange) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if grace <= 0 || r.keepsDisconnected() {
+		return nil, nil
+	}
+
+	var removed []string
+	for _, p := range r.Participants {
+		if removeAt := r.removalTime(p, grace); !removeAt.IsZero() && !now.Before(removeAt) {
+			removed = append(removed, p.ID)
+		}
+	}
+	var hostChange *HostChange
+	for _, id := range removed {
+		if change := r.removeParticipant(id); change != nil {
+			hostChange = change
+		}
+	}
+	return removed, hostChange
+}
+
+// NextDisconnectRemoval returns when the next disconnected participant is due
+// for removal (zero if none is)
+func (r *Room) NextDisconnectRemoval(grace time.Duration) time.Time {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if grace <= 0 || r.keepsDisconnected() {
+		return time.Time{}
+	}
+
+	var next time.Time
+	for _, p := range r.Participants {
+		if removeAt := r.removalTime(p, grace); !removeAt.IsZero() && (next.IsZero() || removeAt.Before(next)) {
+			next = removeAt
+		}
+	}
+	return next
+}
+
+// removalTime returns when a disconnected participant is removed (zero if they
+// are not due for removal). A host who can still take the room back is kept
+// until their return deadline (caller must hold the lock).
+func (r *Room) removalTime(p *Participant, grace time.Duration) time.Time {
+	if p.IsConnected || p.IsPlaceholder || p.DisconnectedAt.IsZero() {
+		return time.Time{}
+	}
+
+	removeAt := p.DisconnectedAt.Add(grace)
+	if p.ID == r.returningHostID && r.returnDeadline.After(removeAt) {
+		removeAt = r.returnDeadline
+	}
+	return removeAt
+}
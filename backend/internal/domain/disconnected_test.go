@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRemoveDisconnected(t *testing.T) {
+	room := NewRoom("r1", "room", &Participant{ID: "host", IsConnected: true}, nil)
+	_ = room.AddParticipant(&Participant{ID: "gone", IsConnected: true})
+	_ = room.AddParticipant(&Participant{ID: "placeholder", IsPlaceholder: true})
+	room.SetState(RoomStateVoting)
+	_ = room.CastVote("gone", "5")
+	_, _ = room.DisconnectParticipant("gone")
+
+	now := time.Now()
+	if removed, _ := room.RemoveDisconnected(time.Minute, now); len(removed) != 0 {
+		t.Fatalf("expected nobody removed within the grace period, got %v", removed)
+	}
+	if next := room.NextDisconnectRemoval(time.Minute); next.IsZero() || next.After(now.Add(time.Minute)) {
+		t.Errorf("expected removal due within the grace period, got %v", next)
+	}
+
+	removed, _ := room.RemoveDisconnected(time.Minute, now.Add(time.Minute))
+	if len(removed) != 1 || removed[0] != "gone" {
+		t.Fatalf("expected only the disconnected participant removed, got %v", removed)
+	}
+	if _, voted := room.Votes["gone"]; voted {
+		t.Error("expected removed participant's vote to be dropped")
+	}
+}
+
+func TestRemoveDisconnected_KeepDisconnected(t *testing.T) {
+	room := NewRoom("r1", "room", &Participant{ID: "host", IsConnected: true}, nil)
+	_ = room.AddParticipant(&Participant{ID: "gone", IsConnected: true})
+	_, _ = room.DisconnectParticipant("gone")
+	room.SetKeepDisconnected(true)
+
+	if removed, _ := room.RemoveDisconnected(time.Minute, time.Now().Add(time.Hour)); len(removed) != 0 {
+		t.Errorf("expected disconnected participants kept, got %v", removed)
+	}
+}
@@ -0,0 +1,213 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+// Errors
+var (
+	ErrInvalidRoomMode      = errors.New("action not supported in this room mode")
+	ErrTooFewDotOptions     = errors.New("at least 2 options are required")
+	ErrTooManyDotOptions    = errors.New("maximum 30 options allowed")
+	ErrDotOptionTooLong     = errors.New("option must be 100 characters or less")
+	ErrInvalidDotsPerPerson = errors.New("dots per participant must be between 1 and 20")
+	ErrInvalidDotOption     = errors.New("invalid dot option")
+	ErrTooManyDots          = errors.New("more dots placed than allowed")
+	ErrNoDotsPlaced         = errors.New("at least one dot must be placed")
+)
+
+// RoomMode represents how participants vote in a room
+type RoomMode int
+
+// RoomMode constants
+const (
+	RoomModeEstimation RoomMode = iota
+	RoomModeDotVoting
+)
+
+// DotVoteConfig configures a dot-voting room
+type DotVoteConfig struct {
+	Options            []string
+	DotsPerParticipant int
+}
+
+// DotAllocation is a number of dots placed on a single option
+type DotAllocation struct {
+	Option string
+	Dots   int
+}
+
+// DotRanking is an option's position in the revealed results
+type DotRanking struct {
+	Option     string
+	Dots       int
+	Rank       int // 1-based; tied options share the same rank
+	VoterCount int
+}
+
+// NewDotVoteConfig validates and creates a dot-voting configuration
+func NewDotVoteConfig(options []string, dotsPerParticipant int) (*DotVoteConfig, error) {
+	if dotsPerParticipant < 1 || dotsPerParticipant > 20 {
+		return nil, ErrInvalidDotsPerPerson
+	}
+
+	seen := make(map[string]bool)
+	cleaned := make([]string, 0, len(options))
+	for _, option := range options {
+		// Trim whitespace and strip control characters
+		value := controlCharRegex.ReplaceAllString(strings.TrimSpace(option), "")
+		if value == "" {
+			continue
+		}
+		if len(value) > 100 {
+			return nil, ErrDotOptionTooLong
+		}
+
+		// Skip duplicates
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		cleaned = append(cleaned, value)
+	}
+
+	if len(cleaned) < 2 {
+		return nil, ErrTooFewDotOptions
+	}
+	if len(cleaned) > 30 {
+		return nil, ErrTooManyDotOptions
+	}
+
+	return &DotVoteConfig{
+		Options:            cleaned,
+		DotsPerParticipant: dotsPerParticipant,
+	}, nil
+}
+
+// normalizeAllocations validates allocations against the config and returns
+// them merged per option, in option order, without empty entries
+func (c *DotVoteConfig) normalizeAllocations(allocations []*DotAllocation) ([]*DotAllocation, error) {
+	perOption := make(map[string]int)
+	total := 0
+	for _, a := range allocations {
+		if a.Dots < 0 || !c.hasOption(a.Option) {
+			return nil, ErrInvalidDotOption
+		}
+		perOption[a.Option] += a.Dots
+		total += a.Dots
+	}
+
+	if total == 0 {
+		return nil, ErrNoDotsPlaced
+	}
+	if total > c.DotsPerParticipant {
+		return nil, ErrTooManyDots
+	}
+
+	result := make([]*DotAllocation, 0, len(perOption))
+	for _, option := range c.Options {
+		if dots := perOption[option]; dots > 0 {
+			result = append(result, &DotAllocation{Option: option, Dots: dots})
+		}
+	}
+	return result, nil
+}
+
+// hasOption checks if the option is part of the config
+func (c *DotVoteConfig) hasOption(option string) bool {
+	for _, o := range c.Options {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+// EnableDotVoting switches the room to dot-voting mode with the given config
+func (r *Room) EnableDotVoting(config *DotVoteConfig) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.Mode = RoomModeDotVoting
+	r.DotConfig = config
+}
+
+// CastDots records a participant's dot placement in a dot-voting room
+func (r *Room) CastDots(participantID string, allocations []*DotAllocation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, exists := r.Participants[participantID]
+	if !exists {
+		return ErrParticipantNotFound
+	}
+
+	if p.IsSpectator {
+		return ErrSpectatorCannotVote
+	}
+
+	if r.Mode != RoomModeDotVoting {
+		return ErrInvalidRoomMode
+	}
+
+	if r.State != RoomStateVoting {
+		return ErrInvalidState
+	}
+
+	dots, err := r.DotConfig.normalizeAllocations(allocations)
+	if err != nil {
+		return err
+	}
+
+	r.Votes[participantID] = &Vote{
+		ParticipantID:   participantID,
+		ParticipantName: p.Name,
+		Dots:            dots,
+		HasVoted:        true,
+	}
+
+	return nil
+}
+
+// RankDotOptions aggregates dot placements into a ranked list.
+// Options are ordered by total dots (most first); options with equal totals
+// share a rank and keep their configured order, and the next rank skips
+// accordingly (e.g. 1, 1, 3).
+func RankDotOptions(config *DotVoteConfig, votes []*Vote) []*DotRanking {
+	if config == nil {
+		return nil
+	}
+
+	ranking := make([]*DotRanking, 0, len(config.Options))
+	index := make(map[string]*DotRanking, len(config.Options))
+	for _, option := range config.Options {
+		entry := &DotRanking{Option: option}
+		ranking = append(ranking, entry)
+		index[option] = entry
+	}
+
+	for _, v := range votes {
+		for _, a := range v.Dots {
+			if entry, ok := index[a.Option]; ok && a.Dots > 0 {
+				entry.Dots += a.Dots
+				entry.VoterCount++
+			}
+		}
+	}
+
+	sort.SliceStable(ranking, func(i, j int) bool {
+		return ranking[i].Dots > ranking[j].Dots
+	})
+
+	for i, entry := range ranking {
+		if i > 0 && entry.Dots == ranking[i-1].Dots {
+			entry.Rank = ranking[i-1].Rank
+		} else {
+			entry.Rank = i + 1
+		}
+	}
+
+	return ranking
+}
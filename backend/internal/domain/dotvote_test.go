@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestRankDotOptions_Ties(t *testing.T) {
+	config := &DotVoteConfig{
+		Options:            []string{"login", "search", "export", "billing"},
+		DotsPerParticipant: 3,
+	}
+
+	votes := []*Vote{
+		{ParticipantID: "a", Dots: []*DotAllocation{{Option: "search", Dots: 2}, {Option: "export", Dots: 1}}},
+		{ParticipantID: "b", Dots: []*DotAllocation{{Option: "login", Dots: 2}, {Option: "export", Dots: 1}}},
+		{ParticipantID: "c", Dots: []*DotAllocation{{Option: "billing", Dots: 1}}},
+	}
+
+	ranking := RankDotOptions(config, votes)
+
+	expected := []struct {
+		option string
+		dots   int
+		rank   int
+	}{
+		{"login", 2, 1},
+		{"search", 2, 1},
+		{"export", 2, 1},
+		{"billing", 1, 4},
+	}
+
+	if len(ranking) != len(expected) {
+		t.Fatalf("expected %d entries, got %d", len(expected), len(ranking))
+	}
+
+	for i, e := range expected {
+		r := ranking[i]
+		if r.Option != e.option || r.Dots != e.dots || r.Rank != e.rank {
+			t.Errorf("entry %d: expected %s/%d/#%d, got %s/%d/#%d", i, e.option, e.dots, e.rank, r.Option, r.Dots, r.Rank)
+		}
+	}
+
+	if ranking[2].VoterCount != 2 {
+		t.Errorf("expected 2 voters on export, got %d", ranking[2].VoterCount)
+	}
+}
+
+func TestCastDots_Validation(t *testing.T) {
+	host := &Participant{ID: "host", Name: "Host", IsConnected: true}
+	room := NewRoom("r1", "brave-nebula", host, nil)
+
+	config, err := NewDotVoteConfig([]string{"a", "b", " a ", ""}, 3)
+	if err != nil {
+		t.Fatalf("unexpected config error: %v", err)
+	}
+	if len(config.Options) != 2 {
+		t.Errorf("expected duplicates and blanks removed, got %v", config.Options)
+	}
+
+	room.EnableDotVoting(config)
+	room.StartVoting()
+
+	tests := []struct {
+		name  string
+		dots  []*DotAllocation
+		error error
+	}{
+		{"unknown option", []*DotAllocation{{Option: "c", Dots: 1}}, ErrInvalidDotOption},
+		{"too many dots", []*DotAllocation{{Option: "a", Dots: 2}, {Option: "b", Dots: 2}}, ErrTooManyDots},
+		{"no dots", []*DotAllocation{{Option: "a", Dots: 0}}, ErrNoDotsPlaced},
+		{"valid", []*DotAllocation{{Option: "b", Dots: 1}, {Option: "a", Dots: 1}, {Option: "b", Dots: 1}}, nil},
+	}
+
+	for _, tt := range tests {
+		if err := room.CastDots("host", tt.dots); err != tt.error {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.error, err)
+		}
+	}
+
+	// Allocations are merged and kept in option order
+	vote := room.Votes["host"]
+	if len(vote.Dots) != 2 || vote.Dots[0].Option != "a" || vote.Dots[1].Dots != 2 {
+		t.Errorf("unexpected normalized dots: %+v %+v", vote.Dots[0], vote.Dots[1])
+	}
+
+	if err := room.CastVote("host", "5"); err != ErrInvalidRoomMode {
+		t.Errorf("expected ErrInvalidRoomMode for card vote, got %v", err)
+	}
+}
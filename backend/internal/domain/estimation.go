@@ -2,6 +2,7 @@ package domain
 
 import (
 	"sort"
+	"time"
 )
 
 // Vote represents a participant's vote
@@ -10,15 +11,30 @@ type Vote struct {
 	ParticipantName string
 	Value           string
 	HasVoted        bool
+	Dots            []*DotAllocation // Dot placement (dot-voting rooms only)
+	IsProxy         bool             // Cast by the host on behalf of an absent participant
+	ProxyCastBy     string           // Name of the host who cast the proxy vote
+}
+
+// maxRoundHistory bounds the number of revealed rounds kept per room
+const maxRoundHistory = 100
+
+// RoundResult is a revealed round kept in the room's history
+type RoundResult struct {
+	RevealedAt     time.Time
+	SourceRoomID   string
+	SourceRoomName string
+	Summary        *VoteSummary
 }
 
 // VoteSummary shows statistics after reveal
 type VoteSummary struct {
 	Votes          []*Vote
-	Average        string  // Rounded to nearest card value
-	Mode           string  // Most common vote
-	HasConsensus   bool    // All votes are the same
-	NumericAverage float64 // Raw numeric average (for display)
+	Average        string        // Rounded to nearest card value
+	Mode           string        // Most common vote
+	HasConsensus   bool          // All votes are the same
+	NumericAverage float64       // Raw numeric average (for display)
+	Ranking        []*DotRanking // Ranked options (dot-voting rooms only)
 }
 
 // CastVote records a participant's vote in the room
@@ -35,6 +51,10 @@ func (r *Room) CastVote(participantID, value string) error {
 		return ErrSpectatorCannotVote
 	}
 
+	if r.Mode != RoomModeEstimation {
+		return ErrInvalidRoomMode
+	}
+
 	if r.State != RoomStateVoting {
 		return ErrInvalidState
 	}
@@ -63,6 +83,20 @@ func (r *Room) HasVoted(participantID string) bool {
 	return exists && vote.HasVoted
 }
 
+// GetOwnVote returns a copy of a participant's vote in the current round, value
+// included, or nil if they have not voted. Meant for showing voters their own card.
+func (r *Room) GetOwnVote(participantID string) *Vote {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	vote, exists := r.Votes[participantID]
+	if !exists || !vote.HasVoted {
+		return nil
+	}
+	own := *vote
+	return &own
+}
+
 // GetVoteStatus returns which participants have voted (without revealing values)
 // Spectators are excluded from the vote status list
 func (r *Room) GetVoteStatus() []*Vote {
@@ -75,13 +109,14 @@ func (r *Room) GetVoteStatus() []*Vote {
 		if p.IsSpectator {
 			continue
 		}
-		_, hasVoted := r.Votes[p.ID]
+		vote, hasVoted := r.Votes[p.ID]
 		if hasVoted {
 			// Only include that they voted, not the value
 			status = append(status, &Vote{
 				ParticipantID:   p.ID,
 				ParticipantName: p.Name,
 				HasVoted:        true,
+				IsProxy:         vote.IsProxy,
 			})
 		} else {
 			status = append(status, &Vote{
@@ -103,33 +138,38 @@ func (r *Room) RevealVotes() (*VoteSummary, error) {
 		return nil, ErrInvalidState
 	}
 
+	snapshot := r.pushSnapshot(TransitionReveal)
 	r.State = RoomStateRevealed
-
-	votes := make([]*Vote, 0, len(r.Votes))
-	for _, v := range r.Votes {
-		votes = append(votes, v)
+	r.Deadline = time.Time{}
+
+	summary := r.buildSummary()
+	snapshot.round = &RoundResult{
+		RevealedAt:     time.Now(),
+		SourceRoomID:   r.ID,
+		SourceRoomName: r.Name,
+		Summary:        summary,
 	}
+	r.appendHistory(snapshot.round)
 
-	// Calculate statistics using the room's card config
-	numericAvg, hasNumeric := CalculateNumericAverage(r.CardConfig, votes)
+	return summary, nil
+}
 
-	var avgValue string
-	if hasNumeric {
-		nearestCard := FindNearestCard(r.CardConfig, numericAvg)
-		if nearestCard != nil {
-			avgValue = nearestCard.Value
-		}
+// appendHistory records a round, dropping the oldest beyond the limit (caller must hold the lock)
+func (r *Room) appendHistory(results ...*RoundResult) {
+	r.History = append(r.History, results...)
+	if len(r.History) > maxRoundHistory {
+		r.History = r.History[len(r.History)-maxRoundHistory:]
 	}
+}
 
-	summary := &VoteSummary{
-		Votes:          votes,
-		Average:        avgValue,
-		Mode:           CalculateModeValue(votes),
-		HasConsensus:   CheckConsensus(votes),
-		NumericAverage: numericAvg,
-	}
+// GetHistory returns a copy of the room's revealed rounds, oldest first
+func (r *Room) GetHistory() []*RoundResult {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 
-	return summary, nil
+	history := make([]*RoundResult, len(r.History))
+	copy(history, r.History)
+	return history
 }
 
 // GetVoteSummary returns the vote summary (only after reveal)
@@ -141,11 +181,24 @@ func (r *Room) GetVoteSummary() (*VoteSummary, error) {
 		return nil, ErrInvalidState
 	}
 
+	return r.buildSummary(), nil
+}
+
+// buildSummary calculates statistics for the current votes (caller must hold the lock)
+func (r *Room) buildSummary() *VoteSummary {
 	votes := make([]*Vote, 0, len(r.Votes))
 	for _, v := range r.Votes {
 		votes = append(votes, v)
 	}
 
+	if r.Mode == RoomModeDotVoting {
+		return &VoteSummary{
+			Votes:   votes,
+			Ranking: RankDotOptions(r.DotConfig, votes),
+		}
+	}
+
+	// Calculate statistics using the room's card config
 	numericAvg, hasNumeric := CalculateNumericAverage(r.CardConfig, votes)
 
 	var avgValue string
@@ -162,7 +215,7 @@ func (r *Room) GetVoteSummary() (*VoteSummary, error) {
 		Mode:           CalculateModeValue(votes),
 		HasConsensus:   CheckConsensus(votes),
 		NumericAverage: numericAvg,
-	}, nil
+	}
 }
 
 // ResetRound clears all votes and starts a new voting round
@@ -170,8 +223,10 @@ func (r *Room) ResetRound() {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	r.pushSnapshot(TransitionReset)
 	r.Votes = make(map[string]*Vote)
 	r.State = RoomStateVoting
+	r.Deadline = time.Time{}
 }
 
 // StartVoting transitions the room to voting state
@@ -179,6 +234,7 @@ func (r *Room) StartVoting() {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	r.pushSnapshot(TransitionStart)
 	r.State = RoomStateVoting
 }
 
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Errors
+var (
+	ErrInvalidTimeout = errors.New("inactivity timeout is out of range")
+)
+
+// Bounds for a room's custom inactivity timeout
+const (
+	MinInactivityTimeout = 5 * time.Minute
+	MaxInactivityTimeout = 24 * time.Hour
+)
+
+// ValidateInactivityTimeout checks that a custom inactivity timeout is within bounds
+func ValidateInactivityTimeout(timeout time.Duration) error {
+	if timeout < MinInactivityTimeout || timeout > MaxInactivityTimeout {
+		return ErrInvalidTimeout
+	}
+	return nil
+}
+
+// SetInactivityTimeout overrides the deployment's inactivity timeout for this room
+func (r *Room) SetInactivityTimeout(timeout time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.InactivityTimeout = timeout
+}
+
+// GetInactivityTimeout returns the room's custom inactivity timeout (zero if none)
+func (r *Room) GetInactivityTimeout() time.Duration {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.InactivityTimeout
+}
+
+// ShouldWarnExpiry reports whether the room expires within the warning period
+// and has not been warned since its last activity. The room is marked as warned.
+func (r *Room) ShouldWarnExpiry(timeout, warning time.Duration) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.expiryWarned {
+		return false
+	}
+
+	if time.Until(r.LastActivityAt.Add(timeout)) > warning {
+		return false
+	}
+
+	r.expiryWarned = true
+	return true
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidateInactivityTimeout(t *testing.T) {
+	tests := []struct {
+		timeout time.Duration
+		wantErr bool
+	}{
+		{0, true},
+		{MinInactivityTimeout - time.Second, true},
+		{MinInactivityTimeout, false},
+		{time.Hour, false},
+		{MaxInactivityTimeout, false},
+		{MaxInactivityTimeout + time.Second, true},
+	}
+
+	for _, tt := range tests {
+		if err := ValidateInactivityTimeout(tt.timeout); (err == ErrInvalidTimeout) != tt.wantErr {
+			t.Errorf("ValidateInactivityTimeout(%v) = %v, want error %v", tt.timeout, err, tt.wantErr)
+		}
+	}
+}
+
+func TestShouldWarnExpiry(t *testing.T) {
+	tests := []struct {
+		name     string
+		idle     time.Duration // Time since the last activity
+		warned   bool          // Already warned since the last activity
+		wantWarn bool
+	}{
+		{"well before expiry", 5 * time.Minute, false, false},
+		{"inside the warning period", 14 * time.Minute, false, true},
+		{"already expired", 20 * time.Minute, false, true},
+		{"already warned", 14 * time.Minute, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			room := NewRoom("r1", "room", &Participant{ID: "host"}, nil)
+			room.LastActivityAt = time.Now().Add(-tt.idle)
+			room.expiryWarned = tt.warned
+
+			if got := room.ShouldWarnExpiry(15*time.Minute, 2*time.Minute); got != tt.wantWarn {
+				t.Errorf("ShouldWarnExpiry() = %v, want %v", got, tt.wantWarn)
+			}
+		})
+	}
+}
+
+func TestShouldWarnExpiry_OncePerActivity(t *testing.T) {
+	room := NewRoom("r1", "room", &Participant{ID: "host"}, nil)
+	room.LastActivityAt = time.Now().Add(-14 * time.Minute)
+
+	if !room.ShouldWarnExpiry(15*time.Minute, 2*time.Minute) {
+		t.Fatal("expected a warning inside the warning period")
+	}
+	if room.ShouldWarnExpiry(15*time.Minute, 2*time.Minute) {
+		t.Error("expected only one warning")
+	}
+
+	// New activity resets the warning
+	room.TouchActivity()
+	room.LastActivityAt = time.Now().Add(-14 * time.Minute)
+	if !room.ShouldWarnExpiry(15*time.Minute, 2*time.Minute) {
+		t.Error("expected another warning after new activity")
+	}
+}
@@ -0,0 +1,62 @@
+package domain
+
+import "errors"
+
+// Participant limit errors
+var (
+	ErrVoterLimitReached     = errors.New("this room has reached its maximum number of voters")
+	ErrSpectatorLimitReached = errors.New("this room has reached its maximum number of spectators; you can still watch without joining")
+	ErrInvalidLimit          = errors.New("participant limits cannot be negative")
+)
+
+// SetParticipantLimits caps how many voters and spectators the room takes
+// (owner action). Zero means no limit. Lowering a limit below the current
+// count does not remove anyone; it only turns newcomers away.
+func (r *Room) SetParticipantLimits(ownerID string, maxVoters, maxSpectators int) error {
+	if maxVoters < 0 || maxSpectators < 0 {
+		return ErrInvalidLimit
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.authorize(ownerID, ActionManageRoom); err != nil {
+		return err
+	}
+
+	r.MaxVoters = maxVoters
+	r.MaxSpectators = maxSpectators
+	return nil
+}
+
+// GetParticipantLimits returns the room's voter and spectator limits (zero if unlimited)
+func (r *Room) GetParticipantLimits() (maxVoters, maxSpectators int) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.MaxVoters, r.MaxSpectators
+}
+
+// checkCapacity returns an error if the room has no room left for another
+// voter or spectator (caller must hold the lock). Only connected participants
+// count: placeholders and members who left do not hold a place, though a
+// member who returns gets back in even if the room has filled up meanwhile.
+func (r *Room) checkCapacity(spectator bool) error {
+	limit, err := r.MaxVoters, ErrVoterLimitReached
+	if spectator {
+		limit, err = r.MaxSpectators, ErrSpectatorLimitReached
+	}
+	if limit == 0 {
+		return nil
+	}
+
+	count := 0
+	for _, p := range r.Participants {
+		if p.IsConnected && !p.IsPlaceholder && p.IsSpectator == spectator {
+			count++
+		}
+	}
+	if count >= limit {
+		return err
+	}
+	return nil
+}
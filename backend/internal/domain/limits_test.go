@@ -0,0 +1,55 @@
+package domain
+
+import "testing"
+
+func TestParticipantLimits(t *testing.T) {
+	room := NewRoom("r1", "room", &Participant{ID: "host", SessionToken: "host-token", IsConnected: true}, nil)
+
+	if err := room.SetParticipantLimits("host", -1, 0); err != ErrInvalidLimit {
+		t.Errorf("expected ErrInvalidLimit, got %v", err)
+	}
+	if err := room.SetParticipantLimits("host", 2, 1); err != nil {
+		t.Fatalf("failed to set limits: %v", err)
+	}
+
+	if err := room.AddParticipant(&Participant{ID: "v1", IsConnected: true}); err != nil {
+		t.Fatalf("failed to add voter: %v", err)
+	}
+	if err := room.AddParticipant(&Participant{ID: "v2", IsConnected: true}); err != ErrVoterLimitReached {
+		t.Errorf("expected ErrVoterLimitReached, got %v", err)
+	}
+	if err := room.AddParticipant(&Participant{ID: "s1", IsSpectator: true, IsConnected: true}); err != nil {
+		t.Fatalf("failed to add spectator: %v", err)
+	}
+	if err := room.AddParticipant(&Participant{ID: "s2", IsSpectator: true, IsConnected: true}); err != ErrSpectatorLimitReached {
+		t.Errorf("expected ErrSpectatorLimitReached, got %v", err)
+	}
+
+	// Switching sides counts against the other limit
+	if _, err := room.SetSpectator("s1", "s1", false); err != ErrVoterLimitReached {
+		t.Errorf("expected ErrVoterLimitReached when a spectator starts voting, got %v", err)
+	}
+	if _, err := room.SetSpectator("v1", "v1", true); err != ErrSpectatorLimitReached {
+		t.Errorf("expected ErrSpectatorLimitReached when a voter starts spectating, got %v", err)
+	}
+
+	// Placeholders do not take a voter's place
+	if _, _, err := room.ResolveProxyTarget("host", "", "Absent"); err != nil {
+		t.Fatalf("failed to add placeholder: %v", err)
+	}
+	if err := room.SetParticipantLimits("host", 3, 1); err != nil {
+		t.Fatalf("failed to raise limits: %v", err)
+	}
+	if err := room.AddParticipant(&Participant{ID: "v2", IsConnected: true}); err != nil {
+		t.Errorf("expected room for a third voter, got %v", err)
+	}
+
+	// Members who left do not hold on to their place
+	if err := room.AddParticipant(&Participant{ID: "v3", IsConnected: true}); err != ErrVoterLimitReached {
+		t.Errorf("expected ErrVoterLimitReached with every voter connected, got %v", err)
+	}
+	_, _ = room.DisconnectParticipant("v1")
+	if err := room.AddParticipant(&Participant{ID: "v3", IsConnected: true}); err != nil {
+		t.Errorf("expected a disconnected voter's place taken, got %v", err)
+	}
+}
@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"time"
+	"unicode/utf8"
+)
+
+// Passcode errors
+var (
+	ErrPasscodeRequired = errors.New("this room requires a passcode")
+	ErrWrongPasscode    = errors.New("incorrect passcode")
+	ErrInvalidPasscode  = errors.New("passcode must be 4-64 characters")
+	ErrTooManyAttempts  = errors.New("too many failed passcode attempts, try again later")
+)
+
+// Passcode limits
+const (
+	MinPasscodeLength = 4
+	MaxPasscodeLength = 64
+
+	// MaxPasscodeFailures failed attempts by one client within
+	// PasscodeFailureWindow lock that client out until the oldest of them
+	// leaves the window
+	MaxPasscodeFailures   = 5
+	PasscodeFailureWindow = time.Minute
+)
+
+// Passcode hashing parameters
+const (
+	passcodeIterations = 100_000
+	passcodeKeyLength  = 32
+	passcodeSaltLength = 16
+)
+
+// passcodeAttempts are one client's recent passcode attempts
+type passcodeAttempts struct {
+	failures []time.Time // Failed attempts, oldest first
+	checks   int         // Attempts being checked, which hold a failure slot until they succeed
+}
+
+// SetPasscode sets the passcode newcomers need to join or watch the room, or
+// removes it when empty (owner action). Only a salted hash is kept.
+func (r *Room) SetPasscode(ownerID, passcode string) error {
+	var salt, hash []byte
+	if passcode != "" {
+		if n := utf8.RuneCountInString(passcode); n < MinPasscodeLength || n > MaxPasscodeLength {
+			return ErrInvalidPasscode
+		}
+		salt = make([]byte, passcodeSaltLength)
+		if _, err := rand.Read(salt); err != nil {
+			return err
+		}
+		var err error
+		if hash, err = hashPasscode(passcode, salt); err != nil {
+			return err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.authorize(ownerID, ActionManageRoom); err != nil {
+		return err
+	}
+
+	r.passcodeSalt = salt
+	r.passcodeHash = hash
+	r.passcodeAttempts = nil
+	return nil
+}
+
+// HasPasscode returns whether newcomers need a passcode
+func (r *Room) HasPasscode() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.passcodeHash != nil
+}
+
+// CheckPasscode verifies a newcomer's passcode. Rooms without a passcode
+// accept any. Failed attempts are limited per client, keyed by session token
+// (clients without one share a limit). An attempt holds one of the client's
+// failure slots while it is checked and gives it back if it succeeds, so
+// parallel guesses cannot get past the limit and correct passcodes never count.
+func (r *Room) CheckPasscode(sessionToken, passcode string, now time.Time) error {
+	r.mu.Lock()
+	salt, want := r.passcodeSalt, r.passcodeHash
+	if want == nil {
+		r.mu.Unlock()
+		return nil
+	}
+	r.pruneAttempts(now)
+	attempts, exists := r.passcodeAttempts[sessionToken]
+	if !exists {
+		attempts = &passcodeAttempts{}
+	}
+	if len(attempts.failures)+attempts.checks >= MaxPasscodeFailures {
+		r.mu.Unlock()
+		return ErrTooManyAttempts
+	}
+	if passcode == "" {
+		r.mu.Unlock()
+		return ErrPasscodeRequired
+	}
+	if r.passcodeAttempts == nil {
+		r.passcodeAttempts = make(map[string]*passcodeAttempts)
+	}
+	r.passcodeAttempts[sessionToken] = attempts
+	attempts.checks++
+	r.mu.Unlock()
+
+	// Hashing is slow on purpose, so it runs without holding the lock
+	got, err := hashPasscode(passcode, salt)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	attempts.checks--
+
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare(got, want) == 1 {
+		return nil
+	}
+	attempts.failures = append(attempts.failures, now)
+	return ErrWrongPasscode
+}
+
+// pruneAttempts forgets failed attempts older than the window, and clients
+// left with no attempts (caller must hold the lock)
+func (r *Room) pruneAttempts(now time.Time) {
+	cutoff := now.Add(-PasscodeFailureWindow)
+	for client, attempts := range r.passcodeAttempts {
+		kept := attempts.failures[:0]
+		for _, at := range attempts.failures {
+			if at.After(cutoff) {
+				kept = append(kept, at)
+			}
+		}
+		attempts.failures = kept
+
+		if len(kept) == 0 && attempts.checks == 0 {
+			delete(r.passcodeAttempts, client)
+		}
+	}
+}
+
+// hashPasscode derives the stored hash of a passcode
+func hashPasscode(passcode string, salt []byte) ([]byte, error) {
+	return pbkdf2.Key(sha256.New, passcode, salt, passcodeIterations, passcodeKeyLength)
+}
@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPasscode(t *testing.T) {
+	room := NewRoom("r1", "room", &Participant{ID: "host"}, nil)
+	now := time.Now()
+
+	if err := room.SetPasscode("host", "abc"); err != ErrInvalidPasscode {
+		t.Errorf("expected ErrInvalidPasscode for a short passcode, got %v", err)
+	}
+	if err := room.SetPasscode("host", "open-sesame"); err != nil {
+		t.Fatalf("failed to set passcode: %v", err)
+	}
+
+	if err := room.CheckPasscode("guest", "", now); err != ErrPasscodeRequired {
+		t.Errorf("expected ErrPasscodeRequired, got %v", err)
+	}
+	if err := room.CheckPasscode("guest", "open-sesame", now); err != nil {
+		t.Errorf("expected passcode accepted, got %v", err)
+	}
+
+	for range MaxPasscodeFailures {
+		if err := room.CheckPasscode("guest", "guess", now); err != ErrWrongPasscode {
+			t.Fatalf("expected ErrWrongPasscode, got %v", err)
+		}
+	}
+	if err := room.CheckPasscode("guest", "open-sesame", now); err != ErrTooManyAttempts {
+		t.Errorf("expected ErrTooManyAttempts after repeated failures, got %v", err)
+	}
+	if err := room.CheckPasscode("other", "open-sesame", now); err != nil {
+		t.Errorf("expected other clients unaffected by the lockout, got %v", err)
+	}
+	if err := room.CheckPasscode("guest", "open-sesame", now.Add(PasscodeFailureWindow)); err != nil {
+		t.Errorf("expected passcode accepted once the window passed, got %v", err)
+	}
+
+	if err := room.SetPasscode("host", ""); err != nil {
+		t.Fatalf("failed to remove passcode: %v", err)
+	}
+	if err := room.CheckPasscode("guest", "", now); err != nil {
+		t.Errorf("expected no passcode needed, got %v", err)
+	}
+}
+
+func TestPasscode_ConcurrentGuessesAreLimited(t *testing.T) {
+	room := NewRoom("r1", "room", &Participant{ID: "host"}, nil)
+	if err := room.SetPasscode("host", "open-sesame"); err != nil {
+		t.Fatalf("failed to set passcode: %v", err)
+	}
+	now := time.Now()
+
+	// Every guess starts before any has failed
+	const guesses = 4 * MaxPasscodeFailures
+	var wg sync.WaitGroup
+	results := make(chan error, guesses)
+	for range guesses {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results <- room.CheckPasscode("guest", "guess", now)
+		}()
+	}
+	wg.Wait()
+	close(results)
+
+	checked := 0
+	for err := range results {
+		switch err {
+		case ErrWrongPasscode:
+			checked++
+		case ErrTooManyAttempts:
+		default:
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	if checked > MaxPasscodeFailures {
+		t.Errorf("expected at most %d guesses checked, got %d", MaxPasscodeFailures, checked)
+	}
+	if err := room.CheckPasscode("guest", "open-sesame", now); err != ErrTooManyAttempts {
+		t.Errorf("expected the client locked out after the failures, got %v", err)
+	}
+}
+
+func TestPasscode_ConcurrentCorrectAttemptsPass(t *testing.T) {
+	room := NewRoom("r1", "room", &Participant{ID: "host"}, nil)
+	if err := room.SetPasscode("host", "open-sesame"); err != nil {
+		t.Fatalf("failed to set passcode: %v", err)
+	}
+	now := time.Now()
+
+	// Newcomers entering the passcode at the same moment do not lock each other out
+	const newcomers = 4 * MaxPasscodeFailures
+	var wg sync.WaitGroup
+	results := make(chan error, newcomers)
+	for i := range newcomers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results <- room.CheckPasscode(fmt.Sprintf("guest-%d", i), "open-sesame", now)
+		}()
+	}
+	wg.Wait()
+	close(results)
+
+	for err := range results {
+		if err != nil {
+			t.Errorf("expected every newcomer accepted, got %v", err)
+		}
+	}
+	if err := room.CheckPasscode("guest-0", "open-sesame", now); err != nil {
+		t.Errorf("expected correct attempts not counted as failures, got %v", err)
+	}
+}
@@ -0,0 +1,230 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Errors
+var (
+	ErrInvalidSlug   = errors.New("slug must be 3-48 lowercase letters, digits or single hyphens")
+	ErrRoomNameTaken = errors.New("room name is already taken")
+)
+
+// Slug length limits
+const (
+	MinSlugLength = 3
+	MaxSlugLength = 48
+)
+
+// slugPattern allows lowercase words separated by single hyphens, e.g. "platform-team"
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// NormalizeSlug lowercases and trims a custom room name, then validates it
+func NormalizeSlug(slug string) (string, error) {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+
+	if len(slug) < MinSlugLength || len(slug) > MaxSlugLength {
+		return "", ErrInvalidSlug
+	}
+	if !slugPattern.MatchString(slug) {
+		return "", ErrInvalidSlug
+	}
+
+	return slug, nil
+}
+
+// MakePersistent exempts the room from inactivity cleanup and from being
+// closed when everyone leaves
+func (r *Room) MakePersistent() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Persistent = true
+}
+
+// IsPersistent returns true if the room is a long-lived team room
+func (r *Room) IsPersistent() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.Persistent
+}
+
+// DisconnectAll marks every connected participant as disconnected while keeping
+// them as members. Returns the IDs of the participants that were connected.
+func (r *Room) DisconnectAll() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	var disconnected []string
+	for _, p := range r.Participants {
+		if p.IsConnected {
+			p.IsConnected = false
+			p.DisconnectedAt = now
+			disconnected = append(disconnected, p.ID)
+		}
+	}
+	return disconnected
+}
+
+// RoomRecord is the durable state of a persistent room, kept so the room
+// survives restarts. Connections, the waiting room, breakouts and the undo
+// history are not kept.
+type RoomRecord struct {
+	ID                    string
+	Name                  string
+	CreatedAt             time.Time
+	LastActivityAt        time.Time
+	Participants          []*Participant // Members, stored disconnected
+	State                 RoomState
+	Votes                 []*Vote
+	CardConfig            *CardConfig
+	Mode                  RoomMode
+	DotConfig             *DotVoteConfig
+	History               []*RoundResult
+	Async                 bool
+	Deadline              time.Time
+	InactivityTimeout     time.Duration
+	HostSuccession        HostSuccession
+	DeputyID              string
+	RotateFacilitator     bool
+	RotatingFacilitatorID string
+	KeepDisconnected      bool
+	Visibility            Visibility
+	Invites               []*Invite
+	PasscodeSalt          []byte
+	PasscodeHash          []byte
+	Locked                bool
+	WaitingRoom           bool
+	Bans                  []*Ban
+	MaxVoters             int
+	MaxSpectators         int
+	ViewToken             string
+}
+
+// Record captures the durable state of the room. The record shares nothing
+// with the room, so it can be stored while the room keeps changing.
+func (r *Room) Record() *RoomRecord {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	record := &RoomRecord{
+		ID:                    r.ID,
+		Name:                  r.Name,
+		CreatedAt:             r.CreatedAt,
+		LastActivityAt:        r.LastActivityAt,
+		State:                 r.State,
+		CardConfig:            r.CardConfig,
+		Mode:                  r.Mode,
+		DotConfig:             r.DotConfig,
+		History:               make([]*RoundResult, len(r.History)),
+		Async:                 r.Async,
+		Deadline:              r.Deadline,
+		InactivityTimeout:     r.InactivityTimeout,
+		HostSuccession:        r.HostSuccession,
+		DeputyID:              r.DeputyID,
+		RotateFacilitator:     r.RotateFacilitator,
+		RotatingFacilitatorID: r.rotatingFacilitatorID,
+		KeepDisconnected:      r.KeepDisconnected,
+		Visibility:            r.Visibility,
+		PasscodeSalt:          r.passcodeSalt,
+		PasscodeHash:          r.passcodeHash,
+		Locked:                r.Locked,
+		WaitingRoom:           r.WaitingRoom,
+		MaxVoters:             r.MaxVoters,
+		MaxSpectators:         r.MaxSpectators,
+		ViewToken:             r.viewToken,
+	}
+	// Decks, configs and revealed rounds are replaced rather than changed, so they can be shared
+	copy(record.History, r.History)
+
+	for _, p := range r.Participants {
+		member := *p
+		member.BreakoutID = ""
+		record.Participants = append(record.Participants, &member)
+	}
+	sort.Slice(record.Participants, func(i, j int) bool {
+		return record.Participants[i].JoinedAt.Before(record.Participants[j].JoinedAt)
+	})
+	for _, v := range r.Votes {
+		vote := *v
+		record.Votes = append(record.Votes, &vote)
+	}
+	for _, invite := range r.Invites {
+		copied := *invite
+		record.Invites = append(record.Invites, &copied)
+	}
+	for _, ban := range r.Bans {
+		copied := *ban
+		record.Bans = append(record.Bans, &copied)
+	}
+
+	return record
+}
+
+// RestoreRoom rebuilds a persistent room from its record. Members come back
+// disconnected and reconnect with their session tokens.
+func RestoreRoom(record *RoomRecord) *Room {
+	now := time.Now()
+	room := &Room{
+		ID:                    record.ID,
+		Name:                  record.Name,
+		Participants:          make(map[string]*Participant, len(record.Participants)),
+		State:                 record.State,
+		CreatedAt:             record.CreatedAt,
+		LastActivityAt:        record.LastActivityAt,
+		Votes:                 make(map[string]*Vote, len(record.Votes)),
+		CardConfig:            record.CardConfig,
+		Mode:                  record.Mode,
+		DotConfig:             record.DotConfig,
+		History:               record.History,
+		Async:                 record.Async,
+		Deadline:              record.Deadline,
+		Persistent:            true,
+		InactivityTimeout:     record.InactivityTimeout,
+		HostSuccession:        record.HostSuccession,
+		DeputyID:              record.DeputyID,
+		RotateFacilitator:     record.RotateFacilitator,
+		rotatingFacilitatorID: record.RotatingFacilitatorID,
+		KeepDisconnected:      record.KeepDisconnected,
+		Visibility:            record.Visibility,
+		passcodeSalt:          record.PasscodeSalt,
+		passcodeHash:          record.PasscodeHash,
+		Locked:                record.Locked,
+		WaitingRoom:           record.WaitingRoom,
+		MaxVoters:             record.MaxVoters,
+		MaxSpectators:         record.MaxSpectators,
+		viewToken:             record.ViewToken,
+	}
+	if room.CardConfig == nil {
+		room.CardConfig = DefaultCardConfig()
+	}
+
+	for _, p := range record.Participants {
+		if p.IsConnected {
+			p.IsConnected = false
+			p.DisconnectedAt = now
+		}
+		room.Participants[p.ID] = p
+	}
+	for _, v := range record.Votes {
+		room.Votes[v.ParticipantID] = v
+	}
+	if len(record.Invites) > 0 {
+		room.Invites = make(map[string]*Invite, len(record.Invites))
+		for _, invite := range record.Invites {
+			room.Invites[invite.ID] = invite
+		}
+	}
+	if len(record.Bans) > 0 {
+		room.Bans = make(map[string]*Ban, len(record.Bans))
+		for _, ban := range record.Bans {
+			room.Bans[ban.ID] = ban
+		}
+	}
+
+	return room
+}
@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestNormalizeSlug(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		error    error
+	}{
+		{"platform-team", "platform-team", nil},
+		{"  Platform-Team ", "platform-team", nil},
+		{"team42", "team42", nil},
+		{"ab", "", ErrInvalidSlug},
+		{"-platform", "", ErrInvalidSlug},
+		{"platform--team", "", ErrInvalidSlug},
+		{"platform_team", "", ErrInvalidSlug},
+		{"platform team", "", ErrInvalidSlug},
+	}
+
+	for _, tt := range tests {
+		slug, err := NormalizeSlug(tt.input)
+		if slug != tt.expected || err != tt.error {
+			t.Errorf("NormalizeSlug(%q) = %q, %v; expected %q, %v", tt.input, slug, err, tt.expected, tt.error)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Profile errors
+var (
+	ErrInvalidName       = errors.New("name must be 1-50 characters without control characters")
+	ErrInvalidAvatarSeed = errors.New("avatar seed must be at most 64 characters")
+	ErrInvalidColor      = errors.New("color must be a hex color like #1a2b3c")
+)
+
+// Profile length limits
+const (
+	MaxParticipantNameLength = 50
+	MaxAvatarSeedLength      = 64
+)
+
+// colorPattern allows six-digit hex colors, e.g. "#1a2b3c"
+var colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// ParticipantProfile holds the fields a participant may change about
+// themselves. Empty fields are left unchanged.
+type ParticipantProfile struct {
+	Name       string
+	AvatarSeed string
+	Color      string
+}
+
+// UpdateParticipant changes a participant's profile. A new name is also shown
+// on any vote they have already cast.
+func (r *Room) UpdateParticipant(participantID string, profile ParticipantProfile) (*Participant, error) {
+	if utf8.RuneCountInString(profile.AvatarSeed) > MaxAvatarSeedLength {
+		return nil, ErrInvalidAvatarSeed
+	}
+	if profile.Color != "" && !colorPattern.MatchString(profile.Color) {
+		return nil, ErrInvalidColor
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, exists := r.Participants[participantID]
+	if !exists {
+		return nil, ErrParticipantNotFound
+	}
+
+	// Names from before validation existed stay valid until they change
+	name := strings.TrimSpace(profile.Name)
+	if profile.Name != "" && name != p.Name {
+		if name == "" || utf8.RuneCountInString(name) > MaxParticipantNameLength || controlCharRegex.MatchString(name) {
+			return nil, ErrInvalidName
+		}
+		p.Name = name
+		if vote, voted := r.Votes[participantID]; voted {
+			vote.ParticipantName = name
+		}
+	}
+	if profile.AvatarSeed != "" {
+		p.AvatarSeed = profile.AvatarSeed
+	}
+	if profile.Color != "" {
+		p.Color = strings.ToLower(profile.Color)
+	}
+
+	return p, nil
+}
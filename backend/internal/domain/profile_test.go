@@ -0,0 +1,29 @@
+package domain
+
+import "testing"
+
+func TestUpdateParticipant(t *testing.T) {
+	room := NewRoom("r1", "room", &Participant{ID: "host", Name: "Alice"}, nil)
+	room.SetState(RoomStateVoting)
+	if err := room.CastVote("host", "5"); err != nil {
+		t.Fatalf("failed to vote: %v", err)
+	}
+
+	p, err := room.UpdateParticipant("host", ParticipantProfile{Name: " Alicia ", Color: "#1A2B3C"})
+	if err != nil {
+		t.Fatalf("failed to update: %v", err)
+	}
+	if p.Name != "Alicia" || p.Color != "#1a2b3c" {
+		t.Errorf("unexpected profile %q %q", p.Name, p.Color)
+	}
+	if room.Votes["host"].ParticipantName != "Alicia" {
+		t.Errorf("expected vote to show the new name, got %q", room.Votes["host"].ParticipantName)
+	}
+
+	if _, err := room.UpdateParticipant("host", ParticipantProfile{Name: "   "}); err != ErrInvalidName {
+		t.Errorf("expected ErrInvalidName, got %v", err)
+	}
+	if _, err := room.UpdateParticipant("host", ParticipantProfile{Color: "red"}); err != ErrInvalidColor {
+		t.Errorf("expected ErrInvalidColor, got %v", err)
+	}
+}
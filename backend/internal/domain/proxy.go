@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Errors
+var (
+	ErrParticipantPresent = errors.New("participant is connected and can vote themselves")
+	ErrProxyTargetMissing = errors.New("a target participant or name is required")
+)
+
+// ResolveProxyTarget finds the absent participant a proxy vote is for (host or facilitator action).
+// When no participant ID is given, a disconnected participant with a matching name
+// is used, or a placeholder participant is added. Returns the target and whether
+// it was newly created.
+func (r *Room) ResolveProxyTarget(hostID, targetID, targetName string) (target *Participant, created bool, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.authorize(hostID, ActionManageRound); err != nil {
+		return nil, false, err
+	}
+
+	if targetID != "" {
+		target, exists := r.Participants[targetID]
+		if !exists {
+			return nil, false, ErrParticipantNotFound
+		}
+		return target, false, nil
+	}
+
+	targetName = strings.TrimSpace(targetName)
+	if targetName == "" {
+		return nil, false, ErrProxyTargetMissing
+	}
+
+	for _, p := range r.Participants {
+		if !p.IsConnected && !p.IsSpectator && strings.EqualFold(p.Name, targetName) {
+			return p, false, nil
+		}
+	}
+
+	// The placeholder's session token is never handed out, so nobody can act as it
+	target = &Participant{
+		ID:            GenerateID(),
+		Name:          targetName,
+		SessionToken:  GenerateSessionToken(),
+		IsPlaceholder: true,
+		JoinedAt:      time.Now(),
+	}
+	r.Participants[target.ID] = target
+
+	return target, true, nil
+}
+
+// CastProxyVote records a vote on behalf of an absent participant (host or facilitator action)
+func (r *Room) CastProxyVote(hostID, targetID, value string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	host, err := r.authorize(hostID, ActionManageRound)
+	if err != nil {
+		return err
+	}
+
+	target, exists := r.Participants[targetID]
+	if !exists {
+		return ErrParticipantNotFound
+	}
+	if target.IsConnected {
+		return ErrParticipantPresent
+	}
+	if target.IsSpectator {
+		return ErrSpectatorCannotVote
+	}
+
+	if r.Mode != RoomModeEstimation {
+		return ErrInvalidRoomMode
+	}
+
+	if r.State != RoomStateVoting {
+		return ErrInvalidState
+	}
+
+	if err := ValidateCardValue(r.CardConfig, value); err != nil {
+		return err
+	}
+
+	r.Votes[targetID] = &Vote{
+		ParticipantID:   targetID,
+		ParticipantName: target.Name,
+		Value:           value,
+		HasVoted:        true,
+		IsProxy:         true,
+		ProxyCastBy:     host.Name,
+	}
+
+	return nil
+}
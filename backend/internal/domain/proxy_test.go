@@ -0,0 +1,58 @@
+package domain
+
+import "testing"
+
+func TestCastProxyVote(t *testing.T) {
+	room := NewRoom("r1", "brave-nebula", &Participant{ID: "host", Name: "Host", SessionToken: "host-token", IsConnected: true}, nil)
+	_ = room.AddParticipant(&Participant{ID: "voter", Name: "Pat", SessionToken: "voter-token", IsConnected: true})
+	room.StartVoting()
+
+	if _, _, err := room.ResolveProxyTarget("voter", "", "Sam"); err != ErrNotHost {
+		t.Errorf("expected ErrNotHost for a voter, got %v", err)
+	}
+	if _, _, err := room.ResolveProxyTarget("host", "", " "); err != ErrProxyTargetMissing {
+		t.Errorf("expected ErrProxyTargetMissing without a target, got %v", err)
+	}
+
+	target, created, err := room.ResolveProxyTarget("host", "", "Sam")
+	if err != nil || !created || !target.IsPlaceholder {
+		t.Fatalf("expected a placeholder for Sam, got %+v, %v, %v", target, created, err)
+	}
+	if again, created, _ := room.ResolveProxyTarget("host", "", "sam"); created || again.ID != target.ID {
+		t.Errorf("expected the placeholder reused by name, got %+v", again)
+	}
+
+	if err := room.CastProxyVote("host", "voter", "5"); err != ErrParticipantPresent {
+		t.Errorf("expected ErrParticipantPresent for a connected voter, got %v", err)
+	}
+	if err := room.CastProxyVote("host", target.ID, "8"); err != nil {
+		t.Fatalf("failed to cast proxy vote: %v", err)
+	}
+	var vote *Vote
+	for _, v := range room.GetVotes() {
+		if v.ParticipantID == target.ID {
+			vote = v
+		}
+	}
+	if vote == nil || !vote.IsProxy || vote.ProxyCastBy != "Host" || vote.Value != "8" {
+		t.Errorf("expected a proxy vote cast by the host, got %+v", vote)
+	}
+}
+
+func TestPlaceholder_CannotBeImpersonated(t *testing.T) {
+	room := NewRoom("r1", "brave-nebula", &Participant{ID: "host", SessionToken: "host-token", IsConnected: true}, nil)
+	target, _, err := room.ResolveProxyTarget("host", "", "Sam")
+	if err != nil {
+		t.Fatalf("failed to add placeholder: %v", err)
+	}
+
+	if target.SessionToken == "" {
+		t.Error("expected the placeholder to get a session token")
+	}
+	if err := room.ValidateToken(target.ID, ""); err != ErrInvalidToken {
+		t.Errorf("expected ErrInvalidToken for an empty token, got %v", err)
+	}
+	if _, err := room.GetParticipantByToken(""); err != ErrParticipantNotFound {
+		t.Errorf("expected no participant for an empty token, got %v", err)
+	}
+}
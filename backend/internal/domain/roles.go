@@ -0,0 +1,268 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+)
+
+// Role errors
+var (
+	ErrNotOwner           = errors.New("only the room owner can perform this action")
+	ErrInvalidRole        = errors.New("this role cannot be granted to the participant")
+	ErrHostCannotSpectate = errors.New("the host cannot become a spectator")
+)
+
+// Role is what a participant may do in a room
+type Role int
+
+// Role constants from least to most privileged.
+const (
+	RoleSpectator   Role = iota // Watches without voting
+	RoleVoter                   // Votes on stories
+	RoleFacilitator             // Runs rounds and manages participants alongside the owner
+	RoleOwner                   // The host; also manages roles and room settings
+)
+
+// Action is a group of operations guarded by the same permission
+type Action int
+
+// Action constants for permission checks.
+const (
+	ActionVote               Action = iota // Cast votes and dots
+	ActionManageRound                      // Start, reveal, reset and undo rounds; set deadlines; cast proxy votes
+	ActionManageParticipants               // Kick participants and move them into breakouts
+	ActionManageBreakouts                  // Create and close breakouts
+	ActionManageRoom                       // Grant roles, transfer ownership, change room settings
+)
+
+// Role returns the participant's role
+func (p *Participant) Role() Role {
+	switch {
+	case p.IsHost:
+		return RoleOwner
+	case p.IsSpectator:
+		return RoleSpectator
+	case p.IsFacilitator:
+		return RoleFacilitator
+	default:
+		return RoleVoter
+	}
+}
+
+// Can reports whether the role permits the action
+func (r Role) Can(action Action) bool {
+	switch r {
+	case RoleOwner:
+		return true
+	case RoleFacilitator:
+		return action != ActionManageRoom
+	case RoleVoter:
+		return action == ActionVote
+	default:
+		return false
+	}
+}
+
+// Authorize checks that a participant's role permits the action
+func (r *Room) Authorize(participantID string, action Action) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, err := r.authorize(participantID, action)
+	return err
+}
+
+// authorize returns the participant if their role permits the action (caller must hold the lock)
+func (r *Room) authorize(participantID string, action Action) (*Participant, error) {
+	p, exists := r.Participants[participantID]
+	if !exists {
+		return nil, ErrParticipantNotFound
+	}
+	if p.Role().Can(action) {
+		return p, nil
+	}
+
+	switch action {
+	case ActionVote:
+		return nil, ErrSpectatorCannotVote
+	case ActionManageRoom:
+		return nil, ErrNotOwner
+	default:
+		return nil, ErrNotHost
+	}
+}
+
+// SetRole grants a participant the facilitator, voter or spectator role (owner
+// action) and reports whether their vote in the open round was dropped.
+// Ownership changes through TransferOwnership instead.
+func (r *Room) SetRole(ownerID, targetID string, role Role) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.authorize(ownerID, ActionManageRoom); err != nil {
+		return false, err
+	}
+
+	target, exists := r.Participants[targetID]
+	if !exists {
+		return false, ErrParticipantNotFound
+	}
+	if role == RoleOwner || target.IsHost || (target.IsPlaceholder && role != RoleVoter) {
+		return false, ErrInvalidRole
+	}
+
+	if spectator := role == RoleSpectator; spectator != target.IsSpectator {
+		if err := r.checkCapacity(spectator); err != nil {
+			return false, err
+		}
+	}
+
+	if role == RoleSpectator {
+		return r.makeSpectator(target), nil
+	}
+	target.IsFacilitator = role == RoleFacilitator
+	target.IsSpectator = false
+	return false, nil
+}
+
+// SetSpectator switches a participant between voting and spectating.
+// Participants may switch themselves; switching someone else takes the host or
+// a facilitator. The host has to hand over the room before spectating. Reports
+// whether the target's vote in the open round was dropped.
+func (r *Room) SetSpectator(callerID, targetID string, spectator bool) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if callerID != targetID {
+		if _, err := r.authorize(callerID, ActionManageParticipants); err != nil {
+			return false, err
+		}
+	}
+
+	target, exists := r.Participants[targetID]
+	if !exists {
+		return false, ErrParticipantNotFound
+	}
+	if target.IsPlaceholder {
+		return false, ErrInvalidRole
+	}
+	if target.IsHost && spectator {
+		return false, ErrHostCannotSpectate
+	}
+	if spectator != target.IsSpectator {
+		if err := r.checkCapacity(spectator); err != nil {
+			return false, err
+		}
+	}
+
+	if !spectator {
+		target.IsSpectator = false
+		return false, nil
+	}
+	return r.makeSpectator(target), nil
+}
+
+// makeSpectator turns a participant into a spectator, dropping any vote in the
+// open round and any standing as deputy (caller must hold the lock). Votes of a
+// revealed round are kept as part of its results. Reports whether a vote was
+// dropped.
+func (r *Room) makeSpectator(p *Participant) bool {
+	p.IsSpectator = true
+	p.IsFacilitator = false
+
+	if r.DeputyID == p.ID {
+		r.DeputyID = ""
+	}
+
+	if r.State != RoomStateVoting {
+		return false
+	}
+	_, voted := r.Votes[p.ID]
+	delete(r.Votes, p.ID)
+	return voted
+}
+
+// SetFacilitatorRotation turns rotating the facilitator role per story on or
+// off (owner action). Turning it off revokes the role from the current rotating
+// facilitator, who is returned if there was one.
+func (r *Room) SetFacilitatorRotation(ownerID string, enabled bool) (*Participant, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.authorize(ownerID, ActionManageRoom); err != nil {
+		return nil, err
+	}
+
+	r.RotateFacilitator = enabled
+	if enabled {
+		return nil, nil
+	}
+
+	current, exists := r.Participants[r.rotatingFacilitatorID]
+	r.rotatingFacilitatorID = ""
+	if !exists || !current.IsFacilitator {
+		return nil, nil
+	}
+	current.IsFacilitator = false
+	return current, nil
+}
+
+// RotatesFacilitator returns whether the facilitator role rotates per story
+func (r *Room) RotatesFacilitator() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.RotateFacilitator
+}
+
+// RotateFacilitatorRole hands the rotating facilitator role to the next
+// connected voter in join order. Facilitators granted by the owner keep their
+// role. Returns the participants whose role changed.
+func (r *Room) RotateFacilitatorRole() []*Participant {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.RotateFacilitator {
+		return nil
+	}
+
+	current := r.Participants[r.rotatingFacilitatorID]
+
+	var candidates []*Participant
+	for _, p := range r.Participants {
+		if p == current || (p.Role() == RoleVoter && p.IsConnected && !p.IsPlaceholder) {
+			candidates = append(candidates, p)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].JoinedAt.Equal(candidates[j].JoinedAt) {
+			return candidates[i].ID < candidates[j].ID
+		}
+		return candidates[i].JoinedAt.Before(candidates[j].JoinedAt)
+	})
+
+	// Next in line after the current facilitator, wrapping around
+	var next *Participant
+	for i, p := range candidates {
+		if p == current {
+			if len(candidates) > 1 {
+				next = candidates[(i+1)%len(candidates)]
+			}
+			break
+		}
+	}
+	if current == nil && len(candidates) > 0 {
+		next = candidates[0]
+	}
+	if next == nil {
+		return nil
+	}
+
+	var changed []*Participant
+	if current != nil && current.IsFacilitator {
+		current.IsFacilitator = false
+		changed = append(changed, current)
+	}
+	next.IsFacilitator = true
+	r.rotatingFacilitatorID = next.ID
+
+	return append(changed, next)
+}
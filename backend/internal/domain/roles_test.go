@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+// newRolesRoom creates a room with a host, a facilitator and a voter, all connected
+func newRolesRoom(t *testing.T) *Room {
+	t.Helper()
+
+	now := time.Now()
+	room := NewRoom("r1", "room", &Participant{ID: "host", IsConnected: true, JoinedAt: now}, nil)
+	_ = room.AddParticipant(&Participant{ID: "fac", IsConnected: true, JoinedAt: now.Add(time.Second)})
+	_ = room.AddParticipant(&Participant{ID: "voter", IsConnected: true, JoinedAt: now.Add(2 * time.Second)})
+
+	if _, err := room.SetRole("host", "fac", RoleFacilitator); err != nil {
+		t.Fatalf("failed to grant facilitator: %v", err)
+	}
+	return room
+}
+
+func TestRoles_Permissions(t *testing.T) {
+	room := newRolesRoom(t)
+
+	if err := room.Authorize("fac", ActionManageRound); err != nil {
+		t.Errorf("expected facilitator to manage rounds, got %v", err)
+	}
+	if err := room.Authorize("voter", ActionManageRound); err != ErrNotHost {
+		t.Errorf("expected ErrNotHost for voter, got %v", err)
+	}
+	if _, err := room.SetRole("fac", "voter", RoleFacilitator); err != ErrNotOwner {
+		t.Errorf("expected ErrNotOwner for facilitator granting roles, got %v", err)
+	}
+	if err := room.KickParticipant("fac", "host"); err != ErrNotOwner {
+		t.Errorf("expected facilitator unable to kick the owner, got %v", err)
+	}
+	if err := room.KickParticipant("fac", "voter"); err != nil {
+		t.Errorf("expected facilitator to kick a voter, got %v", err)
+	}
+}
+
+func TestRoles_SetRole(t *testing.T) {
+	room := newRolesRoom(t)
+	room.SetState(RoomStateVoting)
+	room.Votes["voter"] = &Vote{ParticipantID: "voter", Value: "5", HasVoted: true}
+
+	retracted, err := room.SetRole("host", "voter", RoleSpectator)
+	if err != nil {
+		t.Fatalf("failed to make spectator: %v", err)
+	}
+	if _, voted := room.Votes["voter"]; voted || !retracted {
+		t.Error("expected spectator's vote to be dropped")
+	}
+	if err := room.Authorize("voter", ActionVote); err != ErrSpectatorCannotVote {
+		t.Errorf("expected ErrSpectatorCannotVote, got %v", err)
+	}
+
+	if _, err := room.SetRole("host", "voter", RoleOwner); err != ErrInvalidRole {
+		t.Errorf("expected ErrInvalidRole granting owner, got %v", err)
+	}
+	if _, err := room.SetRole("host", "host", RoleVoter); err != ErrInvalidRole {
+		t.Errorf("expected ErrInvalidRole demoting the owner, got %v", err)
+	}
+}
+
+func TestRoles_RotateFacilitator(t *testing.T) {
+	now := time.Now()
+	room := NewRoom("r1", "room", &Participant{ID: "host", IsConnected: true, JoinedAt: now}, nil)
+	_ = room.AddParticipant(&Participant{ID: "a", IsConnected: true, JoinedAt: now.Add(time.Second)})
+	_ = room.AddParticipant(&Participant{ID: "b", IsConnected: true, JoinedAt: now.Add(2 * time.Second)})
+
+	if _, err := room.SetFacilitatorRotation("host", true); err != nil {
+		t.Fatalf("failed to enable rotation: %v", err)
+	}
+
+	for _, want := range []string{"a", "b", "a"} {
+		room.RotateFacilitatorRole()
+		p, _ := room.GetParticipant(want)
+		if p.Role() != RoleFacilitator {
+			t.Fatalf("expected %s to facilitate", want)
+		}
+	}
+
+	revoked, _ := room.SetFacilitatorRotation("host", false)
+	if revoked == nil || revoked.ID != "a" || revoked.Role() != RoleVoter {
+		t.Errorf("expected rotating facilitator revoked, got %+v", revoked)
+	}
+}
+
+func TestRoles_SetSpectator(t *testing.T) {
+	room := newRolesRoom(t)
+	room.SetState(RoomStateVoting)
+	room.Votes["voter"] = &Vote{ParticipantID: "voter", Value: "5", HasVoted: true}
+
+	retracted, err := room.SetSpectator("voter", "voter", true)
+	if err != nil {
+		t.Fatalf("failed to switch to spectator: %v", err)
+	}
+	if _, voted := room.Votes["voter"]; voted || !retracted {
+		t.Error("expected open-round vote to be dropped")
+	}
+	if _, err := room.SetSpectator("voter", "voter", false); err != nil {
+		t.Fatalf("failed to switch back to voter: %v", err)
+	}
+	if err := room.Authorize("voter", ActionVote); err != nil {
+		t.Errorf("expected voter to vote again, got %v", err)
+	}
+
+	if _, err := room.SetSpectator("voter", "fac", true); err != ErrNotHost {
+		t.Errorf("expected ErrNotHost switching someone else, got %v", err)
+	}
+	if _, err := room.SetSpectator("host", "host", true); err != ErrHostCannotSpectate {
+		t.Errorf("expected ErrHostCannotSpectate, got %v", err)
+	}
+}
@@ -11,7 +11,7 @@ var (
 	ErrRoomNotFound              = errors.New("room not found")
 	ErrParticipantExists         = errors.New("participant already exists")
 	ErrParticipantNotFound       = errors.New("participant not found")
-	ErrNotHost                   = errors.New("only the host can perform this action")
+	ErrNotHost                   = errors.New("only the host or a facilitator can perform this action")
 	ErrInvalidState              = errors.New("invalid room state for this action")
 	ErrInvalidToken              = errors.New("invalid session token")
 	ErrSpectatorCannotVote       = errors.New("spectators cannot vote")
@@ -33,25 +33,69 @@ const (
 type Room struct {
 	mu sync.RWMutex
 
-	ID             string
-	Name           string
-	Participants   map[string]*Participant
-	State          RoomState
-	CreatedAt      time.Time
-	LastActivityAt time.Time
-	Votes          map[string]*Vote
-	CardConfig     *CardConfig
+	ID                    string
+	Name                  string
+	Participants          map[string]*Participant
+	State                 RoomState
+	CreatedAt             time.Time
+	LastActivityAt        time.Time
+	Votes                 map[string]*Vote
+	CardConfig            *CardConfig
+	Mode                  RoomMode
+	DotConfig             *DotVoteConfig          // Only set in dot-voting mode
+	History               []*RoundResult          // Revealed rounds, oldest first
+	ParentID              string                  // Set when this room is a breakout
+	Breakouts             []string                // IDs of open breakout rooms
+	Async                 bool                    // Votes stay open until a deadline instead of a live session
+	Deadline              time.Time               // Auto-reveal time of the current async round (zero if none)
+	Persistent            bool                    // Long-lived team room that is never cleaned up
+	InactivityTimeout     time.Duration           // Overrides the deployment's inactivity timeout (zero if not set)
+	HostSuccession        HostSuccession          // Who takes over when the host disconnects
+	DeputyID              string                  // Next host under HostSuccessionDeputy (empty if none)
+	RotateFacilitator     bool                    // Hand the facilitator role to the next voter for every story
+	KeepDisconnected      bool                    // Keep disconnected participants instead of removing them after a grace period
+	Visibility            Visibility              // Who can find and join the room
+	Invites               map[string]*Invite      // Open invites by ID
+	Locked                bool                    // New participants are turned away
+	WaitingRoom           bool                    // New participants wait until they are admitted
+	Pending               map[string]*Participant // Participants in the waiting room by ID
+	Bans                  map[string]*Ban         // Kicked participants kept out for the room's lifetime, by ban ID
+	MaxVoters             int                     // Connected voters the room takes (0 for no limit)
+	MaxSpectators         int                     // Connected spectators the room takes (0 for no limit)
+	expiryWarned          bool                    // Expiry warning sent since the last activity
+	returningHostID       string                  // Disconnected host who gets the room back on reconnect
+	returnDeadline        time.Time               // End of the returning host's grace period
+	rotatingFacilitatorID string                  // Voter holding the rotating facilitator role
+	passcodeSalt          []byte
+	passcodeHash          []byte                       // Hash of the passcode newcomers need (nil if none)
+	passcodeAttempts      map[string]*passcodeAttempts // Recent passcode attempts by client session token
+	viewToken             string                       // Read-only token for shared screens (empty if none)
+	breakoutCount         int
+	undoStack             []*roomSnapshot  // Rounds before recent transitions, newest last
+	observer              ActivityObserver // Notified when the room's activity is touched
+}
+
+// ActivityObserver is notified when a room's last activity changes, e.g. to
+// reschedule work that is due at a time derived from the room
+type ActivityObserver interface {
+	ActivityTouched(room *Room)
 }
 
 // Participant in a room
 type Participant struct {
-	ID           string
-	Name         string
-	SessionToken string
-	IsHost       bool
-	IsConnected  bool
-	IsSpectator  bool
-	JoinedAt     time.Time
+	ID             string
+	Name           string
+	SessionToken   string
+	IsHost         bool
+	IsConnected    bool
+	IsSpectator    bool
+	JoinedAt       time.Time
+	DisconnectedAt time.Time // When the participant last disconnected (zero while connected or never connected)
+	BreakoutID     string    // Breakout the participant was moved into (parent room only)
+	IsPlaceholder  bool      // Added by the host to hold proxy votes; never connects
+	IsFacilitator  bool      // Runs rounds alongside the host
+	AvatarSeed     string    // Seed for the generated avatar (empty for the default)
+	Color          string    // Hex color shown for the participant (empty for the default)
 }
 
 // NewRoom creates a new room with the given ID, name, host, and optional card config
@@ -78,8 +122,27 @@ func NewRoom(id, name string, host *Participant, cardConfig *CardConfig) *Room {
 // TouchActivity updates the last activity timestamp
 func (r *Room) TouchActivity() {
 	r.mu.Lock()
-	defer r.mu.Unlock()
 	r.LastActivityAt = time.Now()
+	r.expiryWarned = false
+	observer := r.observer
+	r.mu.Unlock()
+
+	// Notify outside the lock so the observer can read the room
+	if observer != nil {
+		observer.ActivityTouched(r)
+	}
+}
+
+// SetActivityObserver registers the observer of the room's activity and
+// notifies it of the current activity
+func (r *Room) SetActivityObserver(observer ActivityObserver) {
+	r.mu.Lock()
+	r.observer = observer
+	r.mu.Unlock()
+
+	if observer != nil {
+		observer.ActivityTouched(r)
+	}
 }
 
 // IsExpired returns true if the room has been inactive longer than the timeout
@@ -104,6 +167,9 @@ func (r *Room) AddParticipant(p *Participant) error {
 	if _, exists := r.Participants[p.ID]; exists {
 		return ErrParticipantExists
 	}
+	if err := r.checkCapacity(p.IsSpectator); err != nil {
+		return err
+	}
 
 	r.Participants[p.ID] = p
 	return nil
@@ -118,70 +184,70 @@ func (r *Room) RemoveParticipant(participantID string) error {
 		return ErrParticipantNotFound
 	}
 
+	r.removeParticipant(participantID)
+	return nil
+}
+
+// removeParticipant removes a participant and their vote, handing a host-less
+// room to a successor under the room's succession policy. Returns the host
+// change, if any (caller must hold the lock).
+func (r *Room) removeParticipant(participantID string) *HostChange {
+	p, exists := r.Participants[participantID]
+	if !exists {
+		return nil
+	}
 	delete(r.Participants, participantID)
 	delete(r.Votes, participantID)
+	r.forgetParticipant(participantID)
 
-	// If host left and there are other participants, assign new host
-	if len(r.Participants) > 0 {
-		hasHost := false
-		for _, p := range r.Participants {
-			if p.IsHost {
-				hasHost = true
-				break
-			}
-		}
-		if !hasHost {
-			// Find earliest connected non-spectator joiner as new host
-			var earliestJoiner *Participant
-			for _, p := range r.Participants {
-				if !p.IsSpectator && p.IsConnected {
-					if earliestJoiner == nil || p.JoinedAt.Before(earliestJoiner.JoinedAt) {
-						earliestJoiner = p
-					}
-				}
-			}
-			if earliestJoiner != nil {
-				earliestJoiner.IsHost = true
-			}
-		}
+	// The host left, or the room was still without one
+	if len(r.Participants) == 0 || r.currentHost() != nil {
+		return nil
+	}
+	change := &HostChange{Reason: HostChangeVacated}
+	if p.IsHost {
+		change.PreviousHostID = p.ID
+	}
+	r.appointHost(change, participantID)
+	if change.PreviousHostID == "" && change.NewHostID == "" {
+		return nil
 	}
+	return change
+}
 
-	return nil
+// forgetParticipant clears the standing a departed participant held as deputy,
+// rotating facilitator or returning host (caller must hold the lock)
+func (r *Room) forgetParticipant(participantID string) {
+	if r.DeputyID == participantID {
+		r.DeputyID = ""
+	}
+	if r.rotatingFacilitatorID == participantID {
+		r.rotatingFacilitatorID = ""
+	}
+	if r.returningHostID == participantID {
+		r.returningHostID = ""
+	}
 }
 
 // DisconnectParticipant marks a participant as disconnected (used for leave)
-// Returns hostTransferred (true if host was transferred) and newHostID
-func (r *Room) DisconnectParticipant(participantID string) (hostTransferred bool, newHostID string, err error) {
+// Returns the host change if the host left, following the room's succession policy
+func (r *Room) DisconnectParticipant(participantID string) (*HostChange, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	p, exists := r.Participants[participantID]
 	if !exists {
-		return false, "", ErrParticipantNotFound
+		return nil, ErrParticipantNotFound
 	}
 
 	p.IsConnected = false
-	wasHost := p.IsHost
-
-	// If host disconnected and there are other connected participants, transfer host
-	if wasHost {
-		p.IsHost = false
-		// Find earliest connected non-spectator joiner as new host
-		var earliestJoiner *Participant
-		for _, participant := range r.Participants {
-			if participant.ID != participantID && !participant.IsSpectator && participant.IsConnected {
-				if earliestJoiner == nil || participant.JoinedAt.Before(earliestJoiner.JoinedAt) {
-					earliestJoiner = participant
-				}
-			}
-		}
-		if earliestJoiner != nil {
-			earliestJoiner.IsHost = true
-			return true, earliestJoiner.ID, nil
-		}
+	p.DisconnectedAt = time.Now()
+
+	if p.IsHost {
+		return r.succeedHost(p), nil
 	}
 
-	return false, "", nil
+	return nil, nil
 }
 
 // ConnectedParticipantCount returns the number of connected participants
@@ -219,6 +285,9 @@ func (r *Room) GetParticipantByToken(token string) (*Participant, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
+	if token == "" {
+		return nil, ErrParticipantNotFound
+	}
 	for _, p := range r.Participants {
 		if p.SessionToken == token {
 			return p, nil
@@ -245,7 +314,7 @@ func (r *Room) ValidateToken(participantID, token string) error {
 	if !exists {
 		return ErrParticipantNotFound
 	}
-	if p.SessionToken != token {
+	if token == "" || p.SessionToken != token {
 		return ErrInvalidToken
 	}
 	return nil
@@ -289,34 +358,41 @@ func (r *Room) GetParticipants() []*Participant {
 	return participants
 }
 
-// KickParticipant removes a target participant from the room (host action)
+// KickParticipant removes a target participant from the room (host or facilitator action)
 func (r *Room) KickParticipant(hostID, targetID string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	// Verify the requester is the host
-	host, exists := r.Participants[hostID]
-	if !exists {
-		return ErrParticipantNotFound
-	}
-	if !host.IsHost {
-		return ErrNotHost
+	_, err := r.kick(hostID, targetID)
+	return err
+}
+
+// kick removes a participant on behalf of the host or a facilitator (caller must hold the lock)
+func (r *Room) kick(hostID, targetID string) (*Participant, error) {
+	// Verify the requester may manage participants
+	if _, err := r.authorize(hostID, ActionManageParticipants); err != nil {
+		return nil, err
 	}
 
 	// Cannot kick yourself
 	if hostID == targetID {
-		return ErrCannotKickSelf
+		return nil, ErrCannotKickSelf
 	}
 
-	// Verify target exists
-	if _, exists := r.Participants[targetID]; !exists {
-		return ErrParticipantNotFound
+	// Verify target exists; only the owner is beyond a facilitator's reach
+	target, exists := r.Participants[targetID]
+	if !exists {
+		return nil, ErrParticipantNotFound
+	}
+	if target.IsHost {
+		return nil, ErrNotOwner
 	}
 
 	delete(r.Participants, targetID)
 	delete(r.Votes, targetID)
+	r.forgetParticipant(targetID)
 
-	return nil
+	return target, nil
 }
 
 // TransferOwnership transfers host privileges from current host to new participant
@@ -325,12 +401,9 @@ func (r *Room) TransferOwnership(currentHostID, newHostID string) error {
 	defer r.mu.Unlock()
 
 	// Verify current host
-	currentHost, exists := r.Participants[currentHostID]
-	if !exists {
-		return ErrParticipantNotFound
-	}
-	if !currentHost.IsHost {
-		return ErrNotHost
+	currentHost, err := r.authorize(currentHostID, ActionManageRoom)
+	if err != nil {
+		return err
 	}
 
 	// Verify new host exists and is not a spectator
@@ -342,9 +415,14 @@ func (r *Room) TransferOwnership(currentHostID, newHostID string) error {
 		return ErrCannotTransferToSpectator
 	}
 
-	// Transfer ownership
+	// Transfer ownership; a deliberate handover ends any pending host return
 	currentHost.IsHost = false
 	newHost.IsHost = true
+	newHost.IsFacilitator = false
+	r.returningHostID = ""
+	if r.DeputyID == newHostID {
+		r.DeputyID = ""
+	}
 
 	return nil
 }
@@ -0,0 +1,209 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Host succession errors
+var (
+	ErrHostPresent   = errors.New("the room already has a host")
+	ErrInvalidDeputy = errors.New("deputy must be a voting participant other than the host")
+)
+
+// HostReturnGracePeriod is how long a disconnected host can reconnect and take
+// the room back under HostSuccessionRestoreOriginal
+const HostReturnGracePeriod = 5 * time.Minute
+
+// HostSuccession decides who becomes host when the host disconnects
+type HostSuccession int
+
+// HostSuccession constants represent the succession policies a room can use.
+const (
+	HostSuccessionEarliestJoiner  HostSuccession = iota // Earliest connected joiner takes over
+	HostSuccessionRestoreOriginal                       // Earliest joiner stands in until the host reconnects within the grace period
+	HostSuccessionManualClaim                           // The room stays host-less until someone claims it
+	HostSuccessionDeputy                                // The named deputy takes over, else the earliest joiner
+)
+
+// HostChangeReason records why the host changed
+type HostChangeReason int
+
+// HostChangeReason constants represent the ways the host can change.
+const (
+	HostChangeTransferred HostChangeReason = iota // The host handed over
+	HostChangeSucceeded                           // A connected participant took over from a missing host
+	HostChangeDeputy                              // The named deputy took over
+	HostChangeRestored                            // The original host reconnected and took the room back
+	HostChangeVacated                             // The host left and nobody took over
+	HostChangeClaimed                             // A participant claimed the host-less room
+)
+
+// HostChange describes a change of host
+type HostChange struct {
+	NewHostID      string // Empty when the room became host-less
+	PreviousHostID string
+	Reason         HostChangeReason
+	ReturnDeadline time.Time // Until when the previous host takes the room back on reconnect (zero if not)
+}
+
+// SetHostSuccession sets the room's succession policy and, for the deputy
+// policy, the deputy (host only). The deputy may be left empty and named later.
+func (r *Room) SetHostSuccession(hostID string, policy HostSuccession, deputyID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.authorize(hostID, ActionManageRoom); err != nil {
+		return err
+	}
+
+	if policy != HostSuccessionDeputy {
+		deputyID = ""
+	}
+	if deputyID != "" {
+		deputy, exists := r.Participants[deputyID]
+		if !exists || deputy.ID == hostID || deputy.IsSpectator || deputy.IsPlaceholder {
+			return ErrInvalidDeputy
+		}
+	}
+
+	r.HostSuccession = policy
+	r.DeputyID = deputyID
+	if policy != HostSuccessionRestoreOriginal {
+		r.returningHostID = ""
+	}
+	return nil
+}
+
+// GetHostSuccession returns the room's succession policy and deputy
+func (r *Room) GetHostSuccession() (HostSuccession, string) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.HostSuccession, r.DeputyID
+}
+
+// Reconnect marks a participant as connected again. Returns the resulting host
+// change, if any: the original host taking the room back within the grace
+// period, or the participant taking over a host-less room.
+func (r *Room) Reconnect(participantID string) (*HostChange, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, exists := r.Participants[participantID]
+	if !exists {
+		return nil, ErrParticipantNotFound
+	}
+	p.IsConnected = true
+
+	if p.IsHost {
+		return nil, nil
+	}
+
+	current := r.currentHost()
+
+	if r.returningHostID != "" && time.Now().After(r.returnDeadline) {
+		r.returningHostID = ""
+	}
+	if r.returningHostID == participantID {
+		r.returningHostID = ""
+		change := &HostChange{NewHostID: p.ID, Reason: HostChangeRestored}
+		if current != nil {
+			current.IsHost = false
+			change.PreviousHostID = current.ID
+		}
+		p.IsHost = true
+		return change, nil
+	}
+
+	// Someone has to run the room, unless it waits for a manual claim
+	if current == nil && r.HostSuccession != HostSuccessionManualClaim && !p.IsSpectator {
+		p.IsHost = true
+		return &HostChange{NewHostID: p.ID, Reason: HostChangeSucceeded}, nil
+	}
+
+	return nil, nil
+}
+
+// ClaimHost makes a participant the host of a room without one
+func (r *Room) ClaimHost(participantID string) (*HostChange, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, exists := r.Participants[participantID]
+	if !exists {
+		return nil, ErrParticipantNotFound
+	}
+	if r.currentHost() != nil {
+		return nil, ErrHostPresent
+	}
+	if p.IsSpectator {
+		return nil, ErrCannotTransferToSpectator
+	}
+
+	p.IsHost = true
+	r.returningHostID = ""
+	return &HostChange{NewHostID: p.ID, Reason: HostChangeClaimed}, nil
+}
+
+// succeedHost hands the room over from a disconnecting host according to the
+// succession policy (caller must hold the lock)
+func (r *Room) succeedHost(leaving *Participant) *HostChange {
+	leaving.IsHost = false
+	change := &HostChange{PreviousHostID: leaving.ID, Reason: HostChangeVacated}
+
+	if r.HostSuccession == HostSuccessionRestoreOriginal {
+		// A stand-in host leaving does not take the original host's place in line
+		if r.returningHostID == "" || time.Now().After(r.returnDeadline) {
+			r.returningHostID = leaving.ID
+			r.returnDeadline = time.Now().Add(HostReturnGracePeriod)
+		}
+		change.ReturnDeadline = r.returnDeadline
+	}
+
+	r.appointHost(change, leaving.ID)
+	return change
+}
+
+// appointHost makes the successor the policy picks the host of a room without
+// one: nobody under manual claim, else the deputy if connected, else the
+// earliest connected voter other than the leaving participant. Records the new
+// host in the change (caller must hold the lock).
+func (r *Room) appointHost(change *HostChange, leavingID string) {
+	switch r.HostSuccession {
+	case HostSuccessionManualClaim:
+		return
+	case HostSuccessionDeputy:
+		if deputy, exists := r.Participants[r.DeputyID]; exists && deputy.IsConnected && !deputy.IsSpectator {
+			deputy.IsHost = true
+			r.DeputyID = ""
+			change.NewHostID = deputy.ID
+			change.Reason = HostChangeDeputy
+			return
+		}
+	}
+
+	// Find earliest connected non-spectator joiner as new host
+	var earliestJoiner *Participant
+	for _, p := range r.Participants {
+		if p.ID != leavingID && !p.IsSpectator && p.IsConnected {
+			if earliestJoiner == nil || p.JoinedAt.Before(earliestJoiner.JoinedAt) {
+				earliestJoiner = p
+			}
+		}
+	}
+	if earliestJoiner != nil {
+		earliestJoiner.IsHost = true
+		change.NewHostID = earliestJoiner.ID
+		change.Reason = HostChangeSucceeded
+	}
+}
+
+// currentHost returns the host, or nil if the room has none (caller must hold the lock)
+func (r *Room) currentHost() *Participant {
+	for _, p := range r.Participants {
+		if p.IsHost {
+			return p
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+// newSuccessionRoom creates a room with a host, an early voter and a late voter, all connected
+func newSuccessionRoom(t *testing.T, policy HostSuccession) *Room {
+	t.Helper()
+
+	now := time.Now()
+	room := NewRoom("r1", "room", &Participant{ID: "host", IsConnected: true, JoinedAt: now}, nil)
+	_ = room.AddParticipant(&Participant{ID: "early", IsConnected: true, JoinedAt: now.Add(time.Second)})
+	_ = room.AddParticipant(&Participant{ID: "late", IsConnected: true, JoinedAt: now.Add(2 * time.Second)})
+
+	if err := room.SetHostSuccession("host", policy, ""); err != nil {
+		t.Fatalf("failed to set policy: %v", err)
+	}
+	return room
+}
+
+func TestHostSuccession_RestoreOriginal(t *testing.T) {
+	room := newSuccessionRoom(t, HostSuccessionRestoreOriginal)
+
+	change, _ := room.DisconnectParticipant("host")
+	if change.NewHostID != "early" || change.Reason != HostChangeSucceeded || change.ReturnDeadline.IsZero() {
+		t.Fatalf("expected early joiner to stand in, got %+v", change)
+	}
+
+	change, _ = room.Reconnect("host")
+	if change == nil || change.NewHostID != "host" || change.PreviousHostID != "early" || change.Reason != HostChangeRestored {
+		t.Fatalf("expected original host restored, got %+v", change)
+	}
+	if room.IsHost("early") || !room.IsHost("host") {
+		t.Error("expected only the original host to be host")
+	}
+}
+
+func TestHostSuccession_RestoreOriginalAfterGracePeriod(t *testing.T) {
+	room := newSuccessionRoom(t, HostSuccessionRestoreOriginal)
+
+	_, _ = room.DisconnectParticipant("host")
+	room.returnDeadline = time.Now().Add(-time.Second)
+
+	if change, _ := room.Reconnect("host"); change != nil {
+		t.Errorf("expected no host change after the grace period, got %+v", change)
+	}
+	if !room.IsHost("early") {
+		t.Error("expected stand-in to stay host")
+	}
+}
+
+func TestHostSuccession_ManualClaim(t *testing.T) {
+	room := newSuccessionRoom(t, HostSuccessionManualClaim)
+
+	change, _ := room.DisconnectParticipant("host")
+	if change.NewHostID != "" || change.Reason != HostChangeVacated {
+		t.Fatalf("expected room to be host-less, got %+v", change)
+	}
+
+	// Reconnecting does not take the room back
+	if change, _ := room.Reconnect("host"); change != nil {
+		t.Errorf("expected no host change on reconnect, got %+v", change)
+	}
+
+	change, err := room.ClaimHost("late")
+	if err != nil || change.NewHostID != "late" || change.Reason != HostChangeClaimed {
+		t.Fatalf("expected claim to succeed, got %+v, %v", change, err)
+	}
+	if _, err := room.ClaimHost("early"); err != ErrHostPresent {
+		t.Errorf("expected ErrHostPresent, got %v", err)
+	}
+}
+
+func TestHostSuccession_Deputy(t *testing.T) {
+	room := newSuccessionRoom(t, HostSuccessionDeputy)
+
+	if err := room.SetHostSuccession("host", HostSuccessionDeputy, "host"); err != ErrInvalidDeputy {
+		t.Errorf("expected ErrInvalidDeputy for the host itself, got %v", err)
+	}
+	if err := room.SetHostSuccession("host", HostSuccessionDeputy, "late"); err != nil {
+		t.Fatalf("failed to name deputy: %v", err)
+	}
+
+	change, _ := room.DisconnectParticipant("host")
+	if change.NewHostID != "late" || change.Reason != HostChangeDeputy {
+		t.Fatalf("expected deputy to take over, got %+v", change)
+	}
+
+	// With the deputy used up, the earliest joiner is next
+	change, _ = room.DisconnectParticipant("late")
+	if change.NewHostID != "early" || change.Reason != HostChangeSucceeded {
+		t.Errorf("expected earliest joiner to take over, got %+v", change)
+	}
+}
+
+func TestHostSuccession_RemovedHostFollowsPolicy(t *testing.T) {
+	room := newSuccessionRoom(t, HostSuccessionDeputy)
+	_ = room.SetHostSuccession("host", HostSuccessionDeputy, "late")
+
+	// A host removed outright is succeeded like one who disconnected
+	_ = room.RemoveParticipant("host")
+	if !room.IsHost("late") || room.IsHost("early") {
+		t.Fatal("expected the deputy to take over from the removed host")
+	}
+
+	manual := newSuccessionRoom(t, HostSuccessionManualClaim)
+	_ = manual.RemoveParticipant("host")
+	if manual.IsHost("early") || manual.IsHost("late") {
+		t.Error("expected the room to wait for a claim")
+	}
+}
+
+func TestHostSuccession_KickClearsStanding(t *testing.T) {
+	room := newSuccessionRoom(t, HostSuccessionDeputy)
+	_ = room.SetHostSuccession("host", HostSuccessionDeputy, "late")
+	_, _ = room.SetFacilitatorRotation("host", true)
+	room.RotateFacilitatorRole()
+
+	_ = room.KickParticipant("host", "late")
+	_ = room.KickParticipant("host", "early")
+	if room.DeputyID != "" || room.rotatingFacilitatorID != "" {
+		t.Errorf("expected kicked participants to lose their standing, got deputy %q and facilitator %q", room.DeputyID, room.rotatingFacilitatorID)
+	}
+}
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Errors
+var (
+	ErrNothingToUndo = errors.New("there is no transition to undo")
+	ErrUndoExpired   = errors.New("the last transition is too old to undo")
+)
+
+// UndoWindow is how long after a transition the host can still undo it
+const UndoWindow = 30 * time.Second
+
+// maxUndoSnapshots bounds the number of transitions that can be undone in a row
+const maxUndoSnapshots = 10
+
+// Transition identifies a round state change that can be undone
+type Transition int
+
+// Transition constants represent the undoable round state changes.
+const (
+	TransitionStart Transition = iota
+	TransitionReveal
+	TransitionReset
+)
+
+// roomSnapshot captures the round before a transition
+type roomSnapshot struct {
+	transition Transition
+	takenAt    time.Time
+	state      RoomState
+	deadline   time.Time
+	votes      map[string]*Vote
+	round      *RoundResult // History entry added by a reveal, removed again on undo
+}
+
+// pushSnapshot records the round before a transition (caller must hold the lock)
+func (r *Room) pushSnapshot(transition Transition) *roomSnapshot {
+	votes := make(map[string]*Vote, len(r.Votes))
+	for id, v := range r.Votes {
+		votes[id] = v
+	}
+
+	snapshot := &roomSnapshot{
+		transition: transition,
+		takenAt:    time.Now(),
+		state:      r.State,
+		deadline:   r.Deadline,
+		votes:      votes,
+	}
+
+	r.undoStack = append(r.undoStack, snapshot)
+	if len(r.undoStack) > maxUndoSnapshots {
+		r.undoStack = r.undoStack[len(r.undoStack)-maxUndoSnapshots:]
+	}
+
+	return snapshot
+}
+
+// UndoLastTransition restores the votes and state from before the most recent
+// start, reveal or reset (host or facilitator action). Votes of participants
+// who have since left are not restored.
+func (r *Room) UndoLastTransition(hostID string) (Transition, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.authorize(hostID, ActionManageRound); err != nil {
+		return 0, err
+	}
+
+	if len(r.undoStack) == 0 {
+		return 0, ErrNothingToUndo
+	}
+
+	snapshot := r.undoStack[len(r.undoStack)-1]
+	if time.Since(snapshot.takenAt) > UndoWindow {
+		// Everything below is older still
+		r.undoStack = nil
+		return 0, ErrUndoExpired
+	}
+	r.undoStack = r.undoStack[:len(r.undoStack)-1]
+
+	r.State = snapshot.state
+	r.Deadline = time.Time{}
+	if snapshot.deadline.After(time.Now()) {
+		// A deadline that passed in the meantime would reveal the round straight away
+		r.Deadline = snapshot.deadline
+	}
+	r.Votes = make(map[string]*Vote, len(snapshot.votes))
+	for id, v := range snapshot.votes {
+		if _, exists := r.Participants[id]; exists {
+			r.Votes[id] = v
+		}
+	}
+
+	if snapshot.round != nil {
+		r.removeHistory(snapshot.round)
+	}
+
+	return snapshot.transition, nil
+}
+
+// removeHistory drops a round from the history (caller must hold the lock)
+func (r *Room) removeHistory(round *RoundResult) {
+	for i := len(r.History) - 1; i >= 0; i-- {
+		if r.History[i] == round {
+			r.History = append(r.History[:i], r.History[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestUndoLastTransition_RestoresRound(t *testing.T) {
+	host := &Participant{ID: "host", Name: "Host", IsHost: true, IsConnected: true}
+	room := NewRoom("r1", "brave-nebula", host, nil)
+	_ = room.AddParticipant(&Participant{ID: "p2", Name: "Pat", IsConnected: true})
+
+	if _, err := room.UndoLastTransition("host"); err != ErrNothingToUndo {
+		t.Fatalf("expected ErrNothingToUndo, got %v", err)
+	}
+
+	room.StartVoting()
+	_ = room.CastVote("host", "5")
+	_ = room.CastVote("p2", "8")
+
+	if _, err := room.RevealVotes(); err != nil {
+		t.Fatalf("unexpected reveal error: %v", err)
+	}
+	room.ResetRound()
+
+	// Undo the reset: votes come back and the room is revealed again
+	transition, err := room.UndoLastTransition("host")
+	if err != nil || transition != TransitionReset {
+		t.Fatalf("expected reset undone, got %v, %v", transition, err)
+	}
+	if room.GetState() != RoomStateRevealed || len(room.GetVotes()) != 2 {
+		t.Errorf("expected revealed round with 2 votes, got state %v with %d votes", room.GetState(), len(room.GetVotes()))
+	}
+
+	// Undo the reveal: back to voting and the round leaves the history
+	if _, err := room.UndoLastTransition("p2"); err != ErrNotHost {
+		t.Errorf("expected ErrNotHost, got %v", err)
+	}
+	if _, err := room.UndoLastTransition("host"); err != nil {
+		t.Fatalf("unexpected undo error: %v", err)
+	}
+	if room.GetState() != RoomStateVoting || len(room.GetHistory()) != 0 {
+		t.Errorf("expected voting state and empty history, got %v with %d rounds", room.GetState(), len(room.GetHistory()))
+	}
+
+	// Snapshots outside the window cannot be undone
+	room.undoStack[len(room.undoStack)-1].takenAt = room.undoStack[0].takenAt.Add(-UndoWindow)
+	if _, err := room.UndoLastTransition("host"); err != ErrUndoExpired {
+		t.Errorf("expected ErrUndoExpired, got %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+package domain
+
+import "errors"
+
+// ErrViewOnly is returned when a view token is used to join the room
+var ErrViewOnly = errors.New("view tokens are read-only and cannot join the room")
+
+// CreateViewToken issues a read-only view token for showing the room on a shared
+// screen (host or facilitator action). The token may watch the room and load
+// its snapshot but never becomes a participant. Creating a token replaces any
+// earlier one, which stops working; replaced reports whether there was one.
+func (r *Room) CreateViewToken(callerID string) (token string, replaced bool, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.authorize(callerID, ActionManageParticipants); err != nil {
+		return "", false, err
+	}
+
+	replaced = r.viewToken != ""
+	r.viewToken = GenerateSessionToken()
+	return r.viewToken, replaced, nil
+}
+
+// RevokeViewToken stops the room's view token from working (host or facilitator
+// action). Returns whether there was one.
+func (r *Room) RevokeViewToken(callerID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.authorize(callerID, ActionManageParticipants); err != nil {
+		return false, err
+	}
+
+	revoked := r.viewToken != ""
+	r.viewToken = ""
+	return revoked, nil
+}
+
+// IsViewToken returns whether a token is the room's current view token
+func (r *Room) IsViewToken(token string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.isViewToken(token)
+}
+
+// isViewToken checks a token against the view token (caller must hold the lock)
+func (r *Room) isViewToken(token string) bool {
+	return r.viewToken != "" && token == r.viewToken
+}
+
+// HasViewToken returns whether the room has a view token
+func (r *Room) HasViewToken() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.viewToken != ""
+}
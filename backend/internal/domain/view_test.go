@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestViewToken(t *testing.T) {
+	room := NewRoom("r1", "room", &Participant{ID: "host", SessionToken: "host-token"}, nil)
+	_ = room.AddParticipant(&Participant{ID: "voter"})
+	_ = room.SetVisibility("host", VisibilityPrivate)
+
+	if _, _, err := room.CreateViewToken("voter"); err != ErrNotHost {
+		t.Errorf("expected ErrNotHost for a voter, got %v", err)
+	}
+
+	first, replaced, err := room.CreateViewToken("host")
+	if err != nil || first == "" || replaced {
+		t.Fatalf("failed to create view token: %q, %v, %v", first, replaced, err)
+	}
+	if err := room.AuthorizeWatcher(first, "", time.Now()); err != nil {
+		t.Errorf("expected view token to watch a private room, got %v", err)
+	}
+	if len(room.GetParticipants()) != 2 {
+		t.Error("expected the view token not to add a participant")
+	}
+
+	second, replaced, _ := room.CreateViewToken("host")
+	if !replaced || room.IsViewToken(first) || !room.IsViewToken(second) {
+		t.Error("expected a new view token to replace the old one")
+	}
+
+	if revoked, err := room.RevokeViewToken("host"); err != nil || !revoked {
+		t.Fatalf("failed to revoke: %v, %v", revoked, err)
+	}
+	if err := room.AuthorizeWatcher(second, "", time.Now()); err != ErrInviteRequired {
+		t.Errorf("expected revoked view token rejected, got %v", err)
+	}
+	if room.IsViewToken("") {
+		t.Error("expected an empty token never to match")
+	}
+}
@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Visibility and invite errors
+var (
+	ErrInviteRequired   = errors.New("this room can only be joined with an invite")
+	ErrInvalidInvite    = errors.New("invite is invalid, expired or revoked")
+	ErrInviteNotFound   = errors.New("invite not found")
+	ErrInvalidInviteTTL = errors.New("invite lifetime must be between 1 minute and 30 days")
+)
+
+// Invite lifetime limits
+const (
+	DefaultInviteTTL = 24 * time.Hour
+	MinInviteTTL     = time.Minute
+	MaxInviteTTL     = 30 * 24 * time.Hour
+)
+
+// Visibility decides who can find and join a room
+type Visibility int
+
+// Visibility constants from most to least open.
+const (
+	VisibilityPublic   Visibility = iota // Listed and joinable by anyone
+	VisibilityUnlisted                   // Joinable by anyone with the link, but not listed
+	VisibilityPrivate                    // Not listed; new participants need an invite
+)
+
+// Invite lets someone join a private room with a given role
+type Invite struct {
+	ID        string
+	Role      Role // Voter or spectator
+	CreatedBy string
+	ExpiresAt time.Time
+}
+
+// SetVisibility changes who can find and join the room (owner action)
+func (r *Room) SetVisibility(ownerID string, visibility Visibility) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.authorize(ownerID, ActionManageRoom); err != nil {
+		return err
+	}
+
+	r.Visibility = visibility
+	return nil
+}
+
+// GetVisibility returns who can find and join the room
+func (r *Room) GetVisibility() Visibility {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.Visibility
+}
+
+// IsListed returns whether the room shows up in room listings
+func (r *Room) IsListed() bool {
+	return r.GetVisibility() == VisibilityPublic
+}
+
+// IsFindableByName returns whether the room may be looked up by its name.
+// Generated names are easy to guess, so only public rooms and persistent team
+// rooms are; unlisted rooms take their ID.
+func (r *Room) IsFindableByName() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	switch r.Visibility {
+	case VisibilityPublic:
+		return true
+	case VisibilityUnlisted:
+		return false
+	default:
+		return r.Persistent
+	}
+}
+
+// AuthorizeWatcher checks that a session token may watch the room's events.
+// Participants always may. Anyone else may watch public and unlisted rooms,
+// given the passcode if the room has one, but not private rooms. The room's
+// view token may always watch. Banned session tokens may not watch at all.
+func (r *Room) AuthorizeWatcher(sessionToken, passcode string, now time.Time) error {
+	r.mu.RLock()
+	isParticipant := false
+	if sessionToken != "" {
+		for _, p := range r.Participants {
+			if p.SessionToken == sessionToken {
+				isParticipant = true
+				break
+			}
+		}
+	}
+	viewer := r.isViewToken(sessionToken)
+	private := r.Visibility == VisibilityPrivate
+	banned := r.isBanned(sessionToken, "")
+	r.mu.RUnlock()
+
+	switch {
+	case isParticipant, viewer:
+		return nil
+	case banned:
+		return ErrBanned
+	case private:
+		return ErrInviteRequired
+	default:
+		return r.CheckPasscode(sessionToken, passcode, now)
+	}
+}
+
+// CreateInvite adds an invite that joins as a voter or spectator until it
+// expires (host or facilitator action)
+func (r *Room) CreateInvite(callerID, inviteID string, role Role, ttl time.Duration, now time.Time) (*Invite, error) {
+	if ttl < MinInviteTTL || ttl > MaxInviteTTL {
+		return nil, ErrInvalidInviteTTL
+	}
+	if role != RoleVoter && role != RoleSpectator {
+		return nil, ErrInvalidRole
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.authorize(callerID, ActionManageParticipants); err != nil {
+		return nil, err
+	}
+
+	// Expired invites can never be redeemed again
+	for id, invite := range r.Invites {
+		if !now.Before(invite.ExpiresAt) {
+			delete(r.Invites, id)
+		}
+	}
+
+	invite := &Invite{
+		ID:        inviteID,
+		Role:      role,
+		CreatedBy: callerID,
+		ExpiresAt: now.Add(ttl),
+	}
+	if r.Invites == nil {
+		r.Invites = make(map[string]*Invite)
+	}
+	r.Invites[inviteID] = invite
+	return invite, nil
+}
+
+// RevokeInvite stops an invite from being redeemed (host or facilitator action)
+func (r *Room) RevokeInvite(callerID, inviteID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.authorize(callerID, ActionManageParticipants); err != nil {
+		return err
+	}
+	if _, exists := r.Invites[inviteID]; !exists {
+		return ErrInviteNotFound
+	}
+
+	delete(r.Invites, inviteID)
+	return nil
+}
+
+// RedeemInvite returns the invite if it can still be used to join. Invites are
+// links and may be redeemed by several people until they expire.
+func (r *Room) RedeemInvite(inviteID string, now time.Time) (*Invite, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	invite, exists := r.Invites[inviteID]
+	if !exists || !now.Before(invite.ExpiresAt) {
+		return nil, ErrInvalidInvite
+	}
+	return invite, nil
+}
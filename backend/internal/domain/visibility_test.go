@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInvites(t *testing.T) {
+	room := NewRoom("r1", "room", &Participant{ID: "host", SessionToken: "host-token"}, nil)
+	_ = room.AddParticipant(&Participant{ID: "voter"})
+	now := time.Now()
+
+	if _, err := room.CreateInvite("voter", "i1", RoleVoter, time.Hour, now); err != ErrNotHost {
+		t.Errorf("expected ErrNotHost for a voter, got %v", err)
+	}
+	if _, err := room.CreateInvite("host", "i1", RoleFacilitator, time.Hour, now); err != ErrInvalidRole {
+		t.Errorf("expected ErrInvalidRole for a facilitator invite, got %v", err)
+	}
+
+	if _, err := room.CreateInvite("host", "i1", RoleSpectator, time.Hour, now); err != nil {
+		t.Fatalf("failed to create invite: %v", err)
+	}
+	if invite, err := room.RedeemInvite("i1", now); err != nil || invite.Role != RoleSpectator {
+		t.Errorf("expected spectator invite, got %+v, %v", invite, err)
+	}
+	if _, err := room.RedeemInvite("i1", now.Add(time.Hour)); err != ErrInvalidInvite {
+		t.Errorf("expected expired invite rejected, got %v", err)
+	}
+
+	if err := room.RevokeInvite("host", "i1"); err != nil {
+		t.Fatalf("failed to revoke: %v", err)
+	}
+	if _, err := room.RedeemInvite("i1", now); err != ErrInvalidInvite {
+		t.Errorf("expected revoked invite rejected, got %v", err)
+	}
+}
+
+func TestAuthorizeWatcher(t *testing.T) {
+	room := NewRoom("r1", "room", &Participant{ID: "host", SessionToken: "host-token"}, nil)
+
+	now := time.Now()
+	if err := room.AuthorizeWatcher("", "", now); err != nil {
+		t.Errorf("expected public room open to anyone, got %v", err)
+	}
+
+	_ = room.SetVisibility("host", VisibilityPrivate)
+	if err := room.AuthorizeWatcher("", "", now); err != ErrInviteRequired {
+		t.Errorf("expected ErrInviteRequired for a stranger, got %v", err)
+	}
+	if err := room.AuthorizeWatcher("host-token", "", now); err != nil {
+		t.Errorf("expected participant allowed, got %v", err)
+	}
+}
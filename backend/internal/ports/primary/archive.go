@@ -0,0 +1,25 @@
+package primary
+
+import (
+	"context"
+
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+)
+
+// ArchiveService defines the primary port for browsing closed sessions
+type ArchiveService interface {
+	// ListArchivedSessions returns archived sessions matching the filter, most
+	// recently closed first. Callers see the sessions their session tokens took
+	// part in, leaving out private rooms; the admin token sees every session.
+	ListArchivedSessions(ctx context.Context, filter domain.ArchiveFilter, access ArchiveAccess) ([]*domain.ArchivedSessionSummary, error)
+
+	// GetArchivedSession returns an archived session with its participants, deck
+	// and rounds, to its participants and the admin
+	GetArchivedSession(ctx context.Context, id string, access ArchiveAccess) (*domain.ArchivedSession, error)
+}
+
+// ArchiveAccess is the credentials a caller presents to the archive
+type ArchiveAccess struct {
+	SessionTokens []string // Session tokens the caller held in closed rooms
+	AdminToken    string   // The deployment's archive admin token (empty if not an admin)
+}
@@ -2,6 +2,7 @@ package primary
 
 import (
 	"context"
+	"time"
 
 	"github.com/vicmanager/esteemed/backend/internal/domain"
 )
@@ -11,17 +12,37 @@ type EstimationService interface {
 	// CastVote submits a vote for the current round
 	CastVote(ctx context.Context, roomID, participantID, sessionToken, value string) error
 
-	// RevealVotes reveals all votes (host only)
+	// CastDots places dots on options in a dot-voting room
+	CastDots(ctx context.Context, roomID, participantID, sessionToken string, dots []*domain.DotAllocation) error
+
+	// CastProxyVote records a vote on behalf of an absent participant (host or facilitator).
+	// The target is identified by participant ID or, failing that, by name.
+	CastProxyVote(ctx context.Context, roomID, participantID, sessionToken, targetParticipantID, targetName, value string) (*domain.Participant, error)
+
+	// RevealVotes reveals all votes (host or facilitator)
 	RevealVotes(ctx context.Context, roomID, participantID, sessionToken string) (*domain.VoteSummary, error)
 
 	// ResetRound clears all votes and starts a new round
 	ResetRound(ctx context.Context, roomID, participantID, sessionToken string) error
 
-	// StartRound begins a new voting round (host only)
+	// StartRound begins a new voting round (host or facilitator)
 	StartRound(ctx context.Context, roomID, participantID, sessionToken string) error
 
-	// WatchVotes returns a channel for vote events
-	WatchVotes(ctx context.Context, roomID, sessionToken string) (<-chan VoteEvent, error)
+	// UndoLastTransition restores the round from before the last start, reveal or reset (host or facilitator)
+	UndoLastTransition(ctx context.Context, roomID, participantID, sessionToken string) error
+
+	// SetVotingDeadline sets when the current async round is revealed automatically (host or facilitator)
+	SetVotingDeadline(ctx context.Context, roomID, participantID, sessionToken string, deadline time.Time) error
+
+	// GetRoundHistory returns the revealed rounds of a room, oldest first
+	GetRoundHistory(ctx context.Context, roomID, sessionToken string) ([]*domain.RoundResult, error)
+
+	// WatchVotes returns a channel for vote events, starting with the current
+	// vote state. Newcomers who are not participants need the room's passcode if
+	// it has one. A resume point with a non-zero sequence resumes after that
+	// event; if missed events are no longer buffered or the resume point is from
+	// another epoch, a Resync event is sent and the vote state again
+	WatchVotes(ctx context.Context, roomID, sessionToken, passcode string, resume ResumePoint) (<-chan VoteEvent, error)
 }
 
 // VoteEventType represents types of vote events
@@ -32,12 +53,17 @@ const (
 	VoteEventCast VoteEventType = iota
 	VoteEventRevealed
 	VoteEventReset
+	VoteEventResync
+	VoteEventRetracted
 )
 
 // VoteEvent represents a real-time vote event
 type VoteEvent struct {
+	Sequence        uint64 // Position in the room's event sequence (set when published)
+	Epoch           uint64 // Run of the room's event sequence (set when published)
 	Type            VoteEventType
 	ParticipantID   string
 	ParticipantName string
+	IsProxy         bool
 	Summary         *domain.VoteSummary
 }
@@ -2,32 +2,129 @@ package primary
 
 import (
 	"context"
+	"time"
 
 	"github.com/vicmanager/esteemed/backend/internal/domain"
 )
 
 // RoomService defines the primary port for room operations
 type RoomService interface {
-	// ListRooms returns all active rooms
+	// ListRooms returns all active public rooms
 	ListRooms(ctx context.Context) ([]*RoomSummary, error)
 
-	// CreateRoom creates a new room with a generated name and optional card config
-	CreateRoom(ctx context.Context, hostName, sessionToken string, cardConfig *domain.CardConfig) (*CreateRoomResult, error)
+	// CreateRoom creates a new room with a generated name and optional settings
+	CreateRoom(ctx context.Context, hostName, sessionToken string, opts RoomOptions) (*CreateRoomResult, error)
 
-	// JoinRoom adds a participant to an existing room
-	JoinRoom(ctx context.Context, roomID, participantName, sessionToken string, isSpectator bool) (*JoinRoomResult, error)
+	// JoinRoom adds a participant to an existing room. Reconnecting with a valid
+	// session token skips the invite and passcode checks
+	JoinRoom(ctx context.Context, roomID, participantName, sessionToken string, opts JoinOptions) (*JoinRoomResult, error)
 
 	// LeaveRoom removes a participant from a room
 	LeaveRoom(ctx context.Context, roomID, participantID, sessionToken string) error
 
-	// WatchRoom returns a channel for room events
-	WatchRoom(ctx context.Context, roomID, sessionToken string) (<-chan RoomEvent, error)
+	// WatchRoom returns a channel for room events. Newcomers who are not
+	// participants need the room's passcode if it has one. A resume point with a
+	// non-zero sequence resumes after that event; missed events that are no
+	// longer buffered, or a resume point from another epoch, are replaced by a
+	// Resync event
+	WatchRoom(ctx context.Context, roomID, sessionToken, passcode string, resume ResumePoint) (<-chan RoomEvent, error)
 
-	// KickParticipant removes a target participant from the room (host only)
-	KickParticipant(ctx context.Context, roomID, participantID, sessionToken, targetParticipantID string) error
+	// WatchRoomState returns one ordered stream of the room's room and vote
+	// events. It starts with a snapshot numbered with the sequence it reflects;
+	// every later event carries the next sequence number. A resume point with a
+	// non-zero sequence resumes after that event instead, falling back to a
+	// resync snapshot if the events are no longer buffered or the resume point
+	// is from another epoch. Takes the same access as WatchRoom
+	WatchRoomState(ctx context.Context, roomID, sessionToken, passcode string, resume ResumePoint) (<-chan RoomStateEvent, error)
+
+	// GetRoom returns a snapshot of the room without joining it or publishing
+	// anything. Takes the same access as WatchRoom: a participant's session token,
+	// the room's view token, or the passcode if set
+	GetRoom(ctx context.Context, roomID, sessionToken, passcode string) (*RoomSnapshot, error)
+
+	// CreateViewToken issues a read-only view token for shared screens that can
+	// watch the room without joining it, replacing any earlier one (host or facilitator)
+	CreateViewToken(ctx context.Context, roomID, participantID, sessionToken string) (string, error)
+
+	// RevokeViewToken stops the room's view token from working (host or facilitator)
+	RevokeViewToken(ctx context.Context, roomID, participantID, sessionToken string) error
+
+	// KickParticipant removes a target participant from the room, optionally
+	// banning them from rejoining (host or facilitator)
+	KickParticipant(ctx context.Context, roomID, participantID, sessionToken, targetParticipantID string, opts KickOptions) error
+
+	// ListBans returns who has been banned from the room (host or facilitator)
+	ListBans(ctx context.Context, roomID, participantID, sessionToken string) ([]*domain.Ban, error)
+
+	// LiftBan lets a banned participant join the room again (host or facilitator)
+	LiftBan(ctx context.Context, roomID, participantID, sessionToken, banID string) error
 
 	// TransferOwnership transfers host privileges to another participant
 	TransferOwnership(ctx context.Context, roomID, participantID, sessionToken, newHostID string) error
+
+	// GrantRole makes a participant a facilitator, voter or spectator (owner only)
+	GrantRole(ctx context.Context, roomID, participantID, sessionToken, targetParticipantID string, role domain.Role) error
+
+	// RevokeRole returns a participant to the voter role (owner only)
+	RevokeRole(ctx context.Context, roomID, participantID, sessionToken, targetParticipantID string) error
+
+	// SetPasscode sets or, when empty, removes the room's passcode (owner only)
+	SetPasscode(ctx context.Context, roomID, participantID, sessionToken, passcode string) error
+
+	// SetVisibility changes who can find and join the room (owner only)
+	SetVisibility(ctx context.Context, roomID, participantID, sessionToken string, visibility domain.Visibility) error
+
+	// CreateInvite creates a signed invite link that joins the room with the given role (host or facilitator)
+	CreateInvite(ctx context.Context, roomID, participantID, sessionToken string, role domain.Role, ttl time.Duration) (*CreateInviteResult, error)
+
+	// RevokeInvite stops an invite link from being used (host or facilitator)
+	RevokeInvite(ctx context.Context, roomID, participantID, sessionToken, inviteID string) error
+
+	// UpdateParticipant changes the caller's name, avatar seed or color
+	UpdateParticipant(ctx context.Context, roomID, participantID, sessionToken string, profile domain.ParticipantProfile) (*domain.Participant, error)
+
+	// SetLocked locks or unlocks the room to new participants; reconnects still work (host or facilitator)
+	SetLocked(ctx context.Context, roomID, participantID, sessionToken string, locked bool) error
+
+	// SetWaitingRoom turns the waiting room for new participants on or off (owner only)
+	SetWaitingRoom(ctx context.Context, roomID, participantID, sessionToken string, enabled bool) error
+
+	// AdmitParticipant lets a participant in from the waiting room (host or facilitator)
+	AdmitParticipant(ctx context.Context, roomID, participantID, sessionToken, pendingParticipantID string) error
+
+	// RejectParticipant turns away a participant in the waiting room (host or facilitator)
+	RejectParticipant(ctx context.Context, roomID, participantID, sessionToken, pendingParticipantID string) error
+
+	// SetParticipantLimits caps how many voters and spectators the room takes,
+	// within the deployment's limits; zero uses the deployment's limit (owner only)
+	SetParticipantLimits(ctx context.Context, roomID, participantID, sessionToken string, maxVoters, maxSpectators int) error
+
+	// SetSpectator switches a participant between voting and spectating. An empty
+	// target switches the caller; switching someone else takes the host or a facilitator
+	SetSpectator(ctx context.Context, roomID, participantID, sessionToken, targetParticipantID string, spectator bool) error
+
+	// SetFacilitatorRotation turns rotating the facilitator role per story on or off (owner only)
+	SetFacilitatorRotation(ctx context.Context, roomID, participantID, sessionToken string, enabled bool) error
+
+	// SetHostSuccession sets who takes over when the host disconnects (owner only)
+	SetHostSuccession(ctx context.Context, roomID, participantID, sessionToken string, policy domain.HostSuccession, deputyID string) error
+
+	// ClaimHost makes the caller host of a room that has none
+	ClaimHost(ctx context.Context, roomID, participantID, sessionToken string) error
+
+	// KeepAlive resets a room's inactivity timer. Returns when the room now expires
+	// (zero for rooms that never expire)
+	KeepAlive(ctx context.Context, roomID, participantID, sessionToken string) (time.Time, error)
+
+	// CreateBreakout creates a child room for a sub-team (host or facilitator)
+	CreateBreakout(ctx context.Context, roomID, participantID, sessionToken string) (*domain.Room, error)
+
+	// MoveToBreakout moves a participant into one of the room's breakouts (host or facilitator)
+	MoveToBreakout(ctx context.Context, roomID, participantID, sessionToken, targetParticipantID, breakoutRoomID string) error
+
+	// CloseBreakout closes a breakout and merges its round history into the parent (host or facilitator)
+	// Returns the number of rounds merged
+	CloseBreakout(ctx context.Context, roomID, participantID, sessionToken, breakoutRoomID string) (int, error)
 }
 
 // RoomSummary is a brief view of a room for listing
@@ -38,6 +135,70 @@ type RoomSummary struct {
 	State            domain.RoomState
 	CreatedAt        int64
 	ExpiresAt        int64
+	Persistent       bool
+}
+
+// RoomOptions holds the optional settings for a new room
+type RoomOptions struct {
+	CardConfig *domain.CardConfig    // Defaults to Fibonacci when nil
+	DotConfig  *domain.DotVoteConfig // Enables dot-voting mode when set
+	Async      bool                  // Keeps rounds open until a deadline
+	Deadline   time.Time             // Deadline of the first async round (optional)
+	Persistent bool                  // Long-lived team room exempt from cleanup; requires Slug
+	Slug       string                // Custom room name instead of a generated one
+
+	InactivityTimeout time.Duration         // Overrides the deployment's inactivity timeout when set
+	HostSuccession    domain.HostSuccession // Who takes over when the host disconnects
+	RotateFacilitator bool                  // Hands the facilitator role to the next voter for every story
+	KeepDisconnected  bool                  // Keeps disconnected participants instead of removing them after the grace period
+	Visibility        domain.Visibility     // Who can find and join the room
+	Passcode          string                // Passcode newcomers need to join or watch (optional)
+	WaitingRoom       bool                  // New participants wait until the host admits them
+	MaxVoters         int                   // Voters the room takes, within the deployment's limit (0 uses the deployment's limit)
+	MaxSpectators     int                   // Spectators the room takes, within the deployment's limit (0 uses the deployment's limit)
+}
+
+// JoinOptions holds how a new participant joins a room
+type JoinOptions struct {
+	IsSpectator bool   // Watches without voting (overridden by an invite's role)
+	InviteToken string // Required for private rooms
+	Passcode    string // Required if the room has a passcode, unless joining with an invite
+}
+
+// RoomSnapshot is a room's current state as one caller may see it
+type RoomSnapshot struct {
+	Room          *domain.Room
+	VoteStatus    []*domain.Vote      // Who has voted this round; values only for the caller's own vote
+	Summary       *domain.VoteSummary // Set once the votes are revealed
+	ParticipantID string              // The caller's participant ID (empty for view tokens and outsiders)
+	IsViewer      bool                // The caller used the room's view token
+	CanManage     bool                // The caller may see who is waiting to be admitted
+}
+
+// ResumePoint is where an earlier stream of a room left off: the last sequence
+// number it received and the epoch that number belongs to. A room's sequence
+// starts over in a new epoch whenever it is lost, such as after a restart, so a
+// stale resume point is caught instead of resuming into unrelated events.
+type ResumePoint struct {
+	Epoch    uint64
+	Sequence uint64
+}
+
+// RoomStateEvent is one message of a room's combined event stream. Exactly one
+// of Snapshot, Room and Vote is set.
+type RoomStateEvent struct {
+	Sequence uint64        // Increases by one per room or vote event published in the room
+	Epoch    uint64        // Run of the room's sequence that Sequence belongs to
+	Snapshot *RoomSnapshot // The room as of Sequence: the first message, or after missed events
+	Resync   bool          // The snapshot replaces state after events were missed
+	Room     *RoomEvent
+	Vote     *VoteEvent
+}
+
+// KickOptions holds whether a kicked participant may come back
+type KickOptions struct {
+	Ban     bool // Bans the participant's session token for the room's lifetime
+	BanName bool // Also bans their name (implies Ban)
 }
 
 // CreateRoomResult contains the result of creating a room
@@ -47,11 +208,18 @@ type CreateRoomResult struct {
 	ParticipantID string
 }
 
+// CreateInviteResult contains a new invite and the token to share
+type CreateInviteResult struct {
+	Invite *domain.Invite
+	Token  string
+}
+
 // JoinRoomResult contains the result of joining a room
 type JoinRoomResult struct {
 	Room          *domain.Room
 	SessionToken  string
 	ParticipantID string
+	Pending       bool // In the waiting room until the host admits or rejects them
 }
 
 // RoomEventType represents types of room events
@@ -64,14 +232,52 @@ const (
 	RoomEventStateChanged
 	RoomEventClosed
 	RoomEventHostChanged
+	RoomEventParticipantMoved
+	RoomEventBreakoutClosed
+	RoomEventTransitionUndone
+	RoomEventDeadlineChanged
+	RoomEventExpiryWarning
+	RoomEventKeptAlive
+	RoomEventHostSuccessionChanged
+	RoomEventParticipantUpdated
+	RoomEventFacilitatorRotationChanged
+	RoomEventVisibilityChanged
+	RoomEventPasscodeChanged
+	RoomEventLockChanged
+	RoomEventWaitingRoomChanged
+	RoomEventJoinRequested
+	RoomEventJoinAdmitted
+	RoomEventJoinRejected
+	RoomEventParticipantRemoved
+	RoomEventParticipantLimitsChanged
+	RoomEventViewTokenRevoked
+	RoomEventResync
 )
 
 // RoomEvent represents a real-time room event
 type RoomEvent struct {
-	Type          RoomEventType
-	Participant   *domain.Participant
-	ParticipantID string
-	NewState      domain.RoomState
-	Reason        string
-	NewHostID     string
+	Sequence       uint64 // Position in the room's event sequence (set when published)
+	Epoch          uint64 // Run of the room's event sequence (set when published)
+	Type           RoomEventType
+	Participant    *domain.Participant
+	ParticipantID  string
+	NewState       domain.RoomState
+	Reason         string
+	CloseReason    domain.CloseReason // Why the room closed (Closed events only)
+	NewHostID      string
+	HostChange     *domain.HostChange // How the host changed (HostChanged events only)
+	HostSuccession domain.HostSuccession
+	DeputyID       string            // Named deputy (HostSuccessionChanged events only)
+	Enabled        bool              // Whether facilitator rotation, the passcode, the lock or the waiting room is on (FacilitatorRotationChanged, PasscodeChanged, LockChanged and WaitingRoomChanged events only)
+	Banned         bool              // Whether the removed participant was banned (ParticipantRemoved events only)
+	Visibility     domain.Visibility // New visibility (VisibilityChanged events only)
+	MaxVoters      int               // New voter limit (ParticipantLimitsChanged events only)
+	MaxSpectators  int               // New spectator limit (ParticipantLimitsChanged events only)
+	TargetRoomID   string            // Room a participant was moved to, or the closed breakout
+	TargetRoomName string
+	RoundsMerged   int
+	Transition     domain.Transition // Undone transition (TransitionUndone events only)
+	Deadline       time.Time         // New voting deadline (DeadlineChanged events only)
+	ExpiresAt      time.Time         // When the room expires (ExpiryWarning and KeptAlive events only)
+	Snapshot       *RoomSnapshot     // The room's current state (Resync events only)
 }
@@ -0,0 +1,26 @@
+package secondary
+
+import (
+	"context"
+	"time"
+
+	"github.com/vicmanager/esteemed/backend/internal/domain"
+)
+
+// ArchiveRepository defines the secondary port for archived session persistence
+type ArchiveRepository interface {
+	// Save persists an archived session, replacing any earlier archive of the same room
+	Save(ctx context.Context, session *domain.ArchivedSession) error
+
+	// Get retrieves an archived session by its room ID
+	Get(ctx context.Context, id string) (*domain.ArchivedSession, error)
+
+	// List returns summaries of archived sessions matching the filter, most recently closed first
+	List(ctx context.Context, filter domain.ArchiveFilter) ([]*domain.ArchivedSessionSummary, error)
+
+	// DeleteClosedBefore removes sessions closed before the given time, returning how many were removed
+	DeleteClosedBefore(ctx context.Context, before time.Time) (int64, error)
+
+	// Close closes the repository connection
+	Close() error
+}
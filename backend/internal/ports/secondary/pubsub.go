@@ -19,4 +19,24 @@ type EventPublisher interface {
 
 	// SubscribeVoteEvents subscribes to vote events for a specific room
 	SubscribeVoteEvents(ctx context.Context, roomID string) (<-chan primary.VoteEvent, func())
+
+	// SubscribeRoomState subscribes to a room's room and vote events together, in
+	// publish order and numbered by a per-room sequence. Also returns the epoch
+	// of the sequence, which changes whenever the sequence starts over, and the
+	// last sequence number handed out before subscribing.
+	SubscribeRoomState(ctx context.Context, roomID string) (events <-chan primary.RoomStateEvent, epoch, sequence uint64, unsubscribe func())
+
+	// ReplayRoomState returns a room's recent events after a sequence number of
+	// an epoch and the last sequence number handed out. Reports false when the
+	// events are no longer all buffered or the epoch is not the current one, so
+	// the caller has to resync instead.
+	ReplayRoomState(ctx context.Context, roomID string, epoch, after uint64) ([]primary.RoomStateEvent, uint64, bool)
+
+	// CloseSubscriptions ends all room and vote event subscriptions for a room,
+	// keeping its sequence numbers so clients can resume later
+	CloseSubscriptions(ctx context.Context, roomID string)
+
+	// ForgetRoom ends a closed room's subscriptions and drops its sequence
+	// numbers, epoch and replayable events
+	ForgetRoom(ctx context.Context, roomID string)
 }
@@ -8,6 +8,9 @@ import (
 
 // RoomRepository defines the secondary port for room persistence
 type RoomRepository interface {
+	// Create persists a new room, failing with domain.ErrRoomNameTaken if its name is in use
+	Create(ctx context.Context, room *domain.Room) error
+
 	// Save persists a room
 	Save(ctx context.Context, room *domain.Room) error
 
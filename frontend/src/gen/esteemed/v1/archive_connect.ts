@@ -0,0 +1,41 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file esteemed/v1/archive.proto (package esteemed.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { GetArchivedSessionRequest, GetArchivedSessionResponse, ListArchivedSessionsRequest, ListArchivedSessionsResponse } from "./archive_pb.js";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * ArchiveService lets teams browse and re-export closed sessions
+ *
+ * @generated from service esteemed.v1.ArchiveService
+ */
+export const ArchiveService = {
+  typeName: "esteemed.v1.ArchiveService",
+  methods: {
+    /**
+     * ListArchivedSessions returns the caller's closed sessions, most recently closed first
+     *
+     * @generated from rpc esteemed.v1.ArchiveService.ListArchivedSessions
+     */
+    listArchivedSessions: {
+      name: "ListArchivedSessions",
+      I: ListArchivedSessionsRequest,
+      O: ListArchivedSessionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetArchivedSession returns a closed session the caller took part in, with its participants, deck and rounds
+     *
+     * @generated from rpc esteemed.v1.ArchiveService.GetArchivedSession
+     */
+    getArchivedSession: {
+      name: "GetArchivedSession",
+      I: GetArchivedSessionRequest,
+      O: GetArchivedSessionResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,608 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file esteemed/v1/archive.proto (package esteemed.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * ListArchivedSessionsRequest filters the archive
+ *
+ * @generated from message esteemed.v1.ListArchivedSessionsRequest
+ */
+export class ListArchivedSessionsRequest extends Message<ListArchivedSessionsRequest> {
+  /**
+   * Matches part of the room name (case-insensitive)
+   *
+   * @generated from field: string query = 1;
+   */
+  query = "";
+
+  /**
+   * Unix timestamp (0 for no bound)
+   *
+   * @generated from field: int64 closed_after = 2;
+   */
+  closedAfter = protoInt64.zero;
+
+  /**
+   * Unix timestamp (0 for no bound)
+   *
+   * @generated from field: int64 closed_before = 3;
+   */
+  closedBefore = protoInt64.zero;
+
+  /**
+   * Defaults to 50, at most 200
+   *
+   * @generated from field: int32 limit = 4;
+   */
+  limit = 0;
+
+  /**
+   * Session tokens the caller held; lists their sessions, leaving out private rooms (at most 100)
+   *
+   * @generated from field: repeated string session_tokens = 5;
+   */
+  sessionTokens: string[] = [];
+
+  /**
+   * The deployment's archive admin token; lists every session instead
+   *
+   * @generated from field: string admin_token = 6;
+   */
+  adminToken = "";
+
+  constructor(data?: PartialMessage<ListArchivedSessionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.ListArchivedSessionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "closed_after", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "closed_before", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "session_tokens", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "admin_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListArchivedSessionsRequest {
+    return new ListArchivedSessionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListArchivedSessionsRequest {
+    return new ListArchivedSessionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListArchivedSessionsRequest {
+    return new ListArchivedSessionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListArchivedSessionsRequest | PlainMessage<ListArchivedSessionsRequest> | undefined, b: ListArchivedSessionsRequest | PlainMessage<ListArchivedSessionsRequest> | undefined): boolean {
+    return proto3.util.equals(ListArchivedSessionsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.ListArchivedSessionsResponse
+ */
+export class ListArchivedSessionsResponse extends Message<ListArchivedSessionsResponse> {
+  /**
+   * @generated from field: repeated esteemed.v1.ArchivedSessionSummary sessions = 1;
+   */
+  sessions: ArchivedSessionSummary[] = [];
+
+  constructor(data?: PartialMessage<ListArchivedSessionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.ListArchivedSessionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sessions", kind: "message", T: ArchivedSessionSummary, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListArchivedSessionsResponse {
+    return new ListArchivedSessionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListArchivedSessionsResponse {
+    return new ListArchivedSessionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListArchivedSessionsResponse {
+    return new ListArchivedSessionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListArchivedSessionsResponse | PlainMessage<ListArchivedSessionsResponse> | undefined, b: ListArchivedSessionsResponse | PlainMessage<ListArchivedSessionsResponse> | undefined): boolean {
+    return proto3.util.equals(ListArchivedSessionsResponse, a, b);
+  }
+}
+
+/**
+ * ArchivedSessionSummary describes a closed session in listings
+ *
+ * @generated from message esteemed.v1.ArchivedSessionSummary
+ */
+export class ArchivedSessionSummary extends Message<ArchivedSessionSummary> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string room_name = 2;
+   */
+  roomName = "";
+
+  /**
+   * @generated from field: bool dot_voting = 3;
+   */
+  dotVoting = false;
+
+  /**
+   * @generated from field: int64 created_at = 4;
+   */
+  createdAt = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 closed_at = 5;
+   */
+  closedAt = protoInt64.zero;
+
+  /**
+   * @generated from field: string close_reason = 6;
+   */
+  closeReason = "";
+
+  /**
+   * @generated from field: int32 participant_count = 7;
+   */
+  participantCount = 0;
+
+  /**
+   * @generated from field: int32 round_count = 8;
+   */
+  roundCount = 0;
+
+  constructor(data?: PartialMessage<ArchivedSessionSummary>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.ArchivedSessionSummary";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "room_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "dot_voting", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "closed_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "close_reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "participant_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "round_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArchivedSessionSummary {
+    return new ArchivedSessionSummary().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArchivedSessionSummary {
+    return new ArchivedSessionSummary().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArchivedSessionSummary {
+    return new ArchivedSessionSummary().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArchivedSessionSummary | PlainMessage<ArchivedSessionSummary> | undefined, b: ArchivedSessionSummary | PlainMessage<ArchivedSessionSummary> | undefined): boolean {
+    return proto3.util.equals(ArchivedSessionSummary, a, b);
+  }
+}
+
+/**
+ * GetArchivedSessionRequest fetches a closed session
+ *
+ * @generated from message esteemed.v1.GetArchivedSessionRequest
+ */
+export class GetArchivedSessionRequest extends Message<GetArchivedSessionRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * A session token of one of the session's participants
+   *
+   * @generated from field: string session_token = 2;
+   */
+  sessionToken = "";
+
+  /**
+   * Or the deployment's archive admin token
+   *
+   * @generated from field: string admin_token = 3;
+   */
+  adminToken = "";
+
+  constructor(data?: PartialMessage<GetArchivedSessionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.GetArchivedSessionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "admin_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetArchivedSessionRequest {
+    return new GetArchivedSessionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetArchivedSessionRequest {
+    return new GetArchivedSessionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetArchivedSessionRequest {
+    return new GetArchivedSessionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetArchivedSessionRequest | PlainMessage<GetArchivedSessionRequest> | undefined, b: GetArchivedSessionRequest | PlainMessage<GetArchivedSessionRequest> | undefined): boolean {
+    return proto3.util.equals(GetArchivedSessionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.GetArchivedSessionResponse
+ */
+export class GetArchivedSessionResponse extends Message<GetArchivedSessionResponse> {
+  /**
+   * @generated from field: esteemed.v1.ArchivedSession session = 1;
+   */
+  session?: ArchivedSession;
+
+  constructor(data?: PartialMessage<GetArchivedSessionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.GetArchivedSessionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "session", kind: "message", T: ArchivedSession },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetArchivedSessionResponse {
+    return new GetArchivedSessionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetArchivedSessionResponse {
+    return new GetArchivedSessionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetArchivedSessionResponse {
+    return new GetArchivedSessionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetArchivedSessionResponse | PlainMessage<GetArchivedSessionResponse> | undefined, b: GetArchivedSessionResponse | PlainMessage<GetArchivedSessionResponse> | undefined): boolean {
+    return proto3.util.equals(GetArchivedSessionResponse, a, b);
+  }
+}
+
+/**
+ * ArchivedSession is a closed room with everything needed to export it
+ *
+ * @generated from message esteemed.v1.ArchivedSession
+ */
+export class ArchivedSession extends Message<ArchivedSession> {
+  /**
+   * @generated from field: esteemed.v1.ArchivedSessionSummary summary = 1;
+   */
+  summary?: ArchivedSessionSummary;
+
+  /**
+   * Set when the room was a breakout
+   *
+   * @generated from field: string parent_room_id = 2;
+   */
+  parentRoomId = "";
+
+  /**
+   * Card values, or options in dot-voting rooms
+   *
+   * @generated from field: repeated string deck = 3;
+   */
+  deck: string[] = [];
+
+  /**
+   * @generated from field: repeated esteemed.v1.ArchivedParticipant participants = 4;
+   */
+  participants: ArchivedParticipant[] = [];
+
+  /**
+   * Oldest first
+   *
+   * @generated from field: repeated esteemed.v1.ArchivedRound rounds = 5;
+   */
+  rounds: ArchivedRound[] = [];
+
+  constructor(data?: PartialMessage<ArchivedSession>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.ArchivedSession";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "summary", kind: "message", T: ArchivedSessionSummary },
+    { no: 2, name: "parent_room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "deck", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "participants", kind: "message", T: ArchivedParticipant, repeated: true },
+    { no: 5, name: "rounds", kind: "message", T: ArchivedRound, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArchivedSession {
+    return new ArchivedSession().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArchivedSession {
+    return new ArchivedSession().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArchivedSession {
+    return new ArchivedSession().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArchivedSession | PlainMessage<ArchivedSession> | undefined, b: ArchivedSession | PlainMessage<ArchivedSession> | undefined): boolean {
+    return proto3.util.equals(ArchivedSession, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.ArchivedParticipant
+ */
+export class ArchivedParticipant extends Message<ArchivedParticipant> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: bool was_host = 2;
+   */
+  wasHost = false;
+
+  /**
+   * @generated from field: bool is_spectator = 3;
+   */
+  isSpectator = false;
+
+  /**
+   * @generated from field: bool is_placeholder = 4;
+   */
+  isPlaceholder = false;
+
+  constructor(data?: PartialMessage<ArchivedParticipant>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.ArchivedParticipant";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "was_host", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "is_spectator", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "is_placeholder", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArchivedParticipant {
+    return new ArchivedParticipant().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArchivedParticipant {
+    return new ArchivedParticipant().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArchivedParticipant {
+    return new ArchivedParticipant().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArchivedParticipant | PlainMessage<ArchivedParticipant> | undefined, b: ArchivedParticipant | PlainMessage<ArchivedParticipant> | undefined): boolean {
+    return proto3.util.equals(ArchivedParticipant, a, b);
+  }
+}
+
+/**
+ * ArchivedRound is the outcome of a revealed round
+ *
+ * @generated from message esteemed.v1.ArchivedRound
+ */
+export class ArchivedRound extends Message<ArchivedRound> {
+  /**
+   * @generated from field: int64 revealed_at = 1;
+   */
+  revealedAt = protoInt64.zero;
+
+  /**
+   * Set for rounds merged from a breakout
+   *
+   * @generated from field: string source_room_name = 2;
+   */
+  sourceRoomName = "";
+
+  /**
+   * @generated from field: string average = 3;
+   */
+  average = "";
+
+  /**
+   * Most common vote
+   *
+   * @generated from field: string mode = 4;
+   */
+  mode = "";
+
+  /**
+   * @generated from field: bool has_consensus = 5;
+   */
+  hasConsensus = false;
+
+  /**
+   * @generated from field: double numeric_average = 6;
+   */
+  numericAverage = 0;
+
+  /**
+   * @generated from field: repeated esteemed.v1.ArchivedVote votes = 7;
+   */
+  votes: ArchivedVote[] = [];
+
+  /**
+   * Dot-voting rooms only
+   *
+   * @generated from field: repeated esteemed.v1.ArchivedRanking ranking = 8;
+   */
+  ranking: ArchivedRanking[] = [];
+
+  constructor(data?: PartialMessage<ArchivedRound>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.ArchivedRound";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "revealed_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "source_room_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "average", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "mode", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "has_consensus", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "numeric_average", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 7, name: "votes", kind: "message", T: ArchivedVote, repeated: true },
+    { no: 8, name: "ranking", kind: "message", T: ArchivedRanking, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArchivedRound {
+    return new ArchivedRound().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArchivedRound {
+    return new ArchivedRound().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArchivedRound {
+    return new ArchivedRound().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArchivedRound | PlainMessage<ArchivedRound> | undefined, b: ArchivedRound | PlainMessage<ArchivedRound> | undefined): boolean {
+    return proto3.util.equals(ArchivedRound, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.ArchivedVote
+ */
+export class ArchivedVote extends Message<ArchivedVote> {
+  /**
+   * @generated from field: string participant_name = 1;
+   */
+  participantName = "";
+
+  /**
+   * @generated from field: string value = 2;
+   */
+  value = "";
+
+  /**
+   * @generated from field: bool is_proxy = 3;
+   */
+  isProxy = false;
+
+  constructor(data?: PartialMessage<ArchivedVote>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.ArchivedVote";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "participant_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "is_proxy", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArchivedVote {
+    return new ArchivedVote().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArchivedVote {
+    return new ArchivedVote().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArchivedVote {
+    return new ArchivedVote().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArchivedVote | PlainMessage<ArchivedVote> | undefined, b: ArchivedVote | PlainMessage<ArchivedVote> | undefined): boolean {
+    return proto3.util.equals(ArchivedVote, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.ArchivedRanking
+ */
+export class ArchivedRanking extends Message<ArchivedRanking> {
+  /**
+   * @generated from field: string option = 1;
+   */
+  option = "";
+
+  /**
+   * @generated from field: int32 dots = 2;
+   */
+  dots = 0;
+
+  /**
+   * @generated from field: int32 rank = 3;
+   */
+  rank = 0;
+
+  constructor(data?: PartialMessage<ArchivedRanking>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.ArchivedRanking";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "option", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "dots", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "rank", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArchivedRanking {
+    return new ArchivedRanking().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArchivedRanking {
+    return new ArchivedRanking().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArchivedRanking {
+    return new ArchivedRanking().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArchivedRanking | PlainMessage<ArchivedRanking> | undefined, b: ArchivedRanking | PlainMessage<ArchivedRanking> | undefined): boolean {
+    return proto3.util.equals(ArchivedRanking, a, b);
+  }
+}
+
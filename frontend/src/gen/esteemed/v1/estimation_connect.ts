@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CastVoteRequest, CastVoteResponse, ResetRoundRequest, ResetRoundResponse, RevealVotesRequest, RevealVotesResponse, StartRoundRequest, StartRoundResponse, VoteEvent, WatchVotesRequest } from "./estimation_pb.js";
+import { CastDotsRequest, CastDotsResponse, CastProxyVoteRequest, CastProxyVoteResponse, CastVoteRequest, CastVoteResponse, GetRoundHistoryRequest, GetRoundHistoryResponse, ResetRoundRequest, ResetRoundResponse, RevealVotesRequest, RevealVotesResponse, SetVotingDeadlineRequest, SetVotingDeadlineResponse, StartRoundRequest, StartRoundResponse, UndoLastTransitionRequest, UndoLastTransitionResponse, VoteEvent, WatchVotesRequest } from "./estimation_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -26,7 +26,29 @@ export const EstimationService = {
       kind: MethodKind.Unary,
     },
     /**
-     * RevealVotes makes all votes visible (host only)
+     * CastProxyVote records a vote on behalf of an absent participant (host or facilitator)
+     *
+     * @generated from rpc esteemed.v1.EstimationService.CastProxyVote
+     */
+    castProxyVote: {
+      name: "CastProxyVote",
+      I: CastProxyVoteRequest,
+      O: CastProxyVoteResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CastDots places a participant's dots in a dot-voting room (hidden until reveal)
+     *
+     * @generated from rpc esteemed.v1.EstimationService.CastDots
+     */
+    castDots: {
+      name: "CastDots",
+      I: CastDotsRequest,
+      O: CastDotsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RevealVotes makes all votes visible (host or facilitator)
      *
      * @generated from rpc esteemed.v1.EstimationService.RevealVotes
      */
@@ -48,7 +70,7 @@ export const EstimationService = {
       kind: MethodKind.Unary,
     },
     /**
-     * StartRound begins a new voting round (host only)
+     * StartRound begins a new voting round (host or facilitator)
      *
      * @generated from rpc esteemed.v1.EstimationService.StartRound
      */
@@ -58,6 +80,39 @@ export const EstimationService = {
       O: StartRoundResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * UndoLastTransition restores the votes and state from before the last start, reveal or reset (host or facilitator)
+     *
+     * @generated from rpc esteemed.v1.EstimationService.UndoLastTransition
+     */
+    undoLastTransition: {
+      name: "UndoLastTransition",
+      I: UndoLastTransitionRequest,
+      O: UndoLastTransitionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SetVotingDeadline sets when the current async round is revealed automatically (host or facilitator)
+     *
+     * @generated from rpc esteemed.v1.EstimationService.SetVotingDeadline
+     */
+    setVotingDeadline: {
+      name: "SetVotingDeadline",
+      I: SetVotingDeadlineRequest,
+      O: SetVotingDeadlineResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetRoundHistory returns the revealed rounds of a room, including merged breakout rounds
+     *
+     * @generated from rpc esteemed.v1.EstimationService.GetRoundHistory
+     */
+    getRoundHistory: {
+      name: "GetRoundHistory",
+      I: GetRoundHistoryRequest,
+      O: GetRoundHistoryResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * WatchVotes streams real-time vote status and results
      *
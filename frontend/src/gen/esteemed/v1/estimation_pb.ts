@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
 
 /**
  * Vote represents a participant's vote
@@ -36,6 +36,27 @@ export class Vote extends Message<Vote> {
    */
   hasVoted = false;
 
+  /**
+   * Dot placement (dot-voting rooms only)
+   *
+   * @generated from field: repeated esteemed.v1.DotAllocation dots = 5;
+   */
+  dots: DotAllocation[] = [];
+
+  /**
+   * Entered by the host on behalf of an absent participant
+   *
+   * @generated from field: bool is_proxy = 6;
+   */
+  isProxy = false;
+
+  /**
+   * Name of the host who entered the proxy vote
+   *
+   * @generated from field: string proxy_cast_by = 7;
+   */
+  proxyCastBy = "";
+
   constructor(data?: PartialMessage<Vote>) {
     super();
     proto3.util.initPartial(data, this);
@@ -48,6 +69,9 @@ export class Vote extends Message<Vote> {
     { no: 2, name: "participant_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "has_voted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "dots", kind: "message", T: DotAllocation, repeated: true },
+    { no: 6, name: "is_proxy", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "proxy_cast_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Vote {
@@ -67,6 +91,114 @@ export class Vote extends Message<Vote> {
   }
 }
 
+/**
+ * DotAllocation is a number of dots placed on a single option
+ *
+ * @generated from message esteemed.v1.DotAllocation
+ */
+export class DotAllocation extends Message<DotAllocation> {
+  /**
+   * @generated from field: string option = 1;
+   */
+  option = "";
+
+  /**
+   * @generated from field: int32 dots = 2;
+   */
+  dots = 0;
+
+  constructor(data?: PartialMessage<DotAllocation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.DotAllocation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "option", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "dots", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DotAllocation {
+    return new DotAllocation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DotAllocation {
+    return new DotAllocation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DotAllocation {
+    return new DotAllocation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DotAllocation | PlainMessage<DotAllocation> | undefined, b: DotAllocation | PlainMessage<DotAllocation> | undefined): boolean {
+    return proto3.util.equals(DotAllocation, a, b);
+  }
+}
+
+/**
+ * DotRanking is an option's position in the revealed dot-voting results
+ *
+ * @generated from message esteemed.v1.DotRanking
+ */
+export class DotRanking extends Message<DotRanking> {
+  /**
+   * @generated from field: string option = 1;
+   */
+  option = "";
+
+  /**
+   * Total dots placed on this option
+   *
+   * @generated from field: int32 dots = 2;
+   */
+  dots = 0;
+
+  /**
+   * 1-based rank; tied options share a rank
+   *
+   * @generated from field: int32 rank = 3;
+   */
+  rank = 0;
+
+  /**
+   * Number of participants who placed dots here
+   *
+   * @generated from field: int32 voter_count = 4;
+   */
+  voterCount = 0;
+
+  constructor(data?: PartialMessage<DotRanking>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.DotRanking";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "option", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "dots", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "rank", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "voter_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DotRanking {
+    return new DotRanking().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DotRanking {
+    return new DotRanking().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DotRanking {
+    return new DotRanking().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DotRanking | PlainMessage<DotRanking> | undefined, b: DotRanking | PlainMessage<DotRanking> | undefined): boolean {
+    return proto3.util.equals(DotRanking, a, b);
+  }
+}
+
 /**
  * VoteSummary shows statistics after reveal
  *
@@ -106,6 +238,13 @@ export class VoteSummary extends Message<VoteSummary> {
    */
   numericAverage = 0;
 
+  /**
+   * Ranked options (dot-voting rooms only)
+   *
+   * @generated from field: repeated esteemed.v1.DotRanking ranking = 6;
+   */
+  ranking: DotRanking[] = [];
+
   constructor(data?: PartialMessage<VoteSummary>) {
     super();
     proto3.util.initPartial(data, this);
@@ -119,6 +258,7 @@ export class VoteSummary extends Message<VoteSummary> {
     { no: 3, name: "mode", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "has_consensus", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 5, name: "numeric_average", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 6, name: "ranking", kind: "message", T: DotRanking, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VoteSummary {
@@ -138,6 +278,65 @@ export class VoteSummary extends Message<VoteSummary> {
   }
 }
 
+/**
+ * RoundResult is a revealed round kept in the room's history
+ *
+ * @generated from message esteemed.v1.RoundResult
+ */
+export class RoundResult extends Message<RoundResult> {
+  /**
+   * @generated from field: int64 revealed_at = 1;
+   */
+  revealedAt = protoInt64.zero;
+
+  /**
+   * Room the round was played in (differs for merged breakouts)
+   *
+   * @generated from field: string source_room_id = 2;
+   */
+  sourceRoomId = "";
+
+  /**
+   * @generated from field: string source_room_name = 3;
+   */
+  sourceRoomName = "";
+
+  /**
+   * @generated from field: esteemed.v1.VoteSummary summary = 4;
+   */
+  summary?: VoteSummary;
+
+  constructor(data?: PartialMessage<RoundResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.RoundResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "revealed_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "source_room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "source_room_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "summary", kind: "message", T: VoteSummary },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoundResult {
+    return new RoundResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoundResult {
+    return new RoundResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoundResult {
+    return new RoundResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RoundResult | PlainMessage<RoundResult> | undefined, b: RoundResult | PlainMessage<RoundResult> | undefined): boolean {
+    return proto3.util.equals(RoundResult, a, b);
+  }
+}
+
 /**
  * CastVoteRequest submits a vote
  *
@@ -228,6 +427,210 @@ export class CastVoteResponse extends Message<CastVoteResponse> {
   }
 }
 
+/**
+ * CastProxyVoteRequest records a vote for an absent participant
+ *
+ * @generated from message esteemed.v1.CastProxyVoteRequest
+ */
+export class CastProxyVoteRequest extends Message<CastProxyVoteRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * Must be host or facilitator
+   *
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  /**
+   * Existing disconnected participant, or empty to use target_name
+   *
+   * @generated from field: string target_participant_id = 4;
+   */
+  targetParticipantId = "";
+
+  /**
+   * Name of the absent person; a placeholder is created if needed
+   *
+   * @generated from field: string target_name = 5;
+   */
+  targetName = "";
+
+  /**
+   * @generated from field: string value = 6;
+   */
+  value = "";
+
+  constructor(data?: PartialMessage<CastProxyVoteRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.CastProxyVoteRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "target_participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "target_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CastProxyVoteRequest {
+    return new CastProxyVoteRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CastProxyVoteRequest {
+    return new CastProxyVoteRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CastProxyVoteRequest {
+    return new CastProxyVoteRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CastProxyVoteRequest | PlainMessage<CastProxyVoteRequest> | undefined, b: CastProxyVoteRequest | PlainMessage<CastProxyVoteRequest> | undefined): boolean {
+    return proto3.util.equals(CastProxyVoteRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.CastProxyVoteResponse
+ */
+export class CastProxyVoteResponse extends Message<CastProxyVoteResponse> {
+  /**
+   * Participant the vote was recorded for
+   *
+   * @generated from field: string target_participant_id = 1;
+   */
+  targetParticipantId = "";
+
+  constructor(data?: PartialMessage<CastProxyVoteResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.CastProxyVoteResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "target_participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CastProxyVoteResponse {
+    return new CastProxyVoteResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CastProxyVoteResponse {
+    return new CastProxyVoteResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CastProxyVoteResponse {
+    return new CastProxyVoteResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CastProxyVoteResponse | PlainMessage<CastProxyVoteResponse> | undefined, b: CastProxyVoteResponse | PlainMessage<CastProxyVoteResponse> | undefined): boolean {
+    return proto3.util.equals(CastProxyVoteResponse, a, b);
+  }
+}
+
+/**
+ * CastDotsRequest places dots on options in a dot-voting room
+ *
+ * @generated from message esteemed.v1.CastDotsRequest
+ */
+export class CastDotsRequest extends Message<CastDotsRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  /**
+   * Replaces any previous placement
+   *
+   * @generated from field: repeated esteemed.v1.DotAllocation dots = 4;
+   */
+  dots: DotAllocation[] = [];
+
+  constructor(data?: PartialMessage<CastDotsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.CastDotsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "dots", kind: "message", T: DotAllocation, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CastDotsRequest {
+    return new CastDotsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CastDotsRequest {
+    return new CastDotsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CastDotsRequest {
+    return new CastDotsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CastDotsRequest | PlainMessage<CastDotsRequest> | undefined, b: CastDotsRequest | PlainMessage<CastDotsRequest> | undefined): boolean {
+    return proto3.util.equals(CastDotsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.CastDotsResponse
+ */
+export class CastDotsResponse extends Message<CastDotsResponse> {
+  constructor(data?: PartialMessage<CastDotsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.CastDotsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CastDotsResponse {
+    return new CastDotsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CastDotsResponse {
+    return new CastDotsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CastDotsResponse {
+    return new CastDotsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CastDotsResponse | PlainMessage<CastDotsResponse> | undefined, b: CastDotsResponse | PlainMessage<CastDotsResponse> | undefined): boolean {
+    return proto3.util.equals(CastDotsResponse, a, b);
+  }
+}
+
 /**
  * RevealVotesRequest reveals all votes
  *
@@ -240,7 +643,7 @@ export class RevealVotesRequest extends Message<RevealVotesRequest> {
   roomId = "";
 
   /**
-   * Must be host
+   * Must be host or facilitator
    *
    * @generated from field: string participant_id = 2;
    */
@@ -330,7 +733,7 @@ export class ResetRoundRequest extends Message<ResetRoundRequest> {
   roomId = "";
 
   /**
-   * Must be host
+   * Must be host or facilitator
    *
    * @generated from field: string participant_id = 2;
    */
@@ -414,7 +817,7 @@ export class StartRoundRequest extends Message<StartRoundRequest> {
   roomId = "";
 
   /**
-   * Must be host
+   * Must be host or facilitator
    *
    * @generated from field: string participant_id = 2;
    */
@@ -487,21 +890,300 @@ export class StartRoundResponse extends Message<StartRoundResponse> {
 }
 
 /**
- * WatchVotesRequest subscribes to vote updates
+ * UndoLastTransitionRequest undoes a recent start, reveal or reset
  *
- * @generated from message esteemed.v1.WatchVotesRequest
+ * @generated from message esteemed.v1.UndoLastTransitionRequest
  */
-export class WatchVotesRequest extends Message<WatchVotesRequest> {
+export class UndoLastTransitionRequest extends Message<UndoLastTransitionRequest> {
   /**
    * @generated from field: string room_id = 1;
    */
   roomId = "";
 
   /**
-   * @generated from field: string session_token = 2;
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
    */
   sessionToken = "";
 
+  constructor(data?: PartialMessage<UndoLastTransitionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.UndoLastTransitionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UndoLastTransitionRequest {
+    return new UndoLastTransitionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UndoLastTransitionRequest {
+    return new UndoLastTransitionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UndoLastTransitionRequest {
+    return new UndoLastTransitionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UndoLastTransitionRequest | PlainMessage<UndoLastTransitionRequest> | undefined, b: UndoLastTransitionRequest | PlainMessage<UndoLastTransitionRequest> | undefined): boolean {
+    return proto3.util.equals(UndoLastTransitionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.UndoLastTransitionResponse
+ */
+export class UndoLastTransitionResponse extends Message<UndoLastTransitionResponse> {
+  constructor(data?: PartialMessage<UndoLastTransitionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.UndoLastTransitionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UndoLastTransitionResponse {
+    return new UndoLastTransitionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UndoLastTransitionResponse {
+    return new UndoLastTransitionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UndoLastTransitionResponse {
+    return new UndoLastTransitionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UndoLastTransitionResponse | PlainMessage<UndoLastTransitionResponse> | undefined, b: UndoLastTransitionResponse | PlainMessage<UndoLastTransitionResponse> | undefined): boolean {
+    return proto3.util.equals(UndoLastTransitionResponse, a, b);
+  }
+}
+
+/**
+ * SetVotingDeadlineRequest sets the deadline of the current async round
+ *
+ * @generated from message esteemed.v1.SetVotingDeadlineRequest
+ */
+export class SetVotingDeadlineRequest extends Message<SetVotingDeadlineRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  /**
+   * Unix timestamp; votes are revealed automatically at this time
+   *
+   * @generated from field: int64 voting_deadline = 4;
+   */
+  votingDeadline = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SetVotingDeadlineRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.SetVotingDeadlineRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "voting_deadline", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetVotingDeadlineRequest {
+    return new SetVotingDeadlineRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetVotingDeadlineRequest {
+    return new SetVotingDeadlineRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetVotingDeadlineRequest {
+    return new SetVotingDeadlineRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetVotingDeadlineRequest | PlainMessage<SetVotingDeadlineRequest> | undefined, b: SetVotingDeadlineRequest | PlainMessage<SetVotingDeadlineRequest> | undefined): boolean {
+    return proto3.util.equals(SetVotingDeadlineRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.SetVotingDeadlineResponse
+ */
+export class SetVotingDeadlineResponse extends Message<SetVotingDeadlineResponse> {
+  constructor(data?: PartialMessage<SetVotingDeadlineResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.SetVotingDeadlineResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetVotingDeadlineResponse {
+    return new SetVotingDeadlineResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetVotingDeadlineResponse {
+    return new SetVotingDeadlineResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetVotingDeadlineResponse {
+    return new SetVotingDeadlineResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetVotingDeadlineResponse | PlainMessage<SetVotingDeadlineResponse> | undefined, b: SetVotingDeadlineResponse | PlainMessage<SetVotingDeadlineResponse> | undefined): boolean {
+    return proto3.util.equals(SetVotingDeadlineResponse, a, b);
+  }
+}
+
+/**
+ * GetRoundHistoryRequest fetches a room's revealed rounds
+ *
+ * @generated from message esteemed.v1.GetRoundHistoryRequest
+ */
+export class GetRoundHistoryRequest extends Message<GetRoundHistoryRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * Must belong to a participant of the room
+   *
+   * @generated from field: string session_token = 2;
+   */
+  sessionToken = "";
+
+  constructor(data?: PartialMessage<GetRoundHistoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.GetRoundHistoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRoundHistoryRequest {
+    return new GetRoundHistoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRoundHistoryRequest {
+    return new GetRoundHistoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRoundHistoryRequest {
+    return new GetRoundHistoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRoundHistoryRequest | PlainMessage<GetRoundHistoryRequest> | undefined, b: GetRoundHistoryRequest | PlainMessage<GetRoundHistoryRequest> | undefined): boolean {
+    return proto3.util.equals(GetRoundHistoryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.GetRoundHistoryResponse
+ */
+export class GetRoundHistoryResponse extends Message<GetRoundHistoryResponse> {
+  /**
+   * Oldest first
+   *
+   * @generated from field: repeated esteemed.v1.RoundResult rounds = 1;
+   */
+  rounds: RoundResult[] = [];
+
+  constructor(data?: PartialMessage<GetRoundHistoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.GetRoundHistoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rounds", kind: "message", T: RoundResult, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRoundHistoryResponse {
+    return new GetRoundHistoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRoundHistoryResponse {
+    return new GetRoundHistoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRoundHistoryResponse {
+    return new GetRoundHistoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRoundHistoryResponse | PlainMessage<GetRoundHistoryResponse> | undefined, b: GetRoundHistoryResponse | PlainMessage<GetRoundHistoryResponse> | undefined): boolean {
+    return proto3.util.equals(GetRoundHistoryResponse, a, b);
+  }
+}
+
+/**
+ * WatchVotesRequest subscribes to vote updates
+ *
+ * @generated from message esteemed.v1.WatchVotesRequest
+ */
+export class WatchVotesRequest extends Message<WatchVotesRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * @generated from field: string session_token = 2;
+   */
+  sessionToken = "";
+
+  /**
+   * Needed by non-participants if the room has a passcode
+   *
+   * @generated from field: string passcode = 3;
+   */
+  passcode = "";
+
+  /**
+   * Last sequence received on an earlier stream; the stream continues after it
+   *
+   * @generated from field: uint64 resume_after_sequence = 4;
+   */
+  resumeAfterSequence = protoInt64.zero;
+
+  /**
+   * Epoch of that sequence; a stale epoch gets a resync instead
+   *
+   * @generated from field: uint64 resume_epoch = 5;
+   */
+  resumeEpoch = protoInt64.zero;
+
   constructor(data?: PartialMessage<WatchVotesRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -512,6 +1194,9 @@ export class WatchVotesRequest extends Message<WatchVotesRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "passcode", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "resume_after_sequence", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "resume_epoch", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchVotesRequest {
@@ -532,11 +1217,26 @@ export class WatchVotesRequest extends Message<WatchVotesRequest> {
 }
 
 /**
- * VoteEvent is sent when vote state changes
+ * VoteEvent is sent when vote state changes. Events describing the state at the
+ * start of a stream or after a resync carry the sequence that state reflects.
  *
  * @generated from message esteemed.v1.VoteEvent
  */
 export class VoteEvent extends Message<VoteEvent> {
+  /**
+   * Position in the room's event sequence (heartbeats repeat the last one)
+   *
+   * @generated from field: uint64 sequence = 5;
+   */
+  sequence = protoInt64.zero;
+
+  /**
+   * Run of the room's event sequence that sequence belongs to
+   *
+   * @generated from field: uint64 epoch = 8;
+   */
+  epoch = protoInt64.zero;
+
   /**
    * @generated from oneof esteemed.v1.VoteEvent.event
    */
@@ -558,6 +1258,24 @@ export class VoteEvent extends Message<VoteEvent> {
      */
     value: RoundReset;
     case: "roundReset";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.VoteHeartbeat heartbeat = 4;
+     */
+    value: VoteHeartbeat;
+    case: "heartbeat";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.VoteResync resync = 6;
+     */
+    value: VoteResync;
+    case: "resync";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.VoteRetracted vote_retracted = 7;
+     */
+    value: VoteRetracted;
+    case: "voteRetracted";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<VoteEvent>) {
@@ -568,9 +1286,14 @@ export class VoteEvent extends Message<VoteEvent> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "esteemed.v1.VoteEvent";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 5, name: "sequence", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 8, name: "epoch", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
     { no: 1, name: "vote_cast", kind: "message", T: VoteCast, oneof: "event" },
     { no: 2, name: "votes_revealed", kind: "message", T: VotesRevealed, oneof: "event" },
     { no: 3, name: "round_reset", kind: "message", T: RoundReset, oneof: "event" },
+    { no: 4, name: "heartbeat", kind: "message", T: VoteHeartbeat, oneof: "event" },
+    { no: 6, name: "resync", kind: "message", T: VoteResync, oneof: "event" },
+    { no: 7, name: "vote_retracted", kind: "message", T: VoteRetracted, oneof: "event" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VoteEvent {
@@ -606,6 +1329,13 @@ export class VoteCast extends Message<VoteCast> {
    */
   participantName = "";
 
+  /**
+   * Entered by the host on behalf of an absent participant
+   *
+   * @generated from field: bool is_proxy = 3;
+   */
+  isProxy = false;
+
   constructor(data?: PartialMessage<VoteCast>) {
     super();
     proto3.util.initPartial(data, this);
@@ -616,6 +1346,7 @@ export class VoteCast extends Message<VoteCast> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "participant_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "is_proxy", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VoteCast {
@@ -703,3 +1434,119 @@ export class RoundReset extends Message<RoundReset> {
   }
 }
 
+/**
+ * VoteRetracted is sent when a participant's vote in the open round is dropped,
+ * such as when they become a spectator
+ *
+ * @generated from message esteemed.v1.VoteRetracted
+ */
+export class VoteRetracted extends Message<VoteRetracted> {
+  /**
+   * @generated from field: string participant_id = 1;
+   */
+  participantId = "";
+
+  constructor(data?: PartialMessage<VoteRetracted>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.VoteRetracted";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VoteRetracted {
+    return new VoteRetracted().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VoteRetracted {
+    return new VoteRetracted().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VoteRetracted {
+    return new VoteRetracted().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VoteRetracted | PlainMessage<VoteRetracted> | undefined, b: VoteRetracted | PlainMessage<VoteRetracted> | undefined): boolean {
+    return proto3.util.equals(VoteRetracted, a, b);
+  }
+}
+
+/**
+ * VoteResync is sent to a resuming stream when the events it missed are no
+ * longer available; the client discards its vote state and the events that
+ * follow rebuild it
+ *
+ * @generated from message esteemed.v1.VoteResync
+ */
+export class VoteResync extends Message<VoteResync> {
+  constructor(data?: PartialMessage<VoteResync>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.VoteResync";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VoteResync {
+    return new VoteResync().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VoteResync {
+    return new VoteResync().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VoteResync {
+    return new VoteResync().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VoteResync | PlainMessage<VoteResync> | undefined, b: VoteResync | PlainMessage<VoteResync> | undefined): boolean {
+    return proto3.util.equals(VoteResync, a, b);
+  }
+}
+
+/**
+ * VoteHeartbeat is sent periodically on an otherwise idle stream so proxies keep it open
+ *
+ * @generated from message esteemed.v1.VoteHeartbeat
+ */
+export class VoteHeartbeat extends Message<VoteHeartbeat> {
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 sent_at = 1;
+   */
+  sentAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<VoteHeartbeat>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.VoteHeartbeat";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sent_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VoteHeartbeat {
+    return new VoteHeartbeat().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VoteHeartbeat {
+    return new VoteHeartbeat().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VoteHeartbeat {
+    return new VoteHeartbeat().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VoteHeartbeat | PlainMessage<VoteHeartbeat> | undefined, b: VoteHeartbeat | PlainMessage<VoteHeartbeat> | undefined): boolean {
+    return proto3.util.equals(VoteHeartbeat, a, b);
+  }
+}
+
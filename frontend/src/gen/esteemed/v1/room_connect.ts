@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateRoomRequest, CreateRoomResponse, JoinRoomRequest, JoinRoomResponse, KickParticipantRequest, KickParticipantResponse, LeaveRoomRequest, LeaveRoomResponse, ListRoomsRequest, ListRoomsResponse, RoomEvent, TransferOwnershipRequest, TransferOwnershipResponse, WatchRoomRequest } from "./room_pb.js";
+import { AdmitParticipantRequest, AdmitParticipantResponse, ClaimHostRequest, ClaimHostResponse, CloseBreakoutRequest, CloseBreakoutResponse, CreateBreakoutRequest, CreateBreakoutResponse, CreateInviteRequest, CreateInviteResponse, CreateRoomRequest, CreateRoomResponse, CreateViewTokenRequest, CreateViewTokenResponse, GetRoomRequest, GetRoomResponse, GrantRoleRequest, GrantRoleResponse, JoinRoomRequest, JoinRoomResponse, KeepAliveRequest, KeepAliveResponse, KickParticipantRequest, KickParticipantResponse, LeaveRoomRequest, LeaveRoomResponse, LiftBanRequest, LiftBanResponse, ListBansRequest, ListBansResponse, ListRoomsRequest, ListRoomsResponse, MoveToBreakoutRequest, MoveToBreakoutResponse, RejectParticipantRequest, RejectParticipantResponse, RevokeInviteRequest, RevokeInviteResponse, RevokeRoleRequest, RevokeRoleResponse, RevokeViewTokenRequest, RevokeViewTokenResponse, RoomEvent, RoomStateEvent, SetFacilitatorRotationRequest, SetFacilitatorRotationResponse, SetHostSuccessionRequest, SetHostSuccessionResponse, SetParticipantLimitsRequest, SetParticipantLimitsResponse, SetPasscodeRequest, SetPasscodeResponse, SetRoomLockedRequest, SetRoomLockedResponse, SetSpectatorRequest, SetSpectatorResponse, SetVisibilityRequest, SetVisibilityResponse, SetWaitingRoomRequest, SetWaitingRoomResponse, TransferOwnershipRequest, TransferOwnershipResponse, UpdateParticipantRequest, UpdateParticipantResponse, WatchRoomRequest, WatchRoomStateRequest } from "./room_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -15,7 +15,7 @@ export const RoomService = {
   typeName: "esteemed.v1.RoomService",
   methods: {
     /**
-     * ListRooms returns all active rooms
+     * ListRooms returns all active public rooms
      *
      * @generated from rpc esteemed.v1.RoomService.ListRooms
      */
@@ -70,7 +70,53 @@ export const RoomService = {
       kind: MethodKind.ServerStreaming,
     },
     /**
-     * KickParticipant removes a participant from the room (host only)
+     * WatchRoomState streams the room's room and vote events in order, starting
+     * with a snapshot. Replaces watching WatchRoom and WatchVotes side by side.
+     *
+     * @generated from rpc esteemed.v1.RoomService.WatchRoomState
+     */
+    watchRoomState: {
+      name: "WatchRoomState",
+      I: WatchRoomStateRequest,
+      O: RoomStateEvent,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * GetRoom returns a snapshot of the room, its round and, once revealed, the
+     * results to anyone allowed to watch it. Unlike JoinRoom it changes nothing.
+     *
+     * @generated from rpc esteemed.v1.RoomService.GetRoom
+     */
+    getRoom: {
+      name: "GetRoom",
+      I: GetRoomRequest,
+      O: GetRoomResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CreateViewToken issues a read-only token for shared screens, replacing any earlier one (host or facilitator)
+     *
+     * @generated from rpc esteemed.v1.RoomService.CreateViewToken
+     */
+    createViewToken: {
+      name: "CreateViewToken",
+      I: CreateViewTokenRequest,
+      O: CreateViewTokenResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RevokeViewToken stops the room's view token from working (host or facilitator)
+     *
+     * @generated from rpc esteemed.v1.RoomService.RevokeViewToken
+     */
+    revokeViewToken: {
+      name: "RevokeViewToken",
+      I: RevokeViewTokenRequest,
+      O: RevokeViewTokenResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * KickParticipant removes a participant from the room (host or facilitator)
      *
      * @generated from rpc esteemed.v1.RoomService.KickParticipant
      */
@@ -80,6 +126,28 @@ export const RoomService = {
       O: KickParticipantResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * ListBans returns who has been banned from the room (host or facilitator)
+     *
+     * @generated from rpc esteemed.v1.RoomService.ListBans
+     */
+    listBans: {
+      name: "ListBans",
+      I: ListBansRequest,
+      O: ListBansResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * LiftBan lets a banned participant join the room again (host or facilitator)
+     *
+     * @generated from rpc esteemed.v1.RoomService.LiftBan
+     */
+    liftBan: {
+      name: "LiftBan",
+      I: LiftBanRequest,
+      O: LiftBanResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * TransferOwnership transfers host privileges to another participant
      *
@@ -91,6 +159,226 @@ export const RoomService = {
       O: TransferOwnershipResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * GrantRole makes a participant a facilitator, voter or spectator (owner only)
+     *
+     * @generated from rpc esteemed.v1.RoomService.GrantRole
+     */
+    grantRole: {
+      name: "GrantRole",
+      I: GrantRoleRequest,
+      O: GrantRoleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RevokeRole returns a participant to the voter role (owner only)
+     *
+     * @generated from rpc esteemed.v1.RoomService.RevokeRole
+     */
+    revokeRole: {
+      name: "RevokeRole",
+      I: RevokeRoleRequest,
+      O: RevokeRoleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * UpdateParticipant changes the caller's name, avatar seed or color
+     *
+     * @generated from rpc esteemed.v1.RoomService.UpdateParticipant
+     */
+    updateParticipant: {
+      name: "UpdateParticipant",
+      I: UpdateParticipantRequest,
+      O: UpdateParticipantResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SetPasscode sets or, when empty, removes the room's passcode (owner only)
+     *
+     * @generated from rpc esteemed.v1.RoomService.SetPasscode
+     */
+    setPasscode: {
+      name: "SetPasscode",
+      I: SetPasscodeRequest,
+      O: SetPasscodeResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SetVisibility changes who can find and join the room (owner only)
+     *
+     * @generated from rpc esteemed.v1.RoomService.SetVisibility
+     */
+    setVisibility: {
+      name: "SetVisibility",
+      I: SetVisibilityRequest,
+      O: SetVisibilityResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CreateInvite creates a signed invite link that joins the room as a voter or spectator (host or facilitator)
+     *
+     * @generated from rpc esteemed.v1.RoomService.CreateInvite
+     */
+    createInvite: {
+      name: "CreateInvite",
+      I: CreateInviteRequest,
+      O: CreateInviteResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RevokeInvite stops an invite link from being used (host or facilitator)
+     *
+     * @generated from rpc esteemed.v1.RoomService.RevokeInvite
+     */
+    revokeInvite: {
+      name: "RevokeInvite",
+      I: RevokeInviteRequest,
+      O: RevokeInviteResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SetRoomLocked locks or unlocks the room to new participants; reconnects still work (host or facilitator)
+     *
+     * @generated from rpc esteemed.v1.RoomService.SetRoomLocked
+     */
+    setRoomLocked: {
+      name: "SetRoomLocked",
+      I: SetRoomLockedRequest,
+      O: SetRoomLockedResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SetWaitingRoom makes new participants wait until they are admitted (owner only)
+     *
+     * @generated from rpc esteemed.v1.RoomService.SetWaitingRoom
+     */
+    setWaitingRoom: {
+      name: "SetWaitingRoom",
+      I: SetWaitingRoomRequest,
+      O: SetWaitingRoomResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * AdmitParticipant lets a participant in from the waiting room (host or facilitator)
+     *
+     * @generated from rpc esteemed.v1.RoomService.AdmitParticipant
+     */
+    admitParticipant: {
+      name: "AdmitParticipant",
+      I: AdmitParticipantRequest,
+      O: AdmitParticipantResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RejectParticipant turns away a participant in the waiting room (host or facilitator)
+     *
+     * @generated from rpc esteemed.v1.RoomService.RejectParticipant
+     */
+    rejectParticipant: {
+      name: "RejectParticipant",
+      I: RejectParticipantRequest,
+      O: RejectParticipantResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SetParticipantLimits caps how many voters and spectators the room takes (owner only)
+     *
+     * @generated from rpc esteemed.v1.RoomService.SetParticipantLimits
+     */
+    setParticipantLimits: {
+      name: "SetParticipantLimits",
+      I: SetParticipantLimitsRequest,
+      O: SetParticipantLimitsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SetSpectator switches a participant between voting and spectating
+     *
+     * @generated from rpc esteemed.v1.RoomService.SetSpectator
+     */
+    setSpectator: {
+      name: "SetSpectator",
+      I: SetSpectatorRequest,
+      O: SetSpectatorResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SetFacilitatorRotation hands the facilitator role to the next voter for every story (owner only)
+     *
+     * @generated from rpc esteemed.v1.RoomService.SetFacilitatorRotation
+     */
+    setFacilitatorRotation: {
+      name: "SetFacilitatorRotation",
+      I: SetFacilitatorRotationRequest,
+      O: SetFacilitatorRotationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SetHostSuccession sets who takes over when the host disconnects (owner only)
+     *
+     * @generated from rpc esteemed.v1.RoomService.SetHostSuccession
+     */
+    setHostSuccession: {
+      name: "SetHostSuccession",
+      I: SetHostSuccessionRequest,
+      O: SetHostSuccessionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ClaimHost makes the caller host of a room that has none
+     *
+     * @generated from rpc esteemed.v1.RoomService.ClaimHost
+     */
+    claimHost: {
+      name: "ClaimHost",
+      I: ClaimHostRequest,
+      O: ClaimHostResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * KeepAlive resets the room's inactivity timer (any participant)
+     *
+     * @generated from rpc esteemed.v1.RoomService.KeepAlive
+     */
+    keepAlive: {
+      name: "KeepAlive",
+      I: KeepAliveRequest,
+      O: KeepAliveResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CreateBreakout creates a child room for a sub-team (host or facilitator)
+     *
+     * @generated from rpc esteemed.v1.RoomService.CreateBreakout
+     */
+    createBreakout: {
+      name: "CreateBreakout",
+      I: CreateBreakoutRequest,
+      O: CreateBreakoutResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * MoveToBreakout moves a participant into one of the room's breakouts (host or facilitator)
+     *
+     * @generated from rpc esteemed.v1.RoomService.MoveToBreakout
+     */
+    moveToBreakout: {
+      name: "MoveToBreakout",
+      I: MoveToBreakoutRequest,
+      O: MoveToBreakoutResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CloseBreakout closes a breakout and merges its results into the parent (host or facilitator)
+     *
+     * @generated from rpc esteemed.v1.RoomService.CloseBreakout
+     */
+    closeBreakout: {
+      name: "CloseBreakout",
+      I: CloseBreakoutRequest,
+      O: CloseBreakoutResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -5,6 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { Vote, VoteEvent, VoteSummary } from "./estimation_pb.js";
 
 /**
  * CardPreset represents predefined card deck types
@@ -46,6 +47,82 @@ proto3.util.setEnumType(CardPreset, "esteemed.v1.CardPreset", [
   { no: 6, name: "CARD_PRESET_CUSTOM" },
 ]);
 
+/**
+ * RoomMode selects how participants vote in a room
+ *
+ * @generated from enum esteemed.v1.RoomMode
+ */
+export enum RoomMode {
+  /**
+   * @generated from enum value: ROOM_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * One card per participant (planning poker)
+   *
+   * @generated from enum value: ROOM_MODE_ESTIMATION = 1;
+   */
+  ESTIMATION = 1,
+
+  /**
+   * Dots spread across options (prioritization)
+   *
+   * @generated from enum value: ROOM_MODE_DOT_VOTING = 2;
+   */
+  DOT_VOTING = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(RoomMode)
+proto3.util.setEnumType(RoomMode, "esteemed.v1.RoomMode", [
+  { no: 0, name: "ROOM_MODE_UNSPECIFIED" },
+  { no: 1, name: "ROOM_MODE_ESTIMATION" },
+  { no: 2, name: "ROOM_MODE_DOT_VOTING" },
+]);
+
+/**
+ * ParticipantRole is what a participant may do in a room
+ *
+ * @generated from enum esteemed.v1.ParticipantRole
+ */
+export enum ParticipantRole {
+  /**
+   * @generated from enum value: PARTICIPANT_ROLE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The host; also manages roles and room settings
+   *
+   * @generated from enum value: PARTICIPANT_ROLE_OWNER = 1;
+   */
+  OWNER = 1,
+
+  /**
+   * Runs rounds and manages participants
+   *
+   * @generated from enum value: PARTICIPANT_ROLE_FACILITATOR = 2;
+   */
+  FACILITATOR = 2,
+
+  /**
+   * @generated from enum value: PARTICIPANT_ROLE_VOTER = 3;
+   */
+  VOTER = 3,
+
+  /**
+   * @generated from enum value: PARTICIPANT_ROLE_SPECTATOR = 4;
+   */
+  SPECTATOR = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ParticipantRole)
+proto3.util.setEnumType(ParticipantRole, "esteemed.v1.ParticipantRole", [
+  { no: 0, name: "PARTICIPANT_ROLE_UNSPECIFIED" },
+  { no: 1, name: "PARTICIPANT_ROLE_OWNER" },
+  { no: 2, name: "PARTICIPANT_ROLE_FACILITATOR" },
+  { no: 3, name: "PARTICIPANT_ROLE_VOTER" },
+  { no: 4, name: "PARTICIPANT_ROLE_SPECTATOR" },
+]);
+
 /**
  * RoomState represents the current phase of estimation
  *
@@ -86,6 +163,236 @@ proto3.util.setEnumType(RoomState, "esteemed.v1.RoomState", [
   { no: 3, name: "ROOM_STATE_REVEALED" },
 ]);
 
+/**
+ * RoomVisibility decides who can find and join a room
+ *
+ * @generated from enum esteemed.v1.RoomVisibility
+ */
+export enum RoomVisibility {
+  /**
+   * Same as public
+   *
+   * @generated from enum value: ROOM_VISIBILITY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Listed and joinable by anyone
+   *
+   * @generated from enum value: ROOM_VISIBILITY_PUBLIC = 1;
+   */
+  PUBLIC = 1,
+
+  /**
+   * Joinable by room ID, but not listed or found by name
+   *
+   * @generated from enum value: ROOM_VISIBILITY_UNLISTED = 2;
+   */
+  UNLISTED = 2,
+
+  /**
+   * Not listed; new participants need an invite token
+   *
+   * @generated from enum value: ROOM_VISIBILITY_PRIVATE = 3;
+   */
+  PRIVATE = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(RoomVisibility)
+proto3.util.setEnumType(RoomVisibility, "esteemed.v1.RoomVisibility", [
+  { no: 0, name: "ROOM_VISIBILITY_UNSPECIFIED" },
+  { no: 1, name: "ROOM_VISIBILITY_PUBLIC" },
+  { no: 2, name: "ROOM_VISIBILITY_UNLISTED" },
+  { no: 3, name: "ROOM_VISIBILITY_PRIVATE" },
+]);
+
+/**
+ * HostSuccession decides who becomes host when the host disconnects
+ *
+ * @generated from enum esteemed.v1.HostSuccession
+ */
+export enum HostSuccession {
+  /**
+   * Same as earliest joiner
+   *
+   * @generated from enum value: HOST_SUCCESSION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Earliest connected joiner takes over
+   *
+   * @generated from enum value: HOST_SUCCESSION_EARLIEST_JOINER = 1;
+   */
+  EARLIEST_JOINER = 1,
+
+  /**
+   * Earliest joiner stands in until the host reconnects within the grace period
+   *
+   * @generated from enum value: HOST_SUCCESSION_RESTORE_ORIGINAL = 2;
+   */
+  RESTORE_ORIGINAL = 2,
+
+  /**
+   * The room stays host-less until someone calls ClaimHost
+   *
+   * @generated from enum value: HOST_SUCCESSION_MANUAL_CLAIM = 3;
+   */
+  MANUAL_CLAIM = 3,
+
+  /**
+   * The named deputy takes over, else the earliest joiner
+   *
+   * @generated from enum value: HOST_SUCCESSION_DEPUTY = 4;
+   */
+  DEPUTY = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(HostSuccession)
+proto3.util.setEnumType(HostSuccession, "esteemed.v1.HostSuccession", [
+  { no: 0, name: "HOST_SUCCESSION_UNSPECIFIED" },
+  { no: 1, name: "HOST_SUCCESSION_EARLIEST_JOINER" },
+  { no: 2, name: "HOST_SUCCESSION_RESTORE_ORIGINAL" },
+  { no: 3, name: "HOST_SUCCESSION_MANUAL_CLAIM" },
+  { no: 4, name: "HOST_SUCCESSION_DEPUTY" },
+]);
+
+/**
+ * CloseReason is why a room was closed
+ *
+ * @generated from enum esteemed.v1.CloseReason
+ */
+export enum CloseReason {
+  /**
+   * @generated from enum value: CLOSE_REASON_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The last participant left or was kicked
+   *
+   * @generated from enum value: CLOSE_REASON_ALL_LEFT = 1;
+   */
+  ALL_LEFT = 1,
+
+  /**
+   * The room expired after its inactivity timeout
+   *
+   * @generated from enum value: CLOSE_REASON_INACTIVITY = 2;
+   */
+  INACTIVITY = 2,
+
+  /**
+   * The parent's host closed this breakout
+   *
+   * @generated from enum value: CLOSE_REASON_BREAKOUT_CLOSED = 3;
+   */
+  BREAKOUT_CLOSED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(CloseReason)
+proto3.util.setEnumType(CloseReason, "esteemed.v1.CloseReason", [
+  { no: 0, name: "CLOSE_REASON_UNSPECIFIED" },
+  { no: 1, name: "CLOSE_REASON_ALL_LEFT" },
+  { no: 2, name: "CLOSE_REASON_INACTIVITY" },
+  { no: 3, name: "CLOSE_REASON_BREAKOUT_CLOSED" },
+]);
+
+/**
+ * HostChangeReason is why the host changed
+ *
+ * @generated from enum esteemed.v1.HostChangeReason
+ */
+export enum HostChangeReason {
+  /**
+   * @generated from enum value: HOST_CHANGE_REASON_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The host handed over
+   *
+   * @generated from enum value: HOST_CHANGE_REASON_TRANSFERRED = 1;
+   */
+  TRANSFERRED = 1,
+
+  /**
+   * A connected participant took over from a missing host
+   *
+   * @generated from enum value: HOST_CHANGE_REASON_SUCCEEDED = 2;
+   */
+  SUCCEEDED = 2,
+
+  /**
+   * The named deputy took over
+   *
+   * @generated from enum value: HOST_CHANGE_REASON_DEPUTY = 3;
+   */
+  DEPUTY = 3,
+
+  /**
+   * The original host reconnected and took the room back
+   *
+   * @generated from enum value: HOST_CHANGE_REASON_RESTORED = 4;
+   */
+  RESTORED = 4,
+
+  /**
+   * The host left and the room waits for a claim
+   *
+   * @generated from enum value: HOST_CHANGE_REASON_VACATED = 5;
+   */
+  VACATED = 5,
+
+  /**
+   * A participant claimed the host-less room
+   *
+   * @generated from enum value: HOST_CHANGE_REASON_CLAIMED = 6;
+   */
+  CLAIMED = 6,
+}
+// Retrieve enum metadata with: proto3.getEnumType(HostChangeReason)
+proto3.util.setEnumType(HostChangeReason, "esteemed.v1.HostChangeReason", [
+  { no: 0, name: "HOST_CHANGE_REASON_UNSPECIFIED" },
+  { no: 1, name: "HOST_CHANGE_REASON_TRANSFERRED" },
+  { no: 2, name: "HOST_CHANGE_REASON_SUCCEEDED" },
+  { no: 3, name: "HOST_CHANGE_REASON_DEPUTY" },
+  { no: 4, name: "HOST_CHANGE_REASON_RESTORED" },
+  { no: 5, name: "HOST_CHANGE_REASON_VACATED" },
+  { no: 6, name: "HOST_CHANGE_REASON_CLAIMED" },
+]);
+
+/**
+ * RoomTransition is a round state change the host can undo
+ *
+ * @generated from enum esteemed.v1.RoomTransition
+ */
+export enum RoomTransition {
+  /**
+   * @generated from enum value: ROOM_TRANSITION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ROOM_TRANSITION_START = 1;
+   */
+  START = 1,
+
+  /**
+   * @generated from enum value: ROOM_TRANSITION_REVEAL = 2;
+   */
+  REVEAL = 2,
+
+  /**
+   * @generated from enum value: ROOM_TRANSITION_RESET = 3;
+   */
+  RESET = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(RoomTransition)
+proto3.util.setEnumType(RoomTransition, "esteemed.v1.RoomTransition", [
+  { no: 0, name: "ROOM_TRANSITION_UNSPECIFIED" },
+  { no: 1, name: "ROOM_TRANSITION_START" },
+  { no: 2, name: "ROOM_TRANSITION_REVEAL" },
+  { no: 3, name: "ROOM_TRANSITION_RESET" },
+]);
+
 /**
  * Card represents a single card in the deck
  *
@@ -192,6 +499,55 @@ export class CardConfig extends Message<CardConfig> {
   }
 }
 
+/**
+ * DotVoteConfig configures a dot-voting room
+ *
+ * @generated from message esteemed.v1.DotVoteConfig
+ */
+export class DotVoteConfig extends Message<DotVoteConfig> {
+  /**
+   * Stories or options to spread dots across
+   *
+   * @generated from field: repeated string options = 1;
+   */
+  options: string[] = [];
+
+  /**
+   * Number of dots each participant may place
+   *
+   * @generated from field: int32 dots_per_participant = 2;
+   */
+  dotsPerParticipant = 0;
+
+  constructor(data?: PartialMessage<DotVoteConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.DotVoteConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "options", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "dots_per_participant", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DotVoteConfig {
+    return new DotVoteConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DotVoteConfig {
+    return new DotVoteConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DotVoteConfig {
+    return new DotVoteConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DotVoteConfig | PlainMessage<DotVoteConfig> | undefined, b: DotVoteConfig | PlainMessage<DotVoteConfig> | undefined): boolean {
+    return proto3.util.equals(DotVoteConfig, a, b);
+  }
+}
+
 /**
  * Room represents a planning poker room
  *
@@ -230,74 +586,263 @@ export class Room extends Message<Room> {
    */
   cardConfig?: CardConfig;
 
-  constructor(data?: PartialMessage<Room>) {
-    super();
-    proto3.util.initPartial(data, this);
-  }
+  /**
+   * @generated from field: esteemed.v1.RoomMode mode = 7;
+   */
+  mode = RoomMode.UNSPECIFIED;
 
-  static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "esteemed.v1.Room";
-  static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "participants", kind: "message", T: Participant, repeated: true },
-    { no: 4, name: "state", kind: "enum", T: proto3.getEnumType(RoomState) },
-    { no: 5, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
-    { no: 6, name: "card_config", kind: "message", T: CardConfig },
-  ]);
+  /**
+   * Only set for dot-voting rooms
+   *
+   * @generated from field: esteemed.v1.DotVoteConfig dot_vote_config = 8;
+   */
+  dotVoteConfig?: DotVoteConfig;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Room {
-    return new Room().fromBinary(bytes, options);
-  }
+  /**
+   * Set when this room is a breakout
+   *
+   * @generated from field: string parent_room_id = 9;
+   */
+  parentRoomId = "";
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Room {
-    return new Room().fromJson(jsonValue, options);
-  }
+  /**
+   * Open breakouts of this room
+   *
+   * @generated from field: repeated string breakout_room_ids = 10;
+   */
+  breakoutRoomIds: string[] = [];
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Room {
-    return new Room().fromJsonString(jsonString, options);
-  }
+  /**
+   * Votes stay open until a deadline instead of a live session
+   *
+   * @generated from field: bool async = 11;
+   */
+  async = false;
 
-  static equals(a: Room | PlainMessage<Room> | undefined, b: Room | PlainMessage<Room> | undefined): boolean {
-    return proto3.util.equals(Room, a, b);
-  }
-}
+  /**
+   * Auto-reveal time of the current async round (0 if none)
+   *
+   * @generated from field: int64 voting_deadline = 12;
+   */
+  votingDeadline = protoInt64.zero;
 
-/**
- * Participant in a room
- *
- * @generated from message esteemed.v1.Participant
- */
-export class Participant extends Message<Participant> {
   /**
-   * @generated from field: string id = 1;
+   * Long-lived team room that is never cleaned up
+   *
+   * @generated from field: bool persistent = 13;
    */
-  id = "";
+  persistent = false;
 
   /**
-   * @generated from field: string name = 2;
+   * Custom inactivity timeout (0 uses the deployment default)
+   *
+   * @generated from field: int32 inactivity_timeout_minutes = 14;
    */
-  name = "";
+  inactivityTimeoutMinutes = 0;
 
   /**
-   * @generated from field: bool is_host = 3;
+   * Who takes over when the host disconnects
+   *
+   * @generated from field: esteemed.v1.HostSuccession host_succession = 15;
    */
-  isHost = false;
+  hostSuccession = HostSuccession.UNSPECIFIED;
 
   /**
-   * @generated from field: bool is_connected = 4;
+   * Next host under the deputy policy (empty if none)
+   *
+   * @generated from field: string deputy_id = 16;
    */
-  isConnected = false;
+  deputyId = "";
 
   /**
-   * @generated from field: int64 joined_at = 5;
+   * The facilitator role moves to the next voter for every story
+   *
+   * @generated from field: bool rotate_facilitator = 17;
    */
-  joinedAt = protoInt64.zero;
+  rotateFacilitator = false;
 
   /**
-   * @generated from field: bool is_spectator = 6;
+   * Disconnected participants are kept instead of removed after a grace period
+   *
+   * @generated from field: bool keep_disconnected = 18;
    */
-  isSpectator = false;
+  keepDisconnected = false;
+
+  /**
+   * @generated from field: esteemed.v1.RoomVisibility visibility = 19;
+   */
+  visibility = RoomVisibility.UNSPECIFIED;
+
+  /**
+   * Newcomers need a passcode to join or watch
+   *
+   * @generated from field: bool has_passcode = 20;
+   */
+  hasPasscode = false;
+
+  /**
+   * New participants are turned away
+   *
+   * @generated from field: bool locked = 21;
+   */
+  locked = false;
+
+  /**
+   * New participants wait until they are admitted
+   *
+   * @generated from field: bool waiting_room = 22;
+   */
+  waitingRoom = false;
+
+  /**
+   * Waiting to be admitted, in the order they asked
+   *
+   * @generated from field: repeated esteemed.v1.Participant pending_participants = 23;
+   */
+  pendingParticipants: Participant[] = [];
+
+  /**
+   * Connected voters the room takes (0 for no limit)
+   *
+   * @generated from field: int32 max_voters = 24;
+   */
+  maxVoters = 0;
+
+  /**
+   * Connected spectators the room takes (0 for no limit)
+   *
+   * @generated from field: int32 max_spectators = 25;
+   */
+  maxSpectators = 0;
+
+  /**
+   * A read-only view token is active
+   *
+   * @generated from field: bool has_view_token = 26;
+   */
+  hasViewToken = false;
+
+  constructor(data?: PartialMessage<Room>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.Room";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "participants", kind: "message", T: Participant, repeated: true },
+    { no: 4, name: "state", kind: "enum", T: proto3.getEnumType(RoomState) },
+    { no: 5, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "card_config", kind: "message", T: CardConfig },
+    { no: 7, name: "mode", kind: "enum", T: proto3.getEnumType(RoomMode) },
+    { no: 8, name: "dot_vote_config", kind: "message", T: DotVoteConfig },
+    { no: 9, name: "parent_room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "breakout_room_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 11, name: "async", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 12, name: "voting_deadline", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 13, name: "persistent", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 14, name: "inactivity_timeout_minutes", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 15, name: "host_succession", kind: "enum", T: proto3.getEnumType(HostSuccession) },
+    { no: 16, name: "deputy_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 17, name: "rotate_facilitator", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 18, name: "keep_disconnected", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 19, name: "visibility", kind: "enum", T: proto3.getEnumType(RoomVisibility) },
+    { no: 20, name: "has_passcode", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 21, name: "locked", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 22, name: "waiting_room", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 23, name: "pending_participants", kind: "message", T: Participant, repeated: true },
+    { no: 24, name: "max_voters", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 25, name: "max_spectators", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 26, name: "has_view_token", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Room {
+    return new Room().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Room {
+    return new Room().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Room {
+    return new Room().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Room | PlainMessage<Room> | undefined, b: Room | PlainMessage<Room> | undefined): boolean {
+    return proto3.util.equals(Room, a, b);
+  }
+}
+
+/**
+ * Participant in a room
+ *
+ * @generated from message esteemed.v1.Participant
+ */
+export class Participant extends Message<Participant> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: bool is_host = 3;
+   */
+  isHost = false;
+
+  /**
+   * @generated from field: bool is_connected = 4;
+   */
+  isConnected = false;
+
+  /**
+   * @generated from field: int64 joined_at = 5;
+   */
+  joinedAt = protoInt64.zero;
+
+  /**
+   * @generated from field: bool is_spectator = 6;
+   */
+  isSpectator = false;
+
+  /**
+   * Breakout the participant was moved into (parent room only)
+   *
+   * @generated from field: string breakout_room_id = 7;
+   */
+  breakoutRoomId = "";
+
+  /**
+   * Absent person added by the host for proxy voting
+   *
+   * @generated from field: bool is_placeholder = 8;
+   */
+  isPlaceholder = false;
+
+  /**
+   * @generated from field: esteemed.v1.ParticipantRole role = 9;
+   */
+  role = ParticipantRole.UNSPECIFIED;
+
+  /**
+   * Seed for the generated avatar (empty for the default)
+   *
+   * @generated from field: string avatar_seed = 10;
+   */
+  avatarSeed = "";
+
+  /**
+   * Hex color like #1a2b3c (empty for the default)
+   *
+   * @generated from field: string color = 11;
+   */
+  color = "";
 
   constructor(data?: PartialMessage<Participant>) {
     super();
@@ -313,6 +858,11 @@ export class Participant extends Message<Participant> {
     { no: 4, name: "is_connected", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 5, name: "joined_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 6, name: "is_spectator", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "breakout_room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "is_placeholder", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "role", kind: "enum", T: proto3.getEnumType(ParticipantRole) },
+    { no: 10, name: "avatar_seed", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "color", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Participant {
@@ -359,6 +909,111 @@ export class CreateRoomRequest extends Message<CreateRoomRequest> {
    */
   cardConfig?: CardConfig;
 
+  /**
+   * Optional room mode (defaults to estimation)
+   *
+   * @generated from field: esteemed.v1.RoomMode mode = 4;
+   */
+  mode = RoomMode.UNSPECIFIED;
+
+  /**
+   * Required when mode is dot voting
+   *
+   * @generated from field: esteemed.v1.DotVoteConfig dot_vote_config = 5;
+   */
+  dotVoteConfig?: DotVoteConfig;
+
+  /**
+   * Keep rounds open for voting until a deadline
+   *
+   * @generated from field: bool async = 6;
+   */
+  async = false;
+
+  /**
+   * Optional deadline of the first async round; opens voting right away
+   *
+   * @generated from field: int64 voting_deadline = 7;
+   */
+  votingDeadline = protoInt64.zero;
+
+  /**
+   * Keep the room between sessions; requires a slug
+   *
+   * @generated from field: bool persistent = 8;
+   */
+  persistent = false;
+
+  /**
+   * Custom room name (e.g. "platform-team"): 3-48 lowercase letters, digits or hyphens
+   *
+   * @generated from field: string slug = 9;
+   */
+  slug = "";
+
+  /**
+   * Optional custom inactivity timeout (5-1440 minutes)
+   *
+   * @generated from field: int32 inactivity_timeout_minutes = 10;
+   */
+  inactivityTimeoutMinutes = 0;
+
+  /**
+   * Optional succession policy (defaults to earliest joiner)
+   *
+   * @generated from field: esteemed.v1.HostSuccession host_succession = 11;
+   */
+  hostSuccession = HostSuccession.UNSPECIFIED;
+
+  /**
+   * Hand the facilitator role to the next voter for every story
+   *
+   * @generated from field: bool rotate_facilitator = 12;
+   */
+  rotateFacilitator = false;
+
+  /**
+   * Keep disconnected participants instead of removing them after a grace period
+   *
+   * @generated from field: bool keep_disconnected = 13;
+   */
+  keepDisconnected = false;
+
+  /**
+   * Optional visibility (defaults to public)
+   *
+   * @generated from field: esteemed.v1.RoomVisibility visibility = 14;
+   */
+  visibility = RoomVisibility.UNSPECIFIED;
+
+  /**
+   * Optional passcode newcomers need (4-64 characters)
+   *
+   * @generated from field: string passcode = 15;
+   */
+  passcode = "";
+
+  /**
+   * New participants wait until the host admits them
+   *
+   * @generated from field: bool waiting_room = 16;
+   */
+  waitingRoom = false;
+
+  /**
+   * Optional voter limit (capped at, and defaults to, the server's limit)
+   *
+   * @generated from field: int32 max_voters = 17;
+   */
+  maxVoters = 0;
+
+  /**
+   * Optional spectator limit (capped at, and defaults to, the server's limit)
+   *
+   * @generated from field: int32 max_spectators = 18;
+   */
+  maxSpectators = 0;
+
   constructor(data?: PartialMessage<CreateRoomRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -370,194 +1025,3994 @@ export class CreateRoomRequest extends Message<CreateRoomRequest> {
     { no: 1, name: "host_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "card_config", kind: "message", T: CardConfig },
+    { no: 4, name: "mode", kind: "enum", T: proto3.getEnumType(RoomMode) },
+    { no: 5, name: "dot_vote_config", kind: "message", T: DotVoteConfig },
+    { no: 6, name: "async", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "voting_deadline", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "persistent", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "slug", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "inactivity_timeout_minutes", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 11, name: "host_succession", kind: "enum", T: proto3.getEnumType(HostSuccession) },
+    { no: 12, name: "rotate_facilitator", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 13, name: "keep_disconnected", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 14, name: "visibility", kind: "enum", T: proto3.getEnumType(RoomVisibility) },
+    { no: 15, name: "passcode", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 16, name: "waiting_room", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 17, name: "max_voters", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 18, name: "max_spectators", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateRoomRequest {
+    return new CreateRoomRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateRoomRequest {
+    return new CreateRoomRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateRoomRequest {
+    return new CreateRoomRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateRoomRequest | PlainMessage<CreateRoomRequest> | undefined, b: CreateRoomRequest | PlainMessage<CreateRoomRequest> | undefined): boolean {
+    return proto3.util.equals(CreateRoomRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.CreateRoomResponse
+ */
+export class CreateRoomResponse extends Message<CreateRoomResponse> {
+  /**
+   * @generated from field: esteemed.v1.Room room = 1;
+   */
+  room?: Room;
+
+  /**
+   * Token for reconnection
+   *
+   * @generated from field: string session_token = 2;
+   */
+  sessionToken = "";
+
+  /**
+   * ID of the host participant
+   *
+   * @generated from field: string participant_id = 3;
+   */
+  participantId = "";
+
+  constructor(data?: PartialMessage<CreateRoomResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.CreateRoomResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room", kind: "message", T: Room },
+    { no: 2, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateRoomResponse {
+    return new CreateRoomResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateRoomResponse {
+    return new CreateRoomResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateRoomResponse {
+    return new CreateRoomResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateRoomResponse | PlainMessage<CreateRoomResponse> | undefined, b: CreateRoomResponse | PlainMessage<CreateRoomResponse> | undefined): boolean {
+    return proto3.util.equals(CreateRoomResponse, a, b);
+  }
+}
+
+/**
+ * JoinRoomRequest adds a participant
+ *
+ * @generated from message esteemed.v1.JoinRoomRequest
+ */
+export class JoinRoomRequest extends Message<JoinRoomRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * @generated from field: string participant_name = 2;
+   */
+  participantName = "";
+
+  /**
+   * Optional: for reconnection
+   *
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  /**
+   * Join as spectator (watch only)
+   *
+   * @generated from field: bool is_spectator = 4;
+   */
+  isSpectator = false;
+
+  /**
+   * Required for new participants of private rooms; the invite decides is_spectator
+   *
+   * @generated from field: string invite_token = 5;
+   */
+  inviteToken = "";
+
+  /**
+   * Required for new participants if the room has a passcode, unless invited
+   *
+   * @generated from field: string passcode = 6;
+   */
+  passcode = "";
+
+  constructor(data?: PartialMessage<JoinRoomRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.JoinRoomRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "is_spectator", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "invite_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "passcode", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JoinRoomRequest {
+    return new JoinRoomRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JoinRoomRequest {
+    return new JoinRoomRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JoinRoomRequest {
+    return new JoinRoomRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JoinRoomRequest | PlainMessage<JoinRoomRequest> | undefined, b: JoinRoomRequest | PlainMessage<JoinRoomRequest> | undefined): boolean {
+    return proto3.util.equals(JoinRoomRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.JoinRoomResponse
+ */
+export class JoinRoomResponse extends Message<JoinRoomResponse> {
+  /**
+   * @generated from field: esteemed.v1.Room room = 1;
+   */
+  room?: Room;
+
+  /**
+   * @generated from field: string session_token = 2;
+   */
+  sessionToken = "";
+
+  /**
+   * @generated from field: string participant_id = 3;
+   */
+  participantId = "";
+
+  /**
+   * In the waiting room; WatchRoom with the session token for the decision
+   *
+   * @generated from field: bool pending = 4;
+   */
+  pending = false;
+
+  constructor(data?: PartialMessage<JoinRoomResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.JoinRoomResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room", kind: "message", T: Room },
+    { no: 2, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "pending", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JoinRoomResponse {
+    return new JoinRoomResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JoinRoomResponse {
+    return new JoinRoomResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JoinRoomResponse {
+    return new JoinRoomResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JoinRoomResponse | PlainMessage<JoinRoomResponse> | undefined, b: JoinRoomResponse | PlainMessage<JoinRoomResponse> | undefined): boolean {
+    return proto3.util.equals(JoinRoomResponse, a, b);
+  }
+}
+
+/**
+ * LeaveRoomRequest removes a participant
+ *
+ * @generated from message esteemed.v1.LeaveRoomRequest
+ */
+export class LeaveRoomRequest extends Message<LeaveRoomRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  constructor(data?: PartialMessage<LeaveRoomRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.LeaveRoomRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LeaveRoomRequest {
+    return new LeaveRoomRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LeaveRoomRequest {
+    return new LeaveRoomRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LeaveRoomRequest {
+    return new LeaveRoomRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LeaveRoomRequest | PlainMessage<LeaveRoomRequest> | undefined, b: LeaveRoomRequest | PlainMessage<LeaveRoomRequest> | undefined): boolean {
+    return proto3.util.equals(LeaveRoomRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.LeaveRoomResponse
+ */
+export class LeaveRoomResponse extends Message<LeaveRoomResponse> {
+  constructor(data?: PartialMessage<LeaveRoomResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.LeaveRoomResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LeaveRoomResponse {
+    return new LeaveRoomResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LeaveRoomResponse {
+    return new LeaveRoomResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LeaveRoomResponse {
+    return new LeaveRoomResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LeaveRoomResponse | PlainMessage<LeaveRoomResponse> | undefined, b: LeaveRoomResponse | PlainMessage<LeaveRoomResponse> | undefined): boolean {
+    return proto3.util.equals(LeaveRoomResponse, a, b);
+  }
+}
+
+/**
+ * ListRoomsRequest gets all active rooms
+ *
+ * @generated from message esteemed.v1.ListRoomsRequest
+ */
+export class ListRoomsRequest extends Message<ListRoomsRequest> {
+  constructor(data?: PartialMessage<ListRoomsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.ListRoomsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRoomsRequest {
+    return new ListRoomsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRoomsRequest {
+    return new ListRoomsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRoomsRequest {
+    return new ListRoomsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListRoomsRequest | PlainMessage<ListRoomsRequest> | undefined, b: ListRoomsRequest | PlainMessage<ListRoomsRequest> | undefined): boolean {
+    return proto3.util.equals(ListRoomsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.ListRoomsResponse
+ */
+export class ListRoomsResponse extends Message<ListRoomsResponse> {
+  /**
+   * @generated from field: repeated esteemed.v1.RoomSummary rooms = 1;
+   */
+  rooms: RoomSummary[] = [];
+
+  constructor(data?: PartialMessage<ListRoomsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.ListRoomsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rooms", kind: "message", T: RoomSummary, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRoomsResponse {
+    return new ListRoomsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRoomsResponse {
+    return new ListRoomsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRoomsResponse {
+    return new ListRoomsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListRoomsResponse | PlainMessage<ListRoomsResponse> | undefined, b: ListRoomsResponse | PlainMessage<ListRoomsResponse> | undefined): boolean {
+    return proto3.util.equals(ListRoomsResponse, a, b);
+  }
+}
+
+/**
+ * RoomSummary is a brief view of a room for listing
+ *
+ * @generated from message esteemed.v1.RoomSummary
+ */
+export class RoomSummary extends Message<RoomSummary> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: int32 participant_count = 3;
+   */
+  participantCount = 0;
+
+  /**
+   * @generated from field: esteemed.v1.RoomState state = 4;
+   */
+  state = RoomState.UNSPECIFIED;
+
+  /**
+   * @generated from field: int64 created_at = 5;
+   */
+  createdAt = protoInt64.zero;
+
+  /**
+   * 0 for persistent rooms
+   *
+   * @generated from field: int64 expires_at = 6;
+   */
+  expiresAt = protoInt64.zero;
+
+  /**
+   * @generated from field: bool persistent = 7;
+   */
+  persistent = false;
+
+  constructor(data?: PartialMessage<RoomSummary>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.RoomSummary";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "participant_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "state", kind: "enum", T: proto3.getEnumType(RoomState) },
+    { no: 5, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "persistent", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoomSummary {
+    return new RoomSummary().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoomSummary {
+    return new RoomSummary().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoomSummary {
+    return new RoomSummary().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RoomSummary | PlainMessage<RoomSummary> | undefined, b: RoomSummary | PlainMessage<RoomSummary> | undefined): boolean {
+    return proto3.util.equals(RoomSummary, a, b);
+  }
+}
+
+/**
+ * WatchRoomRequest subscribes to room updates
+ *
+ * @generated from message esteemed.v1.WatchRoomRequest
+ */
+export class WatchRoomRequest extends Message<WatchRoomRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * @generated from field: string session_token = 2;
+   */
+  sessionToken = "";
+
+  /**
+   * Needed by non-participants if the room has a passcode
+   *
+   * @generated from field: string passcode = 3;
+   */
+  passcode = "";
+
+  /**
+   * Last sequence received on an earlier stream; the stream continues after it
+   *
+   * @generated from field: uint64 resume_after_sequence = 4;
+   */
+  resumeAfterSequence = protoInt64.zero;
+
+  /**
+   * Epoch of that sequence; a stale epoch gets a resync instead
+   *
+   * @generated from field: uint64 resume_epoch = 5;
+   */
+  resumeEpoch = protoInt64.zero;
+
+  constructor(data?: PartialMessage<WatchRoomRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.WatchRoomRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "passcode", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "resume_after_sequence", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "resume_epoch", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchRoomRequest {
+    return new WatchRoomRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchRoomRequest {
+    return new WatchRoomRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchRoomRequest {
+    return new WatchRoomRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchRoomRequest | PlainMessage<WatchRoomRequest> | undefined, b: WatchRoomRequest | PlainMessage<WatchRoomRequest> | undefined): boolean {
+    return proto3.util.equals(WatchRoomRequest, a, b);
+  }
+}
+
+/**
+ * RoomEvent is sent when room state changes. Room and vote events share one
+ * per-room sequence, so a room stream skips the numbers of vote events. The
+ * sequence starts over in a new epoch whenever the server loses it, such as
+ * after a restart.
+ *
+ * @generated from message esteemed.v1.RoomEvent
+ */
+export class RoomEvent extends Message<RoomEvent> {
+  /**
+   * Position in the room's event sequence (heartbeats repeat the last one)
+   *
+   * @generated from field: uint64 sequence = 27;
+   */
+  sequence = protoInt64.zero;
+
+  /**
+   * Run of the room's event sequence that sequence belongs to
+   *
+   * @generated from field: uint64 epoch = 28;
+   */
+  epoch = protoInt64.zero;
+
+  /**
+   * @generated from oneof esteemed.v1.RoomEvent.event
+   */
+  event: {
+    /**
+     * @generated from field: esteemed.v1.ParticipantJoined participant_joined = 1;
+     */
+    value: ParticipantJoined;
+    case: "participantJoined";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.ParticipantLeft participant_left = 2;
+     */
+    value: ParticipantLeft;
+    case: "participantLeft";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.RoomStateChanged state_changed = 3;
+     */
+    value: RoomStateChanged;
+    case: "stateChanged";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.RoomClosed room_closed = 4;
+     */
+    value: RoomClosed;
+    case: "roomClosed";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.HostChanged host_changed = 5;
+     */
+    value: HostChanged;
+    case: "hostChanged";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.ParticipantMoved participant_moved = 6;
+     */
+    value: ParticipantMoved;
+    case: "participantMoved";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.BreakoutClosed breakout_closed = 7;
+     */
+    value: BreakoutClosed;
+    case: "breakoutClosed";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.TransitionUndone transition_undone = 8;
+     */
+    value: TransitionUndone;
+    case: "transitionUndone";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.DeadlineChanged deadline_changed = 9;
+     */
+    value: DeadlineChanged;
+    case: "deadlineChanged";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.ExpiryWarning expiry_warning = 10;
+     */
+    value: ExpiryWarning;
+    case: "expiryWarning";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.KeptAlive kept_alive = 11;
+     */
+    value: KeptAlive;
+    case: "keptAlive";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.HostSuccessionChanged host_succession_changed = 12;
+     */
+    value: HostSuccessionChanged;
+    case: "hostSuccessionChanged";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.ParticipantUpdated participant_updated = 13;
+     */
+    value: ParticipantUpdated;
+    case: "participantUpdated";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.FacilitatorRotationChanged facilitator_rotation_changed = 14;
+     */
+    value: FacilitatorRotationChanged;
+    case: "facilitatorRotationChanged";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.Heartbeat heartbeat = 15;
+     */
+    value: Heartbeat;
+    case: "heartbeat";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.VisibilityChanged visibility_changed = 16;
+     */
+    value: VisibilityChanged;
+    case: "visibilityChanged";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.PasscodeChanged passcode_changed = 17;
+     */
+    value: PasscodeChanged;
+    case: "passcodeChanged";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.RoomLockChanged lock_changed = 18;
+     */
+    value: RoomLockChanged;
+    case: "lockChanged";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.WaitingRoomChanged waiting_room_changed = 19;
+     */
+    value: WaitingRoomChanged;
+    case: "waitingRoomChanged";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.JoinRequested join_requested = 20;
+     */
+    value: JoinRequested;
+    case: "joinRequested";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.JoinAdmitted join_admitted = 21;
+     */
+    value: JoinAdmitted;
+    case: "joinAdmitted";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.JoinRejected join_rejected = 22;
+     */
+    value: JoinRejected;
+    case: "joinRejected";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.ParticipantRemoved participant_removed = 23;
+     */
+    value: ParticipantRemoved;
+    case: "participantRemoved";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.ParticipantLimitsChanged participant_limits_changed = 24;
+     */
+    value: ParticipantLimitsChanged;
+    case: "participantLimitsChanged";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.ViewTokenRevoked view_token_revoked = 25;
+     */
+    value: ViewTokenRevoked;
+    case: "viewTokenRevoked";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.Resync resync = 26;
+     */
+    value: Resync;
+    case: "resync";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<RoomEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.RoomEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 27, name: "sequence", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 28, name: "epoch", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 1, name: "participant_joined", kind: "message", T: ParticipantJoined, oneof: "event" },
+    { no: 2, name: "participant_left", kind: "message", T: ParticipantLeft, oneof: "event" },
+    { no: 3, name: "state_changed", kind: "message", T: RoomStateChanged, oneof: "event" },
+    { no: 4, name: "room_closed", kind: "message", T: RoomClosed, oneof: "event" },
+    { no: 5, name: "host_changed", kind: "message", T: HostChanged, oneof: "event" },
+    { no: 6, name: "participant_moved", kind: "message", T: ParticipantMoved, oneof: "event" },
+    { no: 7, name: "breakout_closed", kind: "message", T: BreakoutClosed, oneof: "event" },
+    { no: 8, name: "transition_undone", kind: "message", T: TransitionUndone, oneof: "event" },
+    { no: 9, name: "deadline_changed", kind: "message", T: DeadlineChanged, oneof: "event" },
+    { no: 10, name: "expiry_warning", kind: "message", T: ExpiryWarning, oneof: "event" },
+    { no: 11, name: "kept_alive", kind: "message", T: KeptAlive, oneof: "event" },
+    { no: 12, name: "host_succession_changed", kind: "message", T: HostSuccessionChanged, oneof: "event" },
+    { no: 13, name: "participant_updated", kind: "message", T: ParticipantUpdated, oneof: "event" },
+    { no: 14, name: "facilitator_rotation_changed", kind: "message", T: FacilitatorRotationChanged, oneof: "event" },
+    { no: 15, name: "heartbeat", kind: "message", T: Heartbeat, oneof: "event" },
+    { no: 16, name: "visibility_changed", kind: "message", T: VisibilityChanged, oneof: "event" },
+    { no: 17, name: "passcode_changed", kind: "message", T: PasscodeChanged, oneof: "event" },
+    { no: 18, name: "lock_changed", kind: "message", T: RoomLockChanged, oneof: "event" },
+    { no: 19, name: "waiting_room_changed", kind: "message", T: WaitingRoomChanged, oneof: "event" },
+    { no: 20, name: "join_requested", kind: "message", T: JoinRequested, oneof: "event" },
+    { no: 21, name: "join_admitted", kind: "message", T: JoinAdmitted, oneof: "event" },
+    { no: 22, name: "join_rejected", kind: "message", T: JoinRejected, oneof: "event" },
+    { no: 23, name: "participant_removed", kind: "message", T: ParticipantRemoved, oneof: "event" },
+    { no: 24, name: "participant_limits_changed", kind: "message", T: ParticipantLimitsChanged, oneof: "event" },
+    { no: 25, name: "view_token_revoked", kind: "message", T: ViewTokenRevoked, oneof: "event" },
+    { no: 26, name: "resync", kind: "message", T: Resync, oneof: "event" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoomEvent {
+    return new RoomEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoomEvent {
+    return new RoomEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoomEvent {
+    return new RoomEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RoomEvent | PlainMessage<RoomEvent> | undefined, b: RoomEvent | PlainMessage<RoomEvent> | undefined): boolean {
+    return proto3.util.equals(RoomEvent, a, b);
+  }
+}
+
+/**
+ * Resync is sent to a resuming stream when the events it missed are no longer
+ * available; the snapshot replaces the client's state as of the event's sequence
+ *
+ * @generated from message esteemed.v1.Resync
+ */
+export class Resync extends Message<Resync> {
+  /**
+   * @generated from field: esteemed.v1.RoomSnapshot snapshot = 1;
+   */
+  snapshot?: RoomSnapshot;
+
+  constructor(data?: PartialMessage<Resync>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.Resync";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "snapshot", kind: "message", T: RoomSnapshot },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Resync {
+    return new Resync().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Resync {
+    return new Resync().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Resync {
+    return new Resync().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Resync | PlainMessage<Resync> | undefined, b: Resync | PlainMessage<Resync> | undefined): boolean {
+    return proto3.util.equals(Resync, a, b);
+  }
+}
+
+/**
+ * Heartbeat is sent periodically on an otherwise idle stream so proxies keep it
+ * open; a participant whose stream stops receiving them is disconnected
+ *
+ * @generated from message esteemed.v1.Heartbeat
+ */
+export class Heartbeat extends Message<Heartbeat> {
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 sent_at = 1;
+   */
+  sentAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Heartbeat>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.Heartbeat";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sent_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Heartbeat {
+    return new Heartbeat().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Heartbeat {
+    return new Heartbeat().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Heartbeat {
+    return new Heartbeat().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Heartbeat | PlainMessage<Heartbeat> | undefined, b: Heartbeat | PlainMessage<Heartbeat> | undefined): boolean {
+    return proto3.util.equals(Heartbeat, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.ParticipantJoined
+ */
+export class ParticipantJoined extends Message<ParticipantJoined> {
+  /**
+   * @generated from field: esteemed.v1.Participant participant = 1;
+   */
+  participant?: Participant;
+
+  constructor(data?: PartialMessage<ParticipantJoined>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.ParticipantJoined";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "participant", kind: "message", T: Participant },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ParticipantJoined {
+    return new ParticipantJoined().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ParticipantJoined {
+    return new ParticipantJoined().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ParticipantJoined {
+    return new ParticipantJoined().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ParticipantJoined | PlainMessage<ParticipantJoined> | undefined, b: ParticipantJoined | PlainMessage<ParticipantJoined> | undefined): boolean {
+    return proto3.util.equals(ParticipantJoined, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.ParticipantLeft
+ */
+export class ParticipantLeft extends Message<ParticipantLeft> {
+  /**
+   * @generated from field: string participant_id = 1;
+   */
+  participantId = "";
+
+  constructor(data?: PartialMessage<ParticipantLeft>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.ParticipantLeft";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ParticipantLeft {
+    return new ParticipantLeft().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ParticipantLeft {
+    return new ParticipantLeft().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ParticipantLeft {
+    return new ParticipantLeft().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ParticipantLeft | PlainMessage<ParticipantLeft> | undefined, b: ParticipantLeft | PlainMessage<ParticipantLeft> | undefined): boolean {
+    return proto3.util.equals(ParticipantLeft, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.RoomStateChanged
+ */
+export class RoomStateChanged extends Message<RoomStateChanged> {
+  /**
+   * @generated from field: esteemed.v1.RoomState new_state = 1;
+   */
+  newState = RoomState.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<RoomStateChanged>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.RoomStateChanged";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "new_state", kind: "enum", T: proto3.getEnumType(RoomState) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoomStateChanged {
+    return new RoomStateChanged().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoomStateChanged {
+    return new RoomStateChanged().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoomStateChanged {
+    return new RoomStateChanged().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RoomStateChanged | PlainMessage<RoomStateChanged> | undefined, b: RoomStateChanged | PlainMessage<RoomStateChanged> | undefined): boolean {
+    return proto3.util.equals(RoomStateChanged, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.RoomClosed
+ */
+export class RoomClosed extends Message<RoomClosed> {
+  /**
+   * Human-readable reason
+   *
+   * @generated from field: string reason = 1;
+   */
+  reason = "";
+
+  /**
+   * @generated from field: esteemed.v1.CloseReason close_reason = 2;
+   */
+  closeReason = CloseReason.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<RoomClosed>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.RoomClosed";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "close_reason", kind: "enum", T: proto3.getEnumType(CloseReason) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoomClosed {
+    return new RoomClosed().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoomClosed {
+    return new RoomClosed().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoomClosed {
+    return new RoomClosed().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RoomClosed | PlainMessage<RoomClosed> | undefined, b: RoomClosed | PlainMessage<RoomClosed> | undefined): boolean {
+    return proto3.util.equals(RoomClosed, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.HostChanged
+ */
+export class HostChanged extends Message<HostChanged> {
+  /**
+   * Empty when the room is now host-less
+   *
+   * @generated from field: string new_host_id = 1;
+   */
+  newHostId = "";
+
+  /**
+   * @generated from field: string previous_host_id = 2;
+   */
+  previousHostId = "";
+
+  /**
+   * @generated from field: esteemed.v1.HostChangeReason reason = 3;
+   */
+  reason = HostChangeReason.UNSPECIFIED;
+
+  /**
+   * Until when the previous host takes the room back on reconnect (0 if not)
+   *
+   * @generated from field: int64 return_deadline = 4;
+   */
+  returnDeadline = protoInt64.zero;
+
+  constructor(data?: PartialMessage<HostChanged>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.HostChanged";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "new_host_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "previous_host_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "reason", kind: "enum", T: proto3.getEnumType(HostChangeReason) },
+    { no: 4, name: "return_deadline", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HostChanged {
+    return new HostChanged().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HostChanged {
+    return new HostChanged().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HostChanged {
+    return new HostChanged().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: HostChanged | PlainMessage<HostChanged> | undefined, b: HostChanged | PlainMessage<HostChanged> | undefined): boolean {
+    return proto3.util.equals(HostChanged, a, b);
+  }
+}
+
+/**
+ * ParticipantUpdated is sent when a participant's role or details change
+ *
+ * @generated from message esteemed.v1.ParticipantUpdated
+ */
+export class ParticipantUpdated extends Message<ParticipantUpdated> {
+  /**
+   * @generated from field: esteemed.v1.Participant participant = 1;
+   */
+  participant?: Participant;
+
+  constructor(data?: PartialMessage<ParticipantUpdated>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.ParticipantUpdated";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "participant", kind: "message", T: Participant },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ParticipantUpdated {
+    return new ParticipantUpdated().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ParticipantUpdated {
+    return new ParticipantUpdated().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ParticipantUpdated {
+    return new ParticipantUpdated().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ParticipantUpdated | PlainMessage<ParticipantUpdated> | undefined, b: ParticipantUpdated | PlainMessage<ParticipantUpdated> | undefined): boolean {
+    return proto3.util.equals(ParticipantUpdated, a, b);
+  }
+}
+
+/**
+ * FacilitatorRotationChanged is sent when the owner turns facilitator rotation on or off
+ *
+ * @generated from message esteemed.v1.FacilitatorRotationChanged
+ */
+export class FacilitatorRotationChanged extends Message<FacilitatorRotationChanged> {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled = false;
+
+  constructor(data?: PartialMessage<FacilitatorRotationChanged>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.FacilitatorRotationChanged";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FacilitatorRotationChanged {
+    return new FacilitatorRotationChanged().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FacilitatorRotationChanged {
+    return new FacilitatorRotationChanged().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FacilitatorRotationChanged {
+    return new FacilitatorRotationChanged().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FacilitatorRotationChanged | PlainMessage<FacilitatorRotationChanged> | undefined, b: FacilitatorRotationChanged | PlainMessage<FacilitatorRotationChanged> | undefined): boolean {
+    return proto3.util.equals(FacilitatorRotationChanged, a, b);
+  }
+}
+
+/**
+ * VisibilityChanged is sent when the owner changes who can find and join the room
+ *
+ * @generated from message esteemed.v1.VisibilityChanged
+ */
+export class VisibilityChanged extends Message<VisibilityChanged> {
+  /**
+   * @generated from field: esteemed.v1.RoomVisibility visibility = 1;
+   */
+  visibility = RoomVisibility.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<VisibilityChanged>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.VisibilityChanged";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "visibility", kind: "enum", T: proto3.getEnumType(RoomVisibility) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VisibilityChanged {
+    return new VisibilityChanged().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VisibilityChanged {
+    return new VisibilityChanged().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VisibilityChanged {
+    return new VisibilityChanged().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VisibilityChanged | PlainMessage<VisibilityChanged> | undefined, b: VisibilityChanged | PlainMessage<VisibilityChanged> | undefined): boolean {
+    return proto3.util.equals(VisibilityChanged, a, b);
+  }
+}
+
+/**
+ * PasscodeChanged is sent when the owner sets or removes the passcode
+ *
+ * @generated from message esteemed.v1.PasscodeChanged
+ */
+export class PasscodeChanged extends Message<PasscodeChanged> {
+  /**
+   * @generated from field: bool has_passcode = 1;
+   */
+  hasPasscode = false;
+
+  constructor(data?: PartialMessage<PasscodeChanged>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.PasscodeChanged";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "has_passcode", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PasscodeChanged {
+    return new PasscodeChanged().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PasscodeChanged {
+    return new PasscodeChanged().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PasscodeChanged {
+    return new PasscodeChanged().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PasscodeChanged | PlainMessage<PasscodeChanged> | undefined, b: PasscodeChanged | PlainMessage<PasscodeChanged> | undefined): boolean {
+    return proto3.util.equals(PasscodeChanged, a, b);
+  }
+}
+
+/**
+ * ParticipantRemoved is sent when the host or a facilitator kicks a participant.
+ * The removed participant's own stream ends after it.
+ *
+ * @generated from message esteemed.v1.ParticipantRemoved
+ */
+export class ParticipantRemoved extends Message<ParticipantRemoved> {
+  /**
+   * @generated from field: string participant_id = 1;
+   */
+  participantId = "";
+
+  /**
+   * They cannot rejoin until the ban is lifted
+   *
+   * @generated from field: bool banned = 2;
+   */
+  banned = false;
+
+  constructor(data?: PartialMessage<ParticipantRemoved>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.ParticipantRemoved";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "banned", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ParticipantRemoved {
+    return new ParticipantRemoved().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ParticipantRemoved {
+    return new ParticipantRemoved().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ParticipantRemoved {
+    return new ParticipantRemoved().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ParticipantRemoved | PlainMessage<ParticipantRemoved> | undefined, b: ParticipantRemoved | PlainMessage<ParticipantRemoved> | undefined): boolean {
+    return proto3.util.equals(ParticipantRemoved, a, b);
+  }
+}
+
+/**
+ * ParticipantLimitsChanged is sent when the owner changes the room's participant limits
+ *
+ * @generated from message esteemed.v1.ParticipantLimitsChanged
+ */
+export class ParticipantLimitsChanged extends Message<ParticipantLimitsChanged> {
+  /**
+   * @generated from field: int32 max_voters = 1;
+   */
+  maxVoters = 0;
+
+  /**
+   * @generated from field: int32 max_spectators = 2;
+   */
+  maxSpectators = 0;
+
+  constructor(data?: PartialMessage<ParticipantLimitsChanged>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.ParticipantLimitsChanged";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "max_voters", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "max_spectators", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ParticipantLimitsChanged {
+    return new ParticipantLimitsChanged().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ParticipantLimitsChanged {
+    return new ParticipantLimitsChanged().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ParticipantLimitsChanged {
+    return new ParticipantLimitsChanged().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ParticipantLimitsChanged | PlainMessage<ParticipantLimitsChanged> | undefined, b: ParticipantLimitsChanged | PlainMessage<ParticipantLimitsChanged> | undefined): boolean {
+    return proto3.util.equals(ParticipantLimitsChanged, a, b);
+  }
+}
+
+/**
+ * ViewTokenRevoked is sent when the view token is revoked or replaced. Streams
+ * opened with it end after it.
+ *
+ * @generated from message esteemed.v1.ViewTokenRevoked
+ */
+export class ViewTokenRevoked extends Message<ViewTokenRevoked> {
+  constructor(data?: PartialMessage<ViewTokenRevoked>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.ViewTokenRevoked";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ViewTokenRevoked {
+    return new ViewTokenRevoked().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ViewTokenRevoked {
+    return new ViewTokenRevoked().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ViewTokenRevoked {
+    return new ViewTokenRevoked().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ViewTokenRevoked | PlainMessage<ViewTokenRevoked> | undefined, b: ViewTokenRevoked | PlainMessage<ViewTokenRevoked> | undefined): boolean {
+    return proto3.util.equals(ViewTokenRevoked, a, b);
+  }
+}
+
+/**
+ * RoomLockChanged is sent when the room is locked or unlocked
+ *
+ * @generated from message esteemed.v1.RoomLockChanged
+ */
+export class RoomLockChanged extends Message<RoomLockChanged> {
+  /**
+   * @generated from field: bool locked = 1;
+   */
+  locked = false;
+
+  constructor(data?: PartialMessage<RoomLockChanged>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.RoomLockChanged";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "locked", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoomLockChanged {
+    return new RoomLockChanged().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoomLockChanged {
+    return new RoomLockChanged().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoomLockChanged {
+    return new RoomLockChanged().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RoomLockChanged | PlainMessage<RoomLockChanged> | undefined, b: RoomLockChanged | PlainMessage<RoomLockChanged> | undefined): boolean {
+    return proto3.util.equals(RoomLockChanged, a, b);
+  }
+}
+
+/**
+ * WaitingRoomChanged is sent when the owner turns the waiting room on or off
+ *
+ * @generated from message esteemed.v1.WaitingRoomChanged
+ */
+export class WaitingRoomChanged extends Message<WaitingRoomChanged> {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled = false;
+
+  constructor(data?: PartialMessage<WaitingRoomChanged>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.WaitingRoomChanged";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WaitingRoomChanged {
+    return new WaitingRoomChanged().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WaitingRoomChanged {
+    return new WaitingRoomChanged().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WaitingRoomChanged {
+    return new WaitingRoomChanged().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WaitingRoomChanged | PlainMessage<WaitingRoomChanged> | undefined, b: WaitingRoomChanged | PlainMessage<WaitingRoomChanged> | undefined): boolean {
+    return proto3.util.equals(WaitingRoomChanged, a, b);
+  }
+}
+
+/**
+ * JoinRequested is sent when a new participant enters the waiting room
+ *
+ * @generated from message esteemed.v1.JoinRequested
+ */
+export class JoinRequested extends Message<JoinRequested> {
+  /**
+   * @generated from field: esteemed.v1.Participant participant = 1;
+   */
+  participant?: Participant;
+
+  constructor(data?: PartialMessage<JoinRequested>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.JoinRequested";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "participant", kind: "message", T: Participant },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JoinRequested {
+    return new JoinRequested().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JoinRequested {
+    return new JoinRequested().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JoinRequested {
+    return new JoinRequested().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JoinRequested | PlainMessage<JoinRequested> | undefined, b: JoinRequested | PlainMessage<JoinRequested> | undefined): boolean {
+    return proto3.util.equals(JoinRequested, a, b);
+  }
+}
+
+/**
+ * JoinAdmitted is sent to the room and the waiting participant when they are let in
+ *
+ * @generated from message esteemed.v1.JoinAdmitted
+ */
+export class JoinAdmitted extends Message<JoinAdmitted> {
+  /**
+   * @generated from field: esteemed.v1.Participant participant = 1;
+   */
+  participant?: Participant;
+
+  constructor(data?: PartialMessage<JoinAdmitted>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.JoinAdmitted";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "participant", kind: "message", T: Participant },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JoinAdmitted {
+    return new JoinAdmitted().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JoinAdmitted {
+    return new JoinAdmitted().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JoinAdmitted {
+    return new JoinAdmitted().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JoinAdmitted | PlainMessage<JoinAdmitted> | undefined, b: JoinAdmitted | PlainMessage<JoinAdmitted> | undefined): boolean {
+    return proto3.util.equals(JoinAdmitted, a, b);
+  }
+}
+
+/**
+ * JoinRejected is sent to the room and the waiting participant when they are turned away
+ *
+ * @generated from message esteemed.v1.JoinRejected
+ */
+export class JoinRejected extends Message<JoinRejected> {
+  /**
+   * @generated from field: string participant_id = 1;
+   */
+  participantId = "";
+
+  constructor(data?: PartialMessage<JoinRejected>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.JoinRejected";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JoinRejected {
+    return new JoinRejected().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JoinRejected {
+    return new JoinRejected().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JoinRejected {
+    return new JoinRejected().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JoinRejected | PlainMessage<JoinRejected> | undefined, b: JoinRejected | PlainMessage<JoinRejected> | undefined): boolean {
+    return proto3.util.equals(JoinRejected, a, b);
+  }
+}
+
+/**
+ * HostSuccessionChanged is sent when the host changes the succession policy
+ *
+ * @generated from message esteemed.v1.HostSuccessionChanged
+ */
+export class HostSuccessionChanged extends Message<HostSuccessionChanged> {
+  /**
+   * @generated from field: esteemed.v1.HostSuccession host_succession = 1;
+   */
+  hostSuccession = HostSuccession.UNSPECIFIED;
+
+  /**
+   * @generated from field: string deputy_id = 2;
+   */
+  deputyId = "";
+
+  constructor(data?: PartialMessage<HostSuccessionChanged>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.HostSuccessionChanged";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "host_succession", kind: "enum", T: proto3.getEnumType(HostSuccession) },
+    { no: 2, name: "deputy_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HostSuccessionChanged {
+    return new HostSuccessionChanged().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HostSuccessionChanged {
+    return new HostSuccessionChanged().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HostSuccessionChanged {
+    return new HostSuccessionChanged().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: HostSuccessionChanged | PlainMessage<HostSuccessionChanged> | undefined, b: HostSuccessionChanged | PlainMessage<HostSuccessionChanged> | undefined): boolean {
+    return proto3.util.equals(HostSuccessionChanged, a, b);
+  }
+}
+
+/**
+ * ParticipantMoved tells the participant's client to navigate to another room
+ *
+ * @generated from message esteemed.v1.ParticipantMoved
+ */
+export class ParticipantMoved extends Message<ParticipantMoved> {
+  /**
+   * @generated from field: string participant_id = 1;
+   */
+  participantId = "";
+
+  /**
+   * Room the participant was moved to
+   *
+   * @generated from field: string room_id = 2;
+   */
+  roomId = "";
+
+  /**
+   * @generated from field: string room_name = 3;
+   */
+  roomName = "";
+
+  constructor(data?: PartialMessage<ParticipantMoved>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.ParticipantMoved";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "room_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ParticipantMoved {
+    return new ParticipantMoved().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ParticipantMoved {
+    return new ParticipantMoved().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ParticipantMoved {
+    return new ParticipantMoved().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ParticipantMoved | PlainMessage<ParticipantMoved> | undefined, b: ParticipantMoved | PlainMessage<ParticipantMoved> | undefined): boolean {
+    return proto3.util.equals(ParticipantMoved, a, b);
+  }
+}
+
+/**
+ * BreakoutClosed is sent to the parent when a breakout's results were merged back
+ *
+ * @generated from message esteemed.v1.BreakoutClosed
+ */
+export class BreakoutClosed extends Message<BreakoutClosed> {
+  /**
+   * @generated from field: string breakout_room_id = 1;
+   */
+  breakoutRoomId = "";
+
+  /**
+   * @generated from field: int32 rounds_merged = 2;
+   */
+  roundsMerged = 0;
+
+  constructor(data?: PartialMessage<BreakoutClosed>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.BreakoutClosed";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "breakout_room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rounds_merged", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BreakoutClosed {
+    return new BreakoutClosed().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BreakoutClosed {
+    return new BreakoutClosed().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BreakoutClosed {
+    return new BreakoutClosed().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: BreakoutClosed | PlainMessage<BreakoutClosed> | undefined, b: BreakoutClosed | PlainMessage<BreakoutClosed> | undefined): boolean {
+    return proto3.util.equals(BreakoutClosed, a, b);
+  }
+}
+
+/**
+ * TransitionUndone is sent when the host undid a transition; clients should resync their votes
+ *
+ * @generated from message esteemed.v1.TransitionUndone
+ */
+export class TransitionUndone extends Message<TransitionUndone> {
+  /**
+   * @generated from field: esteemed.v1.RoomTransition transition = 1;
+   */
+  transition = RoomTransition.UNSPECIFIED;
+
+  /**
+   * @generated from field: esteemed.v1.RoomState new_state = 2;
+   */
+  newState = RoomState.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<TransitionUndone>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.TransitionUndone";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "transition", kind: "enum", T: proto3.getEnumType(RoomTransition) },
+    { no: 2, name: "new_state", kind: "enum", T: proto3.getEnumType(RoomState) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransitionUndone {
+    return new TransitionUndone().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransitionUndone {
+    return new TransitionUndone().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransitionUndone {
+    return new TransitionUndone().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TransitionUndone | PlainMessage<TransitionUndone> | undefined, b: TransitionUndone | PlainMessage<TransitionUndone> | undefined): boolean {
+    return proto3.util.equals(TransitionUndone, a, b);
+  }
+}
+
+/**
+ * DeadlineChanged is sent when the host sets the voting deadline of an async round
+ *
+ * @generated from message esteemed.v1.DeadlineChanged
+ */
+export class DeadlineChanged extends Message<DeadlineChanged> {
+  /**
+   * @generated from field: int64 voting_deadline = 1;
+   */
+  votingDeadline = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DeadlineChanged>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.DeadlineChanged";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "voting_deadline", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeadlineChanged {
+    return new DeadlineChanged().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeadlineChanged {
+    return new DeadlineChanged().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeadlineChanged {
+    return new DeadlineChanged().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeadlineChanged | PlainMessage<DeadlineChanged> | undefined, b: DeadlineChanged | PlainMessage<DeadlineChanged> | undefined): boolean {
+    return proto3.util.equals(DeadlineChanged, a, b);
+  }
+}
+
+/**
+ * ExpiryWarning is sent shortly before an inactive room is closed
+ *
+ * @generated from message esteemed.v1.ExpiryWarning
+ */
+export class ExpiryWarning extends Message<ExpiryWarning> {
+  /**
+   * @generated from field: int64 expires_at = 1;
+   */
+  expiresAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ExpiryWarning>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.ExpiryWarning";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExpiryWarning {
+    return new ExpiryWarning().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExpiryWarning {
+    return new ExpiryWarning().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExpiryWarning {
+    return new ExpiryWarning().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExpiryWarning | PlainMessage<ExpiryWarning> | undefined, b: ExpiryWarning | PlainMessage<ExpiryWarning> | undefined): boolean {
+    return proto3.util.equals(ExpiryWarning, a, b);
+  }
+}
+
+/**
+ * KeptAlive is sent when a participant extended the room
+ *
+ * @generated from message esteemed.v1.KeptAlive
+ */
+export class KeptAlive extends Message<KeptAlive> {
+  /**
+   * @generated from field: string participant_id = 1;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: int64 expires_at = 2;
+   */
+  expiresAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<KeptAlive>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.KeptAlive";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KeptAlive {
+    return new KeptAlive().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KeptAlive {
+    return new KeptAlive().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KeptAlive {
+    return new KeptAlive().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KeptAlive | PlainMessage<KeptAlive> | undefined, b: KeptAlive | PlainMessage<KeptAlive> | undefined): boolean {
+    return proto3.util.equals(KeptAlive, a, b);
+  }
+}
+
+/**
+ * WatchRoomStateRequest opens the combined room stream
+ *
+ * @generated from message esteemed.v1.WatchRoomStateRequest
+ */
+export class WatchRoomStateRequest extends Message<WatchRoomStateRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * A participant's session token or the room's view token
+   *
+   * @generated from field: string session_token = 2;
+   */
+  sessionToken = "";
+
+  /**
+   * Needed by anyone else if the room has a passcode
+   *
+   * @generated from field: string passcode = 3;
+   */
+  passcode = "";
+
+  /**
+   * Last sequence received on an earlier stream; the stream continues after it
+   *
+   * @generated from field: uint64 resume_after_sequence = 4;
+   */
+  resumeAfterSequence = protoInt64.zero;
+
+  /**
+   * Epoch of that sequence; a stale epoch gets a resync instead
+   *
+   * @generated from field: uint64 resume_epoch = 5;
+   */
+  resumeEpoch = protoInt64.zero;
+
+  constructor(data?: PartialMessage<WatchRoomStateRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.WatchRoomStateRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "passcode", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "resume_after_sequence", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "resume_epoch", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchRoomStateRequest {
+    return new WatchRoomStateRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchRoomStateRequest {
+    return new WatchRoomStateRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchRoomStateRequest {
+    return new WatchRoomStateRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchRoomStateRequest | PlainMessage<WatchRoomStateRequest> | undefined, b: WatchRoomStateRequest | PlainMessage<WatchRoomStateRequest> | undefined): boolean {
+    return proto3.util.equals(WatchRoomStateRequest, a, b);
+  }
+}
+
+/**
+ * RoomStateEvent is one message of the combined room stream. The first carries
+ * a snapshot as of its sequence, unless the stream resumes; each later room or
+ * vote event carries the next sequence number. Events may repeat changes the
+ * snapshot already shows. Heartbeats repeat the last sequence sent. When missed
+ * events can no longer be replayed, a snapshot marked resync replaces the
+ * client's state.
+ *
+ * @generated from message esteemed.v1.RoomStateEvent
+ */
+export class RoomStateEvent extends Message<RoomStateEvent> {
+  /**
+   * @generated from field: uint64 sequence = 1;
+   */
+  sequence = protoInt64.zero;
+
+  /**
+   * Run of the room's event sequence that sequence belongs to
+   *
+   * @generated from field: uint64 epoch = 6;
+   */
+  epoch = protoInt64.zero;
+
+  /**
+   * @generated from oneof esteemed.v1.RoomStateEvent.event
+   */
+  event: {
+    /**
+     * @generated from field: esteemed.v1.RoomSnapshot snapshot = 2;
+     */
+    value: RoomSnapshot;
+    case: "snapshot";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.RoomEvent room_event = 3;
+     */
+    value: RoomEvent;
+    case: "roomEvent";
+  } | {
+    /**
+     * @generated from field: esteemed.v1.VoteEvent vote_event = 4;
+     */
+    value: VoteEvent;
+    case: "voteEvent";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * The snapshot was sent because events were missed
+   *
+   * @generated from field: bool resync = 5;
+   */
+  resync = false;
+
+  constructor(data?: PartialMessage<RoomStateEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.RoomStateEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sequence", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 6, name: "epoch", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "snapshot", kind: "message", T: RoomSnapshot, oneof: "event" },
+    { no: 3, name: "room_event", kind: "message", T: RoomEvent, oneof: "event" },
+    { no: 4, name: "vote_event", kind: "message", T: VoteEvent, oneof: "event" },
+    { no: 5, name: "resync", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoomStateEvent {
+    return new RoomStateEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoomStateEvent {
+    return new RoomStateEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoomStateEvent {
+    return new RoomStateEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RoomStateEvent | PlainMessage<RoomStateEvent> | undefined, b: RoomStateEvent | PlainMessage<RoomStateEvent> | undefined): boolean {
+    return proto3.util.equals(RoomStateEvent, a, b);
+  }
+}
+
+/**
+ * RoomSnapshot is the room's state as the caller may see it
+ *
+ * @generated from message esteemed.v1.RoomSnapshot
+ */
+export class RoomSnapshot extends Message<RoomSnapshot> {
+  /**
+   * Pending participants are only listed for the host and facilitators
+   *
+   * @generated from field: esteemed.v1.Room room = 1;
+   */
+  room?: Room;
+
+  /**
+   * Who has voted this round; values stay hidden except the caller's own
+   *
+   * @generated from field: repeated esteemed.v1.Vote vote_status = 2;
+   */
+  voteStatus: Vote[] = [];
+
+  /**
+   * Set once the votes are revealed
+   *
+   * @generated from field: esteemed.v1.VoteSummary summary = 3;
+   */
+  summary?: VoteSummary;
+
+  /**
+   * The caller's participant ID (empty for view tokens and outsiders)
+   *
+   * @generated from field: string participant_id = 4;
+   */
+  participantId = "";
+
+  /**
+   * The caller used the room's view token
+   *
+   * @generated from field: bool is_viewer = 5;
+   */
+  isViewer = false;
+
+  constructor(data?: PartialMessage<RoomSnapshot>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.RoomSnapshot";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room", kind: "message", T: Room },
+    { no: 2, name: "vote_status", kind: "message", T: Vote, repeated: true },
+    { no: 3, name: "summary", kind: "message", T: VoteSummary },
+    { no: 4, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "is_viewer", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoomSnapshot {
+    return new RoomSnapshot().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoomSnapshot {
+    return new RoomSnapshot().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoomSnapshot {
+    return new RoomSnapshot().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RoomSnapshot | PlainMessage<RoomSnapshot> | undefined, b: RoomSnapshot | PlainMessage<RoomSnapshot> | undefined): boolean {
+    return proto3.util.equals(RoomSnapshot, a, b);
+  }
+}
+
+/**
+ * GetRoomRequest fetches a room snapshot
+ *
+ * @generated from message esteemed.v1.GetRoomRequest
+ */
+export class GetRoomRequest extends Message<GetRoomRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * A participant's session token or the room's view token
+   *
+   * @generated from field: string session_token = 2;
+   */
+  sessionToken = "";
+
+  /**
+   * Needed by anyone else if the room has a passcode
+   *
+   * @generated from field: string passcode = 3;
+   */
+  passcode = "";
+
+  constructor(data?: PartialMessage<GetRoomRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.GetRoomRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "passcode", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRoomRequest {
+    return new GetRoomRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRoomRequest {
+    return new GetRoomRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRoomRequest {
+    return new GetRoomRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRoomRequest | PlainMessage<GetRoomRequest> | undefined, b: GetRoomRequest | PlainMessage<GetRoomRequest> | undefined): boolean {
+    return proto3.util.equals(GetRoomRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.GetRoomResponse
+ */
+export class GetRoomResponse extends Message<GetRoomResponse> {
+  /**
+   * Pending participants are only listed for the host and facilitators
+   *
+   * @generated from field: esteemed.v1.Room room = 1;
+   */
+  room?: Room;
+
+  /**
+   * Who has voted this round; values stay hidden except the caller's own
+   *
+   * @generated from field: repeated esteemed.v1.Vote vote_status = 2;
+   */
+  voteStatus: Vote[] = [];
+
+  /**
+   * Set once the votes are revealed
+   *
+   * @generated from field: esteemed.v1.VoteSummary summary = 3;
+   */
+  summary?: VoteSummary;
+
+  /**
+   * The caller's participant ID (empty for view tokens and outsiders)
+   *
+   * @generated from field: string participant_id = 4;
+   */
+  participantId = "";
+
+  /**
+   * The caller used the room's view token
+   *
+   * @generated from field: bool is_viewer = 5;
+   */
+  isViewer = false;
+
+  constructor(data?: PartialMessage<GetRoomResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.GetRoomResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room", kind: "message", T: Room },
+    { no: 2, name: "vote_status", kind: "message", T: Vote, repeated: true },
+    { no: 3, name: "summary", kind: "message", T: VoteSummary },
+    { no: 4, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "is_viewer", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRoomResponse {
+    return new GetRoomResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRoomResponse {
+    return new GetRoomResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRoomResponse {
+    return new GetRoomResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRoomResponse | PlainMessage<GetRoomResponse> | undefined, b: GetRoomResponse | PlainMessage<GetRoomResponse> | undefined): boolean {
+    return proto3.util.equals(GetRoomResponse, a, b);
+  }
+}
+
+/**
+ * CreateViewTokenRequest issues a read-only view token
+ *
+ * @generated from message esteemed.v1.CreateViewTokenRequest
+ */
+export class CreateViewTokenRequest extends Message<CreateViewTokenRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * Must be the host or a facilitator
+   *
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  constructor(data?: PartialMessage<CreateViewTokenRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.CreateViewTokenRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateViewTokenRequest {
+    return new CreateViewTokenRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateViewTokenRequest {
+    return new CreateViewTokenRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateViewTokenRequest {
+    return new CreateViewTokenRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateViewTokenRequest | PlainMessage<CreateViewTokenRequest> | undefined, b: CreateViewTokenRequest | PlainMessage<CreateViewTokenRequest> | undefined): boolean {
+    return proto3.util.equals(CreateViewTokenRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.CreateViewTokenResponse
+ */
+export class CreateViewTokenResponse extends Message<CreateViewTokenResponse> {
+  /**
+   * Pass as session_token to WatchRoom, WatchVotes and GetRoom
+   *
+   * @generated from field: string view_token = 1;
+   */
+  viewToken = "";
+
+  constructor(data?: PartialMessage<CreateViewTokenResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.CreateViewTokenResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "view_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateViewTokenResponse {
+    return new CreateViewTokenResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateViewTokenResponse {
+    return new CreateViewTokenResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateViewTokenResponse {
+    return new CreateViewTokenResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateViewTokenResponse | PlainMessage<CreateViewTokenResponse> | undefined, b: CreateViewTokenResponse | PlainMessage<CreateViewTokenResponse> | undefined): boolean {
+    return proto3.util.equals(CreateViewTokenResponse, a, b);
+  }
+}
+
+/**
+ * RevokeViewTokenRequest stops the view token from working
+ *
+ * @generated from message esteemed.v1.RevokeViewTokenRequest
+ */
+export class RevokeViewTokenRequest extends Message<RevokeViewTokenRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * Must be the host or a facilitator
+   *
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  constructor(data?: PartialMessage<RevokeViewTokenRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.RevokeViewTokenRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeViewTokenRequest {
+    return new RevokeViewTokenRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeViewTokenRequest {
+    return new RevokeViewTokenRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeViewTokenRequest {
+    return new RevokeViewTokenRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeViewTokenRequest | PlainMessage<RevokeViewTokenRequest> | undefined, b: RevokeViewTokenRequest | PlainMessage<RevokeViewTokenRequest> | undefined): boolean {
+    return proto3.util.equals(RevokeViewTokenRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.RevokeViewTokenResponse
+ */
+export class RevokeViewTokenResponse extends Message<RevokeViewTokenResponse> {
+  constructor(data?: PartialMessage<RevokeViewTokenResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.RevokeViewTokenResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeViewTokenResponse {
+    return new RevokeViewTokenResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeViewTokenResponse {
+    return new RevokeViewTokenResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeViewTokenResponse {
+    return new RevokeViewTokenResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeViewTokenResponse | PlainMessage<RevokeViewTokenResponse> | undefined, b: RevokeViewTokenResponse | PlainMessage<RevokeViewTokenResponse> | undefined): boolean {
+    return proto3.util.equals(RevokeViewTokenResponse, a, b);
+  }
+}
+
+/**
+ * KickParticipantRequest removes a participant from the room
+ *
+ * @generated from message esteemed.v1.KickParticipantRequest
+ */
+export class KickParticipantRequest extends Message<KickParticipantRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * ID of the participant making the request (must be host)
+   *
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  /**
+   * ID of the participant to kick
+   *
+   * @generated from field: string target_participant_id = 4;
+   */
+  targetParticipantId = "";
+
+  /**
+   * Keep their session token out for the room's lifetime
+   *
+   * @generated from field: bool ban = 5;
+   */
+  ban = false;
+
+  /**
+   * Also keep their name out (implies ban)
+   *
+   * @generated from field: bool ban_name = 6;
+   */
+  banName = false;
+
+  constructor(data?: PartialMessage<KickParticipantRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.KickParticipantRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "target_participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "ban", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "ban_name", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KickParticipantRequest {
+    return new KickParticipantRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KickParticipantRequest {
+    return new KickParticipantRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KickParticipantRequest {
+    return new KickParticipantRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KickParticipantRequest | PlainMessage<KickParticipantRequest> | undefined, b: KickParticipantRequest | PlainMessage<KickParticipantRequest> | undefined): boolean {
+    return proto3.util.equals(KickParticipantRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.KickParticipantResponse
+ */
+export class KickParticipantResponse extends Message<KickParticipantResponse> {
+  constructor(data?: PartialMessage<KickParticipantResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.KickParticipantResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KickParticipantResponse {
+    return new KickParticipantResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KickParticipantResponse {
+    return new KickParticipantResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KickParticipantResponse {
+    return new KickParticipantResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KickParticipantResponse | PlainMessage<KickParticipantResponse> | undefined, b: KickParticipantResponse | PlainMessage<KickParticipantResponse> | undefined): boolean {
+    return proto3.util.equals(KickParticipantResponse, a, b);
+  }
+}
+
+/**
+ * ListBansRequest lists the room's bans
+ *
+ * @generated from message esteemed.v1.ListBansRequest
+ */
+export class ListBansRequest extends Message<ListBansRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * Must be the host or a facilitator
+   *
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  constructor(data?: PartialMessage<ListBansRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.ListBansRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListBansRequest {
+    return new ListBansRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListBansRequest {
+    return new ListBansRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListBansRequest {
+    return new ListBansRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListBansRequest | PlainMessage<ListBansRequest> | undefined, b: ListBansRequest | PlainMessage<ListBansRequest> | undefined): boolean {
+    return proto3.util.equals(ListBansRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.ListBansResponse
+ */
+export class ListBansResponse extends Message<ListBansResponse> {
+  /**
+   * Oldest first
+   *
+   * @generated from field: repeated esteemed.v1.Ban bans = 1;
+   */
+  bans: Ban[] = [];
+
+  constructor(data?: PartialMessage<ListBansResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.ListBansResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "bans", kind: "message", T: Ban, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListBansResponse {
+    return new ListBansResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListBansResponse {
+    return new ListBansResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListBansResponse {
+    return new ListBansResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListBansResponse | PlainMessage<ListBansResponse> | undefined, b: ListBansResponse | PlainMessage<ListBansResponse> | undefined): boolean {
+    return proto3.util.equals(ListBansResponse, a, b);
+  }
+}
+
+/**
+ * Ban keeps a kicked participant out of the room
+ *
+ * @generated from message esteemed.v1.Ban
+ */
+export class Ban extends Message<Ban> {
+  /**
+   * ID of the banned participant; pass to LiftBan
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string participant_name = 2;
+   */
+  participantName = "";
+
+  /**
+   * Anyone joining under the same name is turned away too
+   *
+   * @generated from field: bool name_banned = 3;
+   */
+  nameBanned = false;
+
+  /**
+   * Participant ID of who issued the ban
+   *
+   * @generated from field: string banned_by = 4;
+   */
+  bannedBy = "";
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 banned_at = 5;
+   */
+  bannedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Ban>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.Ban";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name_banned", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "banned_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "banned_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Ban {
+    return new Ban().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Ban {
+    return new Ban().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Ban {
+    return new Ban().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Ban | PlainMessage<Ban> | undefined, b: Ban | PlainMessage<Ban> | undefined): boolean {
+    return proto3.util.equals(Ban, a, b);
+  }
+}
+
+/**
+ * LiftBanRequest lets a banned participant join again
+ *
+ * @generated from message esteemed.v1.LiftBanRequest
+ */
+export class LiftBanRequest extends Message<LiftBanRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * Must be the host or a facilitator
+   *
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  /**
+   * @generated from field: string ban_id = 4;
+   */
+  banId = "";
+
+  constructor(data?: PartialMessage<LiftBanRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.LiftBanRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "ban_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LiftBanRequest {
+    return new LiftBanRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LiftBanRequest {
+    return new LiftBanRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LiftBanRequest {
+    return new LiftBanRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LiftBanRequest | PlainMessage<LiftBanRequest> | undefined, b: LiftBanRequest | PlainMessage<LiftBanRequest> | undefined): boolean {
+    return proto3.util.equals(LiftBanRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.LiftBanResponse
+ */
+export class LiftBanResponse extends Message<LiftBanResponse> {
+  constructor(data?: PartialMessage<LiftBanResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.LiftBanResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LiftBanResponse {
+    return new LiftBanResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LiftBanResponse {
+    return new LiftBanResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LiftBanResponse {
+    return new LiftBanResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LiftBanResponse | PlainMessage<LiftBanResponse> | undefined, b: LiftBanResponse | PlainMessage<LiftBanResponse> | undefined): boolean {
+    return proto3.util.equals(LiftBanResponse, a, b);
+  }
+}
+
+/**
+ * TransferOwnershipRequest transfers host privileges
+ *
+ * @generated from message esteemed.v1.TransferOwnershipRequest
+ */
+export class TransferOwnershipRequest extends Message<TransferOwnershipRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * ID of the current host
+   *
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  /**
+   * ID of the new host (must not be a spectator)
+   *
+   * @generated from field: string new_host_id = 4;
+   */
+  newHostId = "";
+
+  constructor(data?: PartialMessage<TransferOwnershipRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.TransferOwnershipRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "new_host_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransferOwnershipRequest {
+    return new TransferOwnershipRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransferOwnershipRequest {
+    return new TransferOwnershipRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransferOwnershipRequest {
+    return new TransferOwnershipRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TransferOwnershipRequest | PlainMessage<TransferOwnershipRequest> | undefined, b: TransferOwnershipRequest | PlainMessage<TransferOwnershipRequest> | undefined): boolean {
+    return proto3.util.equals(TransferOwnershipRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.TransferOwnershipResponse
+ */
+export class TransferOwnershipResponse extends Message<TransferOwnershipResponse> {
+  constructor(data?: PartialMessage<TransferOwnershipResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.TransferOwnershipResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransferOwnershipResponse {
+    return new TransferOwnershipResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransferOwnershipResponse {
+    return new TransferOwnershipResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransferOwnershipResponse {
+    return new TransferOwnershipResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TransferOwnershipResponse | PlainMessage<TransferOwnershipResponse> | undefined, b: TransferOwnershipResponse | PlainMessage<TransferOwnershipResponse> | undefined): boolean {
+    return proto3.util.equals(TransferOwnershipResponse, a, b);
+  }
+}
+
+/**
+ * GrantRoleRequest changes a participant's role
+ *
+ * @generated from message esteemed.v1.GrantRoleRequest
+ */
+export class GrantRoleRequest extends Message<GrantRoleRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * Must be the owner
+   *
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  /**
+   * @generated from field: string target_participant_id = 4;
+   */
+  targetParticipantId = "";
+
+  /**
+   * Facilitator, voter or spectator; ownership moves through TransferOwnership
+   *
+   * @generated from field: esteemed.v1.ParticipantRole role = 5;
+   */
+  role = ParticipantRole.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<GrantRoleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.GrantRoleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "target_participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "role", kind: "enum", T: proto3.getEnumType(ParticipantRole) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GrantRoleRequest {
+    return new GrantRoleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GrantRoleRequest {
+    return new GrantRoleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GrantRoleRequest {
+    return new GrantRoleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GrantRoleRequest | PlainMessage<GrantRoleRequest> | undefined, b: GrantRoleRequest | PlainMessage<GrantRoleRequest> | undefined): boolean {
+    return proto3.util.equals(GrantRoleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.GrantRoleResponse
+ */
+export class GrantRoleResponse extends Message<GrantRoleResponse> {
+  constructor(data?: PartialMessage<GrantRoleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.GrantRoleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GrantRoleResponse {
+    return new GrantRoleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GrantRoleResponse {
+    return new GrantRoleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GrantRoleResponse {
+    return new GrantRoleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GrantRoleResponse | PlainMessage<GrantRoleResponse> | undefined, b: GrantRoleResponse | PlainMessage<GrantRoleResponse> | undefined): boolean {
+    return proto3.util.equals(GrantRoleResponse, a, b);
+  }
+}
+
+/**
+ * RevokeRoleRequest returns a participant to the voter role
+ *
+ * @generated from message esteemed.v1.RevokeRoleRequest
+ */
+export class RevokeRoleRequest extends Message<RevokeRoleRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * Must be the owner
+   *
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  /**
+   * @generated from field: string target_participant_id = 4;
+   */
+  targetParticipantId = "";
+
+  constructor(data?: PartialMessage<RevokeRoleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.RevokeRoleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "target_participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeRoleRequest {
+    return new RevokeRoleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeRoleRequest {
+    return new RevokeRoleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeRoleRequest {
+    return new RevokeRoleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeRoleRequest | PlainMessage<RevokeRoleRequest> | undefined, b: RevokeRoleRequest | PlainMessage<RevokeRoleRequest> | undefined): boolean {
+    return proto3.util.equals(RevokeRoleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.RevokeRoleResponse
+ */
+export class RevokeRoleResponse extends Message<RevokeRoleResponse> {
+  constructor(data?: PartialMessage<RevokeRoleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.RevokeRoleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeRoleResponse {
+    return new RevokeRoleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeRoleResponse {
+    return new RevokeRoleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeRoleResponse {
+    return new RevokeRoleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeRoleResponse | PlainMessage<RevokeRoleResponse> | undefined, b: RevokeRoleResponse | PlainMessage<RevokeRoleResponse> | undefined): boolean {
+    return proto3.util.equals(RevokeRoleResponse, a, b);
+  }
+}
+
+/**
+ * UpdateParticipantRequest changes the caller's profile; empty fields are left unchanged
+ *
+ * @generated from message esteemed.v1.UpdateParticipantRequest
+ */
+export class UpdateParticipantRequest extends Message<UpdateParticipantRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  /**
+   * @generated from field: string name = 4;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string avatar_seed = 5;
+   */
+  avatarSeed = "";
+
+  /**
+   * @generated from field: string color = 6;
+   */
+  color = "";
+
+  constructor(data?: PartialMessage<UpdateParticipantRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.UpdateParticipantRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "avatar_seed", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "color", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateParticipantRequest {
+    return new UpdateParticipantRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateParticipantRequest {
+    return new UpdateParticipantRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateParticipantRequest {
+    return new UpdateParticipantRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateParticipantRequest | PlainMessage<UpdateParticipantRequest> | undefined, b: UpdateParticipantRequest | PlainMessage<UpdateParticipantRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateParticipantRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.UpdateParticipantResponse
+ */
+export class UpdateParticipantResponse extends Message<UpdateParticipantResponse> {
+  /**
+   * @generated from field: esteemed.v1.Participant participant = 1;
+   */
+  participant?: Participant;
+
+  constructor(data?: PartialMessage<UpdateParticipantResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.UpdateParticipantResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "participant", kind: "message", T: Participant },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateParticipantResponse {
+    return new UpdateParticipantResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateParticipantResponse {
+    return new UpdateParticipantResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateParticipantResponse {
+    return new UpdateParticipantResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateParticipantResponse | PlainMessage<UpdateParticipantResponse> | undefined, b: UpdateParticipantResponse | PlainMessage<UpdateParticipantResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateParticipantResponse, a, b);
+  }
+}
+
+/**
+ * SetPasscodeRequest sets or removes the room's passcode
+ *
+ * @generated from message esteemed.v1.SetPasscodeRequest
+ */
+export class SetPasscodeRequest extends Message<SetPasscodeRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * Must be the owner
+   *
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  /**
+   * 4-64 characters, or empty to remove it
+   *
+   * @generated from field: string passcode = 4;
+   */
+  passcode = "";
+
+  constructor(data?: PartialMessage<SetPasscodeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.SetPasscodeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "passcode", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetPasscodeRequest {
+    return new SetPasscodeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetPasscodeRequest {
+    return new SetPasscodeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetPasscodeRequest {
+    return new SetPasscodeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetPasscodeRequest | PlainMessage<SetPasscodeRequest> | undefined, b: SetPasscodeRequest | PlainMessage<SetPasscodeRequest> | undefined): boolean {
+    return proto3.util.equals(SetPasscodeRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.SetPasscodeResponse
+ */
+export class SetPasscodeResponse extends Message<SetPasscodeResponse> {
+  constructor(data?: PartialMessage<SetPasscodeResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.SetPasscodeResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetPasscodeResponse {
+    return new SetPasscodeResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetPasscodeResponse {
+    return new SetPasscodeResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetPasscodeResponse {
+    return new SetPasscodeResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetPasscodeResponse | PlainMessage<SetPasscodeResponse> | undefined, b: SetPasscodeResponse | PlainMessage<SetPasscodeResponse> | undefined): boolean {
+    return proto3.util.equals(SetPasscodeResponse, a, b);
+  }
+}
+
+/**
+ * SetVisibilityRequest changes who can find and join the room
+ *
+ * @generated from message esteemed.v1.SetVisibilityRequest
+ */
+export class SetVisibilityRequest extends Message<SetVisibilityRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * Must be the owner
+   *
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  /**
+   * @generated from field: esteemed.v1.RoomVisibility visibility = 4;
+   */
+  visibility = RoomVisibility.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<SetVisibilityRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.SetVisibilityRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "visibility", kind: "enum", T: proto3.getEnumType(RoomVisibility) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetVisibilityRequest {
+    return new SetVisibilityRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetVisibilityRequest {
+    return new SetVisibilityRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetVisibilityRequest {
+    return new SetVisibilityRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetVisibilityRequest | PlainMessage<SetVisibilityRequest> | undefined, b: SetVisibilityRequest | PlainMessage<SetVisibilityRequest> | undefined): boolean {
+    return proto3.util.equals(SetVisibilityRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.SetVisibilityResponse
+ */
+export class SetVisibilityResponse extends Message<SetVisibilityResponse> {
+  constructor(data?: PartialMessage<SetVisibilityResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.SetVisibilityResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetVisibilityResponse {
+    return new SetVisibilityResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetVisibilityResponse {
+    return new SetVisibilityResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetVisibilityResponse {
+    return new SetVisibilityResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetVisibilityResponse | PlainMessage<SetVisibilityResponse> | undefined, b: SetVisibilityResponse | PlainMessage<SetVisibilityResponse> | undefined): boolean {
+    return proto3.util.equals(SetVisibilityResponse, a, b);
+  }
+}
+
+/**
+ * CreateInviteRequest creates an invite link
+ *
+ * @generated from message esteemed.v1.CreateInviteRequest
+ */
+export class CreateInviteRequest extends Message<CreateInviteRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * Must be the host or a facilitator
+   *
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  /**
+   * Voter or spectator (unspecified means voter)
+   *
+   * @generated from field: esteemed.v1.ParticipantRole role = 4;
+   */
+  role = ParticipantRole.UNSPECIFIED;
+
+  /**
+   * Optional lifetime (1 minute to 30 days, defaults to 24 hours)
+   *
+   * @generated from field: int32 ttl_minutes = 5;
+   */
+  ttlMinutes = 0;
+
+  constructor(data?: PartialMessage<CreateInviteRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.CreateInviteRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "role", kind: "enum", T: proto3.getEnumType(ParticipantRole) },
+    { no: 5, name: "ttl_minutes", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateInviteRequest {
+    return new CreateInviteRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateInviteRequest {
+    return new CreateInviteRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateInviteRequest {
+    return new CreateInviteRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateInviteRequest | PlainMessage<CreateInviteRequest> | undefined, b: CreateInviteRequest | PlainMessage<CreateInviteRequest> | undefined): boolean {
+    return proto3.util.equals(CreateInviteRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.CreateInviteResponse
+ */
+export class CreateInviteResponse extends Message<CreateInviteResponse> {
+  /**
+   * Used to revoke the invite
+   *
+   * @generated from field: string invite_id = 1;
+   */
+  inviteId = "";
+
+  /**
+   * Passed to JoinRoom by invitees
+   *
+   * @generated from field: string invite_token = 2;
+   */
+  inviteToken = "";
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 expires_at = 3;
+   */
+  expiresAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<CreateInviteResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.CreateInviteResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "invite_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "invite_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateInviteResponse {
+    return new CreateInviteResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateInviteResponse {
+    return new CreateInviteResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateInviteResponse {
+    return new CreateInviteResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateInviteResponse | PlainMessage<CreateInviteResponse> | undefined, b: CreateInviteResponse | PlainMessage<CreateInviteResponse> | undefined): boolean {
+    return proto3.util.equals(CreateInviteResponse, a, b);
+  }
+}
+
+/**
+ * RevokeInviteRequest stops an invite link from being used
+ *
+ * @generated from message esteemed.v1.RevokeInviteRequest
+ */
+export class RevokeInviteRequest extends Message<RevokeInviteRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * Must be the host or a facilitator
+   *
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  /**
+   * @generated from field: string invite_id = 4;
+   */
+  inviteId = "";
+
+  constructor(data?: PartialMessage<RevokeInviteRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.RevokeInviteRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "invite_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeInviteRequest {
+    return new RevokeInviteRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeInviteRequest {
+    return new RevokeInviteRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeInviteRequest {
+    return new RevokeInviteRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeInviteRequest | PlainMessage<RevokeInviteRequest> | undefined, b: RevokeInviteRequest | PlainMessage<RevokeInviteRequest> | undefined): boolean {
+    return proto3.util.equals(RevokeInviteRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.RevokeInviteResponse
+ */
+export class RevokeInviteResponse extends Message<RevokeInviteResponse> {
+  constructor(data?: PartialMessage<RevokeInviteResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.RevokeInviteResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeInviteResponse {
+    return new RevokeInviteResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeInviteResponse {
+    return new RevokeInviteResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeInviteResponse {
+    return new RevokeInviteResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeInviteResponse | PlainMessage<RevokeInviteResponse> | undefined, b: RevokeInviteResponse | PlainMessage<RevokeInviteResponse> | undefined): boolean {
+    return proto3.util.equals(RevokeInviteResponse, a, b);
+  }
+}
+
+/**
+ * SetRoomLockedRequest locks or unlocks the room
+ *
+ * @generated from message esteemed.v1.SetRoomLockedRequest
+ */
+export class SetRoomLockedRequest extends Message<SetRoomLockedRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * Must be the host or a facilitator
+   *
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  /**
+   * @generated from field: bool locked = 4;
+   */
+  locked = false;
+
+  constructor(data?: PartialMessage<SetRoomLockedRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.SetRoomLockedRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "locked", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetRoomLockedRequest {
+    return new SetRoomLockedRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetRoomLockedRequest {
+    return new SetRoomLockedRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetRoomLockedRequest {
+    return new SetRoomLockedRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetRoomLockedRequest | PlainMessage<SetRoomLockedRequest> | undefined, b: SetRoomLockedRequest | PlainMessage<SetRoomLockedRequest> | undefined): boolean {
+    return proto3.util.equals(SetRoomLockedRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.SetRoomLockedResponse
+ */
+export class SetRoomLockedResponse extends Message<SetRoomLockedResponse> {
+  constructor(data?: PartialMessage<SetRoomLockedResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.SetRoomLockedResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetRoomLockedResponse {
+    return new SetRoomLockedResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetRoomLockedResponse {
+    return new SetRoomLockedResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetRoomLockedResponse {
+    return new SetRoomLockedResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetRoomLockedResponse | PlainMessage<SetRoomLockedResponse> | undefined, b: SetRoomLockedResponse | PlainMessage<SetRoomLockedResponse> | undefined): boolean {
+    return proto3.util.equals(SetRoomLockedResponse, a, b);
+  }
+}
+
+/**
+ * SetWaitingRoomRequest turns the waiting room on or off
+ *
+ * @generated from message esteemed.v1.SetWaitingRoomRequest
+ */
+export class SetWaitingRoomRequest extends Message<SetWaitingRoomRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * Must be the owner
+   *
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  /**
+   * Requests already waiting stay until decided
+   *
+   * @generated from field: bool enabled = 4;
+   */
+  enabled = false;
+
+  constructor(data?: PartialMessage<SetWaitingRoomRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.SetWaitingRoomRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetWaitingRoomRequest {
+    return new SetWaitingRoomRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetWaitingRoomRequest {
+    return new SetWaitingRoomRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetWaitingRoomRequest {
+    return new SetWaitingRoomRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetWaitingRoomRequest | PlainMessage<SetWaitingRoomRequest> | undefined, b: SetWaitingRoomRequest | PlainMessage<SetWaitingRoomRequest> | undefined): boolean {
+    return proto3.util.equals(SetWaitingRoomRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.SetWaitingRoomResponse
+ */
+export class SetWaitingRoomResponse extends Message<SetWaitingRoomResponse> {
+  constructor(data?: PartialMessage<SetWaitingRoomResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.SetWaitingRoomResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetWaitingRoomResponse {
+    return new SetWaitingRoomResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetWaitingRoomResponse {
+    return new SetWaitingRoomResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetWaitingRoomResponse {
+    return new SetWaitingRoomResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetWaitingRoomResponse | PlainMessage<SetWaitingRoomResponse> | undefined, b: SetWaitingRoomResponse | PlainMessage<SetWaitingRoomResponse> | undefined): boolean {
+    return proto3.util.equals(SetWaitingRoomResponse, a, b);
+  }
+}
+
+/**
+ * AdmitParticipantRequest lets a participant in from the waiting room
+ *
+ * @generated from message esteemed.v1.AdmitParticipantRequest
+ */
+export class AdmitParticipantRequest extends Message<AdmitParticipantRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * Must be the host or a facilitator
+   *
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  /**
+   * @generated from field: string pending_participant_id = 4;
+   */
+  pendingParticipantId = "";
+
+  constructor(data?: PartialMessage<AdmitParticipantRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.AdmitParticipantRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "pending_participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AdmitParticipantRequest {
+    return new AdmitParticipantRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AdmitParticipantRequest {
+    return new AdmitParticipantRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AdmitParticipantRequest {
+    return new AdmitParticipantRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AdmitParticipantRequest | PlainMessage<AdmitParticipantRequest> | undefined, b: AdmitParticipantRequest | PlainMessage<AdmitParticipantRequest> | undefined): boolean {
+    return proto3.util.equals(AdmitParticipantRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message esteemed.v1.AdmitParticipantResponse
+ */
+export class AdmitParticipantResponse extends Message<AdmitParticipantResponse> {
+  constructor(data?: PartialMessage<AdmitParticipantResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.AdmitParticipantResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AdmitParticipantResponse {
+    return new AdmitParticipantResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AdmitParticipantResponse {
+    return new AdmitParticipantResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AdmitParticipantResponse {
+    return new AdmitParticipantResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AdmitParticipantResponse | PlainMessage<AdmitParticipantResponse> | undefined, b: AdmitParticipantResponse | PlainMessage<AdmitParticipantResponse> | undefined): boolean {
+    return proto3.util.equals(AdmitParticipantResponse, a, b);
+  }
+}
+
+/**
+ * RejectParticipantRequest turns away a participant in the waiting room
+ *
+ * @generated from message esteemed.v1.RejectParticipantRequest
+ */
+export class RejectParticipantRequest extends Message<RejectParticipantRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * Must be the host or a facilitator
+   *
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  /**
+   * @generated from field: string pending_participant_id = 4;
+   */
+  pendingParticipantId = "";
+
+  constructor(data?: PartialMessage<RejectParticipantRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "esteemed.v1.RejectParticipantRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "pending_participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateRoomRequest {
-    return new CreateRoomRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RejectParticipantRequest {
+    return new RejectParticipantRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateRoomRequest {
-    return new CreateRoomRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RejectParticipantRequest {
+    return new RejectParticipantRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateRoomRequest {
-    return new CreateRoomRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RejectParticipantRequest {
+    return new RejectParticipantRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateRoomRequest | PlainMessage<CreateRoomRequest> | undefined, b: CreateRoomRequest | PlainMessage<CreateRoomRequest> | undefined): boolean {
-    return proto3.util.equals(CreateRoomRequest, a, b);
+  static equals(a: RejectParticipantRequest | PlainMessage<RejectParticipantRequest> | undefined, b: RejectParticipantRequest | PlainMessage<RejectParticipantRequest> | undefined): boolean {
+    return proto3.util.equals(RejectParticipantRequest, a, b);
   }
 }
 
 /**
- * @generated from message esteemed.v1.CreateRoomResponse
+ * @generated from message esteemed.v1.RejectParticipantResponse
  */
-export class CreateRoomResponse extends Message<CreateRoomResponse> {
-  /**
-   * @generated from field: esteemed.v1.Room room = 1;
-   */
-  room?: Room;
-
-  /**
-   * Token for reconnection
-   *
-   * @generated from field: string session_token = 2;
-   */
-  sessionToken = "";
-
-  /**
-   * ID of the host participant
-   *
-   * @generated from field: string participant_id = 3;
-   */
-  participantId = "";
-
-  constructor(data?: PartialMessage<CreateRoomResponse>) {
+export class RejectParticipantResponse extends Message<RejectParticipantResponse> {
+  constructor(data?: PartialMessage<RejectParticipantResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "esteemed.v1.CreateRoomResponse";
+  static readonly typeName = "esteemed.v1.RejectParticipantResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "room", kind: "message", T: Room },
-    { no: 2, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateRoomResponse {
-    return new CreateRoomResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RejectParticipantResponse {
+    return new RejectParticipantResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateRoomResponse {
-    return new CreateRoomResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RejectParticipantResponse {
+    return new RejectParticipantResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateRoomResponse {
-    return new CreateRoomResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RejectParticipantResponse {
+    return new RejectParticipantResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateRoomResponse | PlainMessage<CreateRoomResponse> | undefined, b: CreateRoomResponse | PlainMessage<CreateRoomResponse> | undefined): boolean {
-    return proto3.util.equals(CreateRoomResponse, a, b);
+  static equals(a: RejectParticipantResponse | PlainMessage<RejectParticipantResponse> | undefined, b: RejectParticipantResponse | PlainMessage<RejectParticipantResponse> | undefined): boolean {
+    return proto3.util.equals(RejectParticipantResponse, a, b);
   }
 }
 
 /**
- * JoinRoomRequest adds a participant
+ * SetParticipantLimitsRequest caps how many voters and spectators the room takes.
+ * Nobody is removed if the room already has more; only newcomers are turned away.
  *
- * @generated from message esteemed.v1.JoinRoomRequest
+ * @generated from message esteemed.v1.SetParticipantLimitsRequest
  */
-export class JoinRoomRequest extends Message<JoinRoomRequest> {
+export class SetParticipantLimitsRequest extends Message<SetParticipantLimitsRequest> {
   /**
    * @generated from field: string room_id = 1;
    */
   roomId = "";
 
   /**
-   * @generated from field: string participant_name = 2;
+   * Must be the owner
+   *
+   * @generated from field: string participant_id = 2;
    */
-  participantName = "";
+  participantId = "";
 
   /**
-   * Optional: for reconnection
-   *
    * @generated from field: string session_token = 3;
    */
   sessionToken = "";
 
   /**
-   * Join as spectator (watch only)
+   * 0 uses the server's limit; higher values are capped at it
    *
-   * @generated from field: bool is_spectator = 4;
+   * @generated from field: int32 max_voters = 4;
    */
-  isSpectator = false;
+  maxVoters = 0;
 
-  constructor(data?: PartialMessage<JoinRoomRequest>) {
+  /**
+   * 0 uses the server's limit; higher values are capped at it
+   *
+   * @generated from field: int32 max_spectators = 5;
+   */
+  maxSpectators = 0;
+
+  constructor(data?: PartialMessage<SetParticipantLimitsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "esteemed.v1.JoinRoomRequest";
+  static readonly typeName = "esteemed.v1.SetParticipantLimitsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "participant_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "is_spectator", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "max_voters", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "max_spectators", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JoinRoomRequest {
-    return new JoinRoomRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetParticipantLimitsRequest {
+    return new SetParticipantLimitsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JoinRoomRequest {
-    return new JoinRoomRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetParticipantLimitsRequest {
+    return new SetParticipantLimitsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JoinRoomRequest {
-    return new JoinRoomRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetParticipantLimitsRequest {
+    return new SetParticipantLimitsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: JoinRoomRequest | PlainMessage<JoinRoomRequest> | undefined, b: JoinRoomRequest | PlainMessage<JoinRoomRequest> | undefined): boolean {
-    return proto3.util.equals(JoinRoomRequest, a, b);
+  static equals(a: SetParticipantLimitsRequest | PlainMessage<SetParticipantLimitsRequest> | undefined, b: SetParticipantLimitsRequest | PlainMessage<SetParticipantLimitsRequest> | undefined): boolean {
+    return proto3.util.equals(SetParticipantLimitsRequest, a, b);
   }
 }
 
 /**
- * @generated from message esteemed.v1.JoinRoomResponse
+ * @generated from message esteemed.v1.SetParticipantLimitsResponse
  */
-export class JoinRoomResponse extends Message<JoinRoomResponse> {
-  /**
-   * @generated from field: esteemed.v1.Room room = 1;
-   */
-  room?: Room;
-
-  /**
-   * @generated from field: string session_token = 2;
-   */
-  sessionToken = "";
-
-  /**
-   * @generated from field: string participant_id = 3;
-   */
-  participantId = "";
-
-  constructor(data?: PartialMessage<JoinRoomResponse>) {
+export class SetParticipantLimitsResponse extends Message<SetParticipantLimitsResponse> {
+  constructor(data?: PartialMessage<SetParticipantLimitsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "esteemed.v1.JoinRoomResponse";
+  static readonly typeName = "esteemed.v1.SetParticipantLimitsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "room", kind: "message", T: Room },
-    { no: 2, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JoinRoomResponse {
-    return new JoinRoomResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetParticipantLimitsResponse {
+    return new SetParticipantLimitsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JoinRoomResponse {
-    return new JoinRoomResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetParticipantLimitsResponse {
+    return new SetParticipantLimitsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JoinRoomResponse {
-    return new JoinRoomResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetParticipantLimitsResponse {
+    return new SetParticipantLimitsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: JoinRoomResponse | PlainMessage<JoinRoomResponse> | undefined, b: JoinRoomResponse | PlainMessage<JoinRoomResponse> | undefined): boolean {
-    return proto3.util.equals(JoinRoomResponse, a, b);
+  static equals(a: SetParticipantLimitsResponse | PlainMessage<SetParticipantLimitsResponse> | undefined, b: SetParticipantLimitsResponse | PlainMessage<SetParticipantLimitsResponse> | undefined): boolean {
+    return proto3.util.equals(SetParticipantLimitsResponse, a, b);
   }
 }
 
 /**
- * LeaveRoomRequest removes a participant
+ * SetSpectatorRequest switches a participant between voting and spectating
  *
- * @generated from message esteemed.v1.LeaveRoomRequest
+ * @generated from message esteemed.v1.SetSpectatorRequest
  */
-export class LeaveRoomRequest extends Message<LeaveRoomRequest> {
+export class SetSpectatorRequest extends Message<SetSpectatorRequest> {
   /**
    * @generated from field: string room_id = 1;
    */
@@ -573,522 +5028,552 @@ export class LeaveRoomRequest extends Message<LeaveRoomRequest> {
    */
   sessionToken = "";
 
-  constructor(data?: PartialMessage<LeaveRoomRequest>) {
+  /**
+   * Empty for the caller; others need the host or a facilitator
+   *
+   * @generated from field: string target_participant_id = 4;
+   */
+  targetParticipantId = "";
+
+  /**
+   * @generated from field: bool spectator = 5;
+   */
+  spectator = false;
+
+  constructor(data?: PartialMessage<SetSpectatorRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "esteemed.v1.LeaveRoomRequest";
+  static readonly typeName = "esteemed.v1.SetSpectatorRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "target_participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "spectator", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LeaveRoomRequest {
-    return new LeaveRoomRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSpectatorRequest {
+    return new SetSpectatorRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LeaveRoomRequest {
-    return new LeaveRoomRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSpectatorRequest {
+    return new SetSpectatorRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LeaveRoomRequest {
-    return new LeaveRoomRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSpectatorRequest {
+    return new SetSpectatorRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: LeaveRoomRequest | PlainMessage<LeaveRoomRequest> | undefined, b: LeaveRoomRequest | PlainMessage<LeaveRoomRequest> | undefined): boolean {
-    return proto3.util.equals(LeaveRoomRequest, a, b);
+  static equals(a: SetSpectatorRequest | PlainMessage<SetSpectatorRequest> | undefined, b: SetSpectatorRequest | PlainMessage<SetSpectatorRequest> | undefined): boolean {
+    return proto3.util.equals(SetSpectatorRequest, a, b);
   }
 }
 
 /**
- * @generated from message esteemed.v1.LeaveRoomResponse
+ * @generated from message esteemed.v1.SetSpectatorResponse
  */
-export class LeaveRoomResponse extends Message<LeaveRoomResponse> {
-  constructor(data?: PartialMessage<LeaveRoomResponse>) {
+export class SetSpectatorResponse extends Message<SetSpectatorResponse> {
+  constructor(data?: PartialMessage<SetSpectatorResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "esteemed.v1.LeaveRoomResponse";
+  static readonly typeName = "esteemed.v1.SetSpectatorResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LeaveRoomResponse {
-    return new LeaveRoomResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSpectatorResponse {
+    return new SetSpectatorResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LeaveRoomResponse {
-    return new LeaveRoomResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSpectatorResponse {
+    return new SetSpectatorResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LeaveRoomResponse {
-    return new LeaveRoomResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSpectatorResponse {
+    return new SetSpectatorResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: LeaveRoomResponse | PlainMessage<LeaveRoomResponse> | undefined, b: LeaveRoomResponse | PlainMessage<LeaveRoomResponse> | undefined): boolean {
-    return proto3.util.equals(LeaveRoomResponse, a, b);
+  static equals(a: SetSpectatorResponse | PlainMessage<SetSpectatorResponse> | undefined, b: SetSpectatorResponse | PlainMessage<SetSpectatorResponse> | undefined): boolean {
+    return proto3.util.equals(SetSpectatorResponse, a, b);
   }
 }
 
 /**
- * ListRoomsRequest gets all active rooms
+ * SetFacilitatorRotationRequest turns facilitator rotation on or off
  *
- * @generated from message esteemed.v1.ListRoomsRequest
+ * @generated from message esteemed.v1.SetFacilitatorRotationRequest
  */
-export class ListRoomsRequest extends Message<ListRoomsRequest> {
-  constructor(data?: PartialMessage<ListRoomsRequest>) {
+export class SetFacilitatorRotationRequest extends Message<SetFacilitatorRotationRequest> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * Must be the owner
+   *
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  /**
+   * @generated from field: bool enabled = 4;
+   */
+  enabled = false;
+
+  constructor(data?: PartialMessage<SetFacilitatorRotationRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "esteemed.v1.ListRoomsRequest";
+  static readonly typeName = "esteemed.v1.SetFacilitatorRotationRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRoomsRequest {
-    return new ListRoomsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetFacilitatorRotationRequest {
+    return new SetFacilitatorRotationRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRoomsRequest {
-    return new ListRoomsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetFacilitatorRotationRequest {
+    return new SetFacilitatorRotationRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRoomsRequest {
-    return new ListRoomsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetFacilitatorRotationRequest {
+    return new SetFacilitatorRotationRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListRoomsRequest | PlainMessage<ListRoomsRequest> | undefined, b: ListRoomsRequest | PlainMessage<ListRoomsRequest> | undefined): boolean {
-    return proto3.util.equals(ListRoomsRequest, a, b);
+  static equals(a: SetFacilitatorRotationRequest | PlainMessage<SetFacilitatorRotationRequest> | undefined, b: SetFacilitatorRotationRequest | PlainMessage<SetFacilitatorRotationRequest> | undefined): boolean {
+    return proto3.util.equals(SetFacilitatorRotationRequest, a, b);
   }
 }
 
 /**
- * @generated from message esteemed.v1.ListRoomsResponse
+ * @generated from message esteemed.v1.SetFacilitatorRotationResponse
  */
-export class ListRoomsResponse extends Message<ListRoomsResponse> {
-  /**
-   * @generated from field: repeated esteemed.v1.RoomSummary rooms = 1;
-   */
-  rooms: RoomSummary[] = [];
-
-  constructor(data?: PartialMessage<ListRoomsResponse>) {
+export class SetFacilitatorRotationResponse extends Message<SetFacilitatorRotationResponse> {
+  constructor(data?: PartialMessage<SetFacilitatorRotationResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "esteemed.v1.ListRoomsResponse";
+  static readonly typeName = "esteemed.v1.SetFacilitatorRotationResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "rooms", kind: "message", T: RoomSummary, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRoomsResponse {
-    return new ListRoomsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetFacilitatorRotationResponse {
+    return new SetFacilitatorRotationResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRoomsResponse {
-    return new ListRoomsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetFacilitatorRotationResponse {
+    return new SetFacilitatorRotationResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRoomsResponse {
-    return new ListRoomsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetFacilitatorRotationResponse {
+    return new SetFacilitatorRotationResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListRoomsResponse | PlainMessage<ListRoomsResponse> | undefined, b: ListRoomsResponse | PlainMessage<ListRoomsResponse> | undefined): boolean {
-    return proto3.util.equals(ListRoomsResponse, a, b);
+  static equals(a: SetFacilitatorRotationResponse | PlainMessage<SetFacilitatorRotationResponse> | undefined, b: SetFacilitatorRotationResponse | PlainMessage<SetFacilitatorRotationResponse> | undefined): boolean {
+    return proto3.util.equals(SetFacilitatorRotationResponse, a, b);
   }
 }
 
 /**
- * RoomSummary is a brief view of a room for listing
+ * SetHostSuccessionRequest sets the room's succession policy
  *
- * @generated from message esteemed.v1.RoomSummary
+ * @generated from message esteemed.v1.SetHostSuccessionRequest
  */
-export class RoomSummary extends Message<RoomSummary> {
-  /**
-   * @generated from field: string id = 1;
-   */
-  id = "";
-
+export class SetHostSuccessionRequest extends Message<SetHostSuccessionRequest> {
   /**
-   * @generated from field: string name = 2;
+   * @generated from field: string room_id = 1;
    */
-  name = "";
+  roomId = "";
 
   /**
-   * @generated from field: int32 participant_count = 3;
+   * Must be the owner
+   *
+   * @generated from field: string participant_id = 2;
    */
-  participantCount = 0;
+  participantId = "";
 
   /**
-   * @generated from field: esteemed.v1.RoomState state = 4;
+   * @generated from field: string session_token = 3;
    */
-  state = RoomState.UNSPECIFIED;
+  sessionToken = "";
 
   /**
-   * @generated from field: int64 created_at = 5;
+   * @generated from field: esteemed.v1.HostSuccession host_succession = 4;
    */
-  createdAt = protoInt64.zero;
+  hostSuccession = HostSuccession.UNSPECIFIED;
 
   /**
-   * @generated from field: int64 expires_at = 6;
+   * Deputy policy only; may be empty and named later
+   *
+   * @generated from field: string deputy_id = 5;
    */
-  expiresAt = protoInt64.zero;
+  deputyId = "";
 
-  constructor(data?: PartialMessage<RoomSummary>) {
+  constructor(data?: PartialMessage<SetHostSuccessionRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "esteemed.v1.RoomSummary";
+  static readonly typeName = "esteemed.v1.SetHostSuccessionRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "participant_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 4, name: "state", kind: "enum", T: proto3.getEnumType(RoomState) },
-    { no: 5, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
-    { no: 6, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "host_succession", kind: "enum", T: proto3.getEnumType(HostSuccession) },
+    { no: 5, name: "deputy_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoomSummary {
-    return new RoomSummary().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetHostSuccessionRequest {
+    return new SetHostSuccessionRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoomSummary {
-    return new RoomSummary().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetHostSuccessionRequest {
+    return new SetHostSuccessionRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoomSummary {
-    return new RoomSummary().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetHostSuccessionRequest {
+    return new SetHostSuccessionRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RoomSummary | PlainMessage<RoomSummary> | undefined, b: RoomSummary | PlainMessage<RoomSummary> | undefined): boolean {
-    return proto3.util.equals(RoomSummary, a, b);
+  static equals(a: SetHostSuccessionRequest | PlainMessage<SetHostSuccessionRequest> | undefined, b: SetHostSuccessionRequest | PlainMessage<SetHostSuccessionRequest> | undefined): boolean {
+    return proto3.util.equals(SetHostSuccessionRequest, a, b);
   }
 }
 
 /**
- * WatchRoomRequest subscribes to room updates
- *
- * @generated from message esteemed.v1.WatchRoomRequest
+ * @generated from message esteemed.v1.SetHostSuccessionResponse
  */
-export class WatchRoomRequest extends Message<WatchRoomRequest> {
-  /**
-   * @generated from field: string room_id = 1;
-   */
-  roomId = "";
-
-  /**
-   * @generated from field: string session_token = 2;
-   */
-  sessionToken = "";
-
-  constructor(data?: PartialMessage<WatchRoomRequest>) {
+export class SetHostSuccessionResponse extends Message<SetHostSuccessionResponse> {
+  constructor(data?: PartialMessage<SetHostSuccessionResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "esteemed.v1.WatchRoomRequest";
+  static readonly typeName = "esteemed.v1.SetHostSuccessionResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchRoomRequest {
-    return new WatchRoomRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetHostSuccessionResponse {
+    return new SetHostSuccessionResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchRoomRequest {
-    return new WatchRoomRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetHostSuccessionResponse {
+    return new SetHostSuccessionResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchRoomRequest {
-    return new WatchRoomRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetHostSuccessionResponse {
+    return new SetHostSuccessionResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: WatchRoomRequest | PlainMessage<WatchRoomRequest> | undefined, b: WatchRoomRequest | PlainMessage<WatchRoomRequest> | undefined): boolean {
-    return proto3.util.equals(WatchRoomRequest, a, b);
+  static equals(a: SetHostSuccessionResponse | PlainMessage<SetHostSuccessionResponse> | undefined, b: SetHostSuccessionResponse | PlainMessage<SetHostSuccessionResponse> | undefined): boolean {
+    return proto3.util.equals(SetHostSuccessionResponse, a, b);
   }
 }
 
 /**
- * RoomEvent is sent when room state changes
+ * ClaimHostRequest claims a room without a host
  *
- * @generated from message esteemed.v1.RoomEvent
+ * @generated from message esteemed.v1.ClaimHostRequest
  */
-export class RoomEvent extends Message<RoomEvent> {
+export class ClaimHostRequest extends Message<ClaimHostRequest> {
   /**
-   * @generated from oneof esteemed.v1.RoomEvent.event
+   * @generated from field: string room_id = 1;
    */
-  event: {
-    /**
-     * @generated from field: esteemed.v1.ParticipantJoined participant_joined = 1;
-     */
-    value: ParticipantJoined;
-    case: "participantJoined";
-  } | {
-    /**
-     * @generated from field: esteemed.v1.ParticipantLeft participant_left = 2;
-     */
-    value: ParticipantLeft;
-    case: "participantLeft";
-  } | {
-    /**
-     * @generated from field: esteemed.v1.RoomStateChanged state_changed = 3;
-     */
-    value: RoomStateChanged;
-    case: "stateChanged";
-  } | {
-    /**
-     * @generated from field: esteemed.v1.RoomClosed room_closed = 4;
-     */
-    value: RoomClosed;
-    case: "roomClosed";
-  } | {
-    /**
-     * @generated from field: esteemed.v1.HostChanged host_changed = 5;
-     */
-    value: HostChanged;
-    case: "hostChanged";
-  } | { case: undefined; value?: undefined } = { case: undefined };
+  roomId = "";
 
-  constructor(data?: PartialMessage<RoomEvent>) {
+  /**
+   * Must not be a spectator
+   *
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  constructor(data?: PartialMessage<ClaimHostRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "esteemed.v1.RoomEvent";
+  static readonly typeName = "esteemed.v1.ClaimHostRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "participant_joined", kind: "message", T: ParticipantJoined, oneof: "event" },
-    { no: 2, name: "participant_left", kind: "message", T: ParticipantLeft, oneof: "event" },
-    { no: 3, name: "state_changed", kind: "message", T: RoomStateChanged, oneof: "event" },
-    { no: 4, name: "room_closed", kind: "message", T: RoomClosed, oneof: "event" },
-    { no: 5, name: "host_changed", kind: "message", T: HostChanged, oneof: "event" },
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoomEvent {
-    return new RoomEvent().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ClaimHostRequest {
+    return new ClaimHostRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoomEvent {
-    return new RoomEvent().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ClaimHostRequest {
+    return new ClaimHostRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoomEvent {
-    return new RoomEvent().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ClaimHostRequest {
+    return new ClaimHostRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RoomEvent | PlainMessage<RoomEvent> | undefined, b: RoomEvent | PlainMessage<RoomEvent> | undefined): boolean {
-    return proto3.util.equals(RoomEvent, a, b);
+  static equals(a: ClaimHostRequest | PlainMessage<ClaimHostRequest> | undefined, b: ClaimHostRequest | PlainMessage<ClaimHostRequest> | undefined): boolean {
+    return proto3.util.equals(ClaimHostRequest, a, b);
   }
 }
 
 /**
- * @generated from message esteemed.v1.ParticipantJoined
+ * @generated from message esteemed.v1.ClaimHostResponse
  */
-export class ParticipantJoined extends Message<ParticipantJoined> {
-  /**
-   * @generated from field: esteemed.v1.Participant participant = 1;
-   */
-  participant?: Participant;
-
-  constructor(data?: PartialMessage<ParticipantJoined>) {
+export class ClaimHostResponse extends Message<ClaimHostResponse> {
+  constructor(data?: PartialMessage<ClaimHostResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "esteemed.v1.ParticipantJoined";
+  static readonly typeName = "esteemed.v1.ClaimHostResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "participant", kind: "message", T: Participant },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ParticipantJoined {
-    return new ParticipantJoined().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ClaimHostResponse {
+    return new ClaimHostResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ParticipantJoined {
-    return new ParticipantJoined().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ClaimHostResponse {
+    return new ClaimHostResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ParticipantJoined {
-    return new ParticipantJoined().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ClaimHostResponse {
+    return new ClaimHostResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ParticipantJoined | PlainMessage<ParticipantJoined> | undefined, b: ParticipantJoined | PlainMessage<ParticipantJoined> | undefined): boolean {
-    return proto3.util.equals(ParticipantJoined, a, b);
+  static equals(a: ClaimHostResponse | PlainMessage<ClaimHostResponse> | undefined, b: ClaimHostResponse | PlainMessage<ClaimHostResponse> | undefined): boolean {
+    return proto3.util.equals(ClaimHostResponse, a, b);
   }
 }
 
 /**
- * @generated from message esteemed.v1.ParticipantLeft
+ * KeepAliveRequest extends a room that is about to expire
+ *
+ * @generated from message esteemed.v1.KeepAliveRequest
  */
-export class ParticipantLeft extends Message<ParticipantLeft> {
+export class KeepAliveRequest extends Message<KeepAliveRequest> {
   /**
-   * @generated from field: string participant_id = 1;
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * @generated from field: string participant_id = 2;
    */
   participantId = "";
 
-  constructor(data?: PartialMessage<ParticipantLeft>) {
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  constructor(data?: PartialMessage<KeepAliveRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "esteemed.v1.ParticipantLeft";
+  static readonly typeName = "esteemed.v1.KeepAliveRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ParticipantLeft {
-    return new ParticipantLeft().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KeepAliveRequest {
+    return new KeepAliveRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ParticipantLeft {
-    return new ParticipantLeft().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KeepAliveRequest {
+    return new KeepAliveRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ParticipantLeft {
-    return new ParticipantLeft().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KeepAliveRequest {
+    return new KeepAliveRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ParticipantLeft | PlainMessage<ParticipantLeft> | undefined, b: ParticipantLeft | PlainMessage<ParticipantLeft> | undefined): boolean {
-    return proto3.util.equals(ParticipantLeft, a, b);
+  static equals(a: KeepAliveRequest | PlainMessage<KeepAliveRequest> | undefined, b: KeepAliveRequest | PlainMessage<KeepAliveRequest> | undefined): boolean {
+    return proto3.util.equals(KeepAliveRequest, a, b);
   }
 }
 
 /**
- * @generated from message esteemed.v1.RoomStateChanged
+ * @generated from message esteemed.v1.KeepAliveResponse
  */
-export class RoomStateChanged extends Message<RoomStateChanged> {
+export class KeepAliveResponse extends Message<KeepAliveResponse> {
   /**
-   * @generated from field: esteemed.v1.RoomState new_state = 1;
+   * When the room now expires (0 for persistent rooms)
+   *
+   * @generated from field: int64 expires_at = 1;
    */
-  newState = RoomState.UNSPECIFIED;
+  expiresAt = protoInt64.zero;
 
-  constructor(data?: PartialMessage<RoomStateChanged>) {
+  constructor(data?: PartialMessage<KeepAliveResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "esteemed.v1.RoomStateChanged";
+  static readonly typeName = "esteemed.v1.KeepAliveResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "new_state", kind: "enum", T: proto3.getEnumType(RoomState) },
+    { no: 1, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoomStateChanged {
-    return new RoomStateChanged().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KeepAliveResponse {
+    return new KeepAliveResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoomStateChanged {
-    return new RoomStateChanged().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KeepAliveResponse {
+    return new KeepAliveResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoomStateChanged {
-    return new RoomStateChanged().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KeepAliveResponse {
+    return new KeepAliveResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RoomStateChanged | PlainMessage<RoomStateChanged> | undefined, b: RoomStateChanged | PlainMessage<RoomStateChanged> | undefined): boolean {
-    return proto3.util.equals(RoomStateChanged, a, b);
+  static equals(a: KeepAliveResponse | PlainMessage<KeepAliveResponse> | undefined, b: KeepAliveResponse | PlainMessage<KeepAliveResponse> | undefined): boolean {
+    return proto3.util.equals(KeepAliveResponse, a, b);
   }
 }
 
 /**
- * @generated from message esteemed.v1.RoomClosed
+ * CreateBreakoutRequest creates a child room
+ *
+ * @generated from message esteemed.v1.CreateBreakoutRequest
  */
-export class RoomClosed extends Message<RoomClosed> {
+export class CreateBreakoutRequest extends Message<CreateBreakoutRequest> {
   /**
-   * @generated from field: string reason = 1;
+   * Parent room
+   *
+   * @generated from field: string room_id = 1;
    */
-  reason = "";
+  roomId = "";
 
-  constructor(data?: PartialMessage<RoomClosed>) {
+  /**
+   * Must be host or facilitator of the parent room
+   *
+   * @generated from field: string participant_id = 2;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  constructor(data?: PartialMessage<CreateBreakoutRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "esteemed.v1.RoomClosed";
+  static readonly typeName = "esteemed.v1.CreateBreakoutRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoomClosed {
-    return new RoomClosed().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateBreakoutRequest {
+    return new CreateBreakoutRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoomClosed {
-    return new RoomClosed().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateBreakoutRequest {
+    return new CreateBreakoutRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoomClosed {
-    return new RoomClosed().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateBreakoutRequest {
+    return new CreateBreakoutRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RoomClosed | PlainMessage<RoomClosed> | undefined, b: RoomClosed | PlainMessage<RoomClosed> | undefined): boolean {
-    return proto3.util.equals(RoomClosed, a, b);
+  static equals(a: CreateBreakoutRequest | PlainMessage<CreateBreakoutRequest> | undefined, b: CreateBreakoutRequest | PlainMessage<CreateBreakoutRequest> | undefined): boolean {
+    return proto3.util.equals(CreateBreakoutRequest, a, b);
   }
 }
 
 /**
- * @generated from message esteemed.v1.HostChanged
+ * @generated from message esteemed.v1.CreateBreakoutResponse
  */
-export class HostChanged extends Message<HostChanged> {
+export class CreateBreakoutResponse extends Message<CreateBreakoutResponse> {
   /**
-   * @generated from field: string new_host_id = 1;
+   * The new breakout room
+   *
+   * @generated from field: esteemed.v1.Room room = 1;
    */
-  newHostId = "";
+  room?: Room;
 
-  constructor(data?: PartialMessage<HostChanged>) {
+  constructor(data?: PartialMessage<CreateBreakoutResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "esteemed.v1.HostChanged";
+  static readonly typeName = "esteemed.v1.CreateBreakoutResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "new_host_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "room", kind: "message", T: Room },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HostChanged {
-    return new HostChanged().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateBreakoutResponse {
+    return new CreateBreakoutResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HostChanged {
-    return new HostChanged().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateBreakoutResponse {
+    return new CreateBreakoutResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HostChanged {
-    return new HostChanged().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateBreakoutResponse {
+    return new CreateBreakoutResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: HostChanged | PlainMessage<HostChanged> | undefined, b: HostChanged | PlainMessage<HostChanged> | undefined): boolean {
-    return proto3.util.equals(HostChanged, a, b);
+  static equals(a: CreateBreakoutResponse | PlainMessage<CreateBreakoutResponse> | undefined, b: CreateBreakoutResponse | PlainMessage<CreateBreakoutResponse> | undefined): boolean {
+    return proto3.util.equals(CreateBreakoutResponse, a, b);
   }
 }
 
 /**
- * KickParticipantRequest removes a participant from the room
+ * MoveToBreakoutRequest moves a participant into a breakout
  *
- * @generated from message esteemed.v1.KickParticipantRequest
+ * @generated from message esteemed.v1.MoveToBreakoutRequest
  */
-export class KickParticipantRequest extends Message<KickParticipantRequest> {
+export class MoveToBreakoutRequest extends Message<MoveToBreakoutRequest> {
   /**
+   * Parent room
+   *
    * @generated from field: string room_id = 1;
    */
   roomId = "";
 
   /**
-   * ID of the participant making the request (must be host)
+   * Must be host or facilitator of the parent room
    *
    * @generated from field: string participant_id = 2;
    */
@@ -1100,87 +5585,93 @@ export class KickParticipantRequest extends Message<KickParticipantRequest> {
   sessionToken = "";
 
   /**
-   * ID of the participant to kick
-   *
    * @generated from field: string target_participant_id = 4;
    */
   targetParticipantId = "";
 
-  constructor(data?: PartialMessage<KickParticipantRequest>) {
+  /**
+   * @generated from field: string breakout_room_id = 5;
+   */
+  breakoutRoomId = "";
+
+  constructor(data?: PartialMessage<MoveToBreakoutRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "esteemed.v1.KickParticipantRequest";
+  static readonly typeName = "esteemed.v1.MoveToBreakoutRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "target_participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "breakout_room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KickParticipantRequest {
-    return new KickParticipantRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MoveToBreakoutRequest {
+    return new MoveToBreakoutRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KickParticipantRequest {
-    return new KickParticipantRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MoveToBreakoutRequest {
+    return new MoveToBreakoutRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KickParticipantRequest {
-    return new KickParticipantRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MoveToBreakoutRequest {
+    return new MoveToBreakoutRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: KickParticipantRequest | PlainMessage<KickParticipantRequest> | undefined, b: KickParticipantRequest | PlainMessage<KickParticipantRequest> | undefined): boolean {
-    return proto3.util.equals(KickParticipantRequest, a, b);
+  static equals(a: MoveToBreakoutRequest | PlainMessage<MoveToBreakoutRequest> | undefined, b: MoveToBreakoutRequest | PlainMessage<MoveToBreakoutRequest> | undefined): boolean {
+    return proto3.util.equals(MoveToBreakoutRequest, a, b);
   }
 }
 
 /**
- * @generated from message esteemed.v1.KickParticipantResponse
+ * @generated from message esteemed.v1.MoveToBreakoutResponse
  */
-export class KickParticipantResponse extends Message<KickParticipantResponse> {
-  constructor(data?: PartialMessage<KickParticipantResponse>) {
+export class MoveToBreakoutResponse extends Message<MoveToBreakoutResponse> {
+  constructor(data?: PartialMessage<MoveToBreakoutResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "esteemed.v1.KickParticipantResponse";
+  static readonly typeName = "esteemed.v1.MoveToBreakoutResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KickParticipantResponse {
-    return new KickParticipantResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MoveToBreakoutResponse {
+    return new MoveToBreakoutResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KickParticipantResponse {
-    return new KickParticipantResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MoveToBreakoutResponse {
+    return new MoveToBreakoutResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KickParticipantResponse {
-    return new KickParticipantResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MoveToBreakoutResponse {
+    return new MoveToBreakoutResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: KickParticipantResponse | PlainMessage<KickParticipantResponse> | undefined, b: KickParticipantResponse | PlainMessage<KickParticipantResponse> | undefined): boolean {
-    return proto3.util.equals(KickParticipantResponse, a, b);
+  static equals(a: MoveToBreakoutResponse | PlainMessage<MoveToBreakoutResponse> | undefined, b: MoveToBreakoutResponse | PlainMessage<MoveToBreakoutResponse> | undefined): boolean {
+    return proto3.util.equals(MoveToBreakoutResponse, a, b);
   }
 }
 
 /**
- * TransferOwnershipRequest transfers host privileges
+ * CloseBreakoutRequest closes a breakout and merges its round history into the parent
  *
- * @generated from message esteemed.v1.TransferOwnershipRequest
+ * @generated from message esteemed.v1.CloseBreakoutRequest
  */
-export class TransferOwnershipRequest extends Message<TransferOwnershipRequest> {
+export class CloseBreakoutRequest extends Message<CloseBreakoutRequest> {
   /**
+   * Parent room
+   *
    * @generated from field: string room_id = 1;
    */
   roomId = "";
 
   /**
-   * ID of the current host
+   * Must be host or facilitator of the parent room
    *
    * @generated from field: string participant_id = 2;
    */
@@ -1192,71 +5683,75 @@ export class TransferOwnershipRequest extends Message<TransferOwnershipRequest>
   sessionToken = "";
 
   /**
-   * ID of the new host (must not be a spectator)
-   *
-   * @generated from field: string new_host_id = 4;
+   * @generated from field: string breakout_room_id = 4;
    */
-  newHostId = "";
+  breakoutRoomId = "";
 
-  constructor(data?: PartialMessage<TransferOwnershipRequest>) {
+  constructor(data?: PartialMessage<CloseBreakoutRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "esteemed.v1.TransferOwnershipRequest";
+  static readonly typeName = "esteemed.v1.CloseBreakoutRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "new_host_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "breakout_room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransferOwnershipRequest {
-    return new TransferOwnershipRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CloseBreakoutRequest {
+    return new CloseBreakoutRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransferOwnershipRequest {
-    return new TransferOwnershipRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CloseBreakoutRequest {
+    return new CloseBreakoutRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransferOwnershipRequest {
-    return new TransferOwnershipRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CloseBreakoutRequest {
+    return new CloseBreakoutRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: TransferOwnershipRequest | PlainMessage<TransferOwnershipRequest> | undefined, b: TransferOwnershipRequest | PlainMessage<TransferOwnershipRequest> | undefined): boolean {
-    return proto3.util.equals(TransferOwnershipRequest, a, b);
+  static equals(a: CloseBreakoutRequest | PlainMessage<CloseBreakoutRequest> | undefined, b: CloseBreakoutRequest | PlainMessage<CloseBreakoutRequest> | undefined): boolean {
+    return proto3.util.equals(CloseBreakoutRequest, a, b);
   }
 }
 
 /**
- * @generated from message esteemed.v1.TransferOwnershipResponse
+ * @generated from message esteemed.v1.CloseBreakoutResponse
  */
-export class TransferOwnershipResponse extends Message<TransferOwnershipResponse> {
-  constructor(data?: PartialMessage<TransferOwnershipResponse>) {
+export class CloseBreakoutResponse extends Message<CloseBreakoutResponse> {
+  /**
+   * @generated from field: int32 rounds_merged = 1;
+   */
+  roundsMerged = 0;
+
+  constructor(data?: PartialMessage<CloseBreakoutResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "esteemed.v1.TransferOwnershipResponse";
+  static readonly typeName = "esteemed.v1.CloseBreakoutResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rounds_merged", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransferOwnershipResponse {
-    return new TransferOwnershipResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CloseBreakoutResponse {
+    return new CloseBreakoutResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransferOwnershipResponse {
-    return new TransferOwnershipResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CloseBreakoutResponse {
+    return new CloseBreakoutResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransferOwnershipResponse {
-    return new TransferOwnershipResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CloseBreakoutResponse {
+    return new CloseBreakoutResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: TransferOwnershipResponse | PlainMessage<TransferOwnershipResponse> | undefined, b: TransferOwnershipResponse | PlainMessage<TransferOwnershipResponse> | undefined): boolean {
-    return proto3.util.equals(TransferOwnershipResponse, a, b);
+  static equals(a: CloseBreakoutResponse | PlainMessage<CloseBreakoutResponse> | undefined, b: CloseBreakoutResponse | PlainMessage<CloseBreakoutResponse> | undefined): boolean {
+    return proto3.util.equals(CloseBreakoutResponse, a, b);
   }
 }
 
@@ -101,6 +101,15 @@ export function useVoting(
               currentVote: null,
               isRevealed: false,
             }));
+          } else if (event.event?.case === "voteRetracted") {
+            const { participantId: voterId } = event.event.value;
+            setState((prev) => ({
+              ...prev,
+              voteStatuses: prev.voteStatuses.map((v) =>
+                v.participantId === voterId ? { ...v, hasVoted: false } : v,
+              ),
+              currentVote: voterId === participantId ? null : prev.currentVote,
+            }));
           }
         }
       } catch (err) {
@@ -122,7 +131,7 @@ export function useVoting(
       controller.abort();
       if (retryTimeout) clearTimeout(retryTimeout);
     };
-  }, [roomId, sessionToken]);
+  }, [roomId, participantId, sessionToken]);
 
   const castVote = useCallback(
     async (value: string): Promise<void> => {